@@ -0,0 +1,217 @@
+package audit
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/odpf/optimus/api/auth"
+	pb "github.com/odpf/optimus/api/proto/odpf/optimus/core/v1beta1"
+	"github.com/odpf/optimus/models"
+	"github.com/odpf/optimus/store"
+	"github.com/odpf/salt/log"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// writeTimeout bounds recording an entry once the call has finished,
+	// request context can't be used as it may already be cancelled
+	writeTimeout = 5 * time.Second
+)
+
+// IdentifyFunc returns the identity of caller of request in ctx, empty if unknown
+type IdentifyFunc func(ctx context.Context) string
+
+// RuntimeServiceMethods are the methods of RuntimeService which change state
+// of optimus, i.e. the ones requiring deployer role or above. RegisterInstance
+// and RegisterJobEvent are called by running jobs on every attempt and are
+// not audited, ListAuditEntries only reads the audit log.
+var RuntimeServiceMethods = runtimeServiceMethods()
+
+func runtimeServiceMethods() map[string]bool {
+	methods := auth.RuntimeServicePolicy.Methods(auth.RoleDeployer)
+	delete(methods, auth.RuntimeMethod("RegisterInstance"))
+	delete(methods, auth.RuntimeMethod("RegisterJobEvent"))
+	delete(methods, auth.RuntimeMethod("ListAuditEntries"))
+	return methods
+}
+
+// Auditor records calls of audited methods in repository
+type Auditor struct {
+	repo     store.AuditRepository
+	identify IdentifyFunc
+	methods  map[string]bool
+	l        log.Logger
+}
+
+// UnaryServerInterceptor records the outcome of every audited call
+func (a *Auditor) UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if !a.methods[info.FullMethod] {
+			return handler(ctx, req)
+		}
+		resp, err := handler(ctx, req)
+		entry := a.newEntry(ctx, info.FullMethod, err)
+		entry.ProjectName, entry.NamespaceName = scopeOfRequest(req)
+		entry.Resource = resourceOfRequest(req)
+		a.record(entry)
+		return resp, err
+	}
+}
+
+// StreamServerInterceptor records the outcome of every audited stream,
+// target resources are collected from all the messages received on it
+func (a *Auditor) StreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if !a.methods[info.FullMethod] {
+			return handler(srv, stream)
+		}
+		audited := &auditedStream{ServerStream: stream}
+		err := handler(srv, audited)
+		entry := a.newEntry(stream.Context(), info.FullMethod, err)
+		entry.ProjectName, entry.NamespaceName = audited.projectName, audited.namespaceName
+		entry.Resource = strings.Join(audited.resources, ",")
+		a.record(entry)
+		return err
+	}
+}
+
+func (a *Auditor) newEntry(ctx context.Context, method string, err error) models.AuditEntry {
+	actor := a.identify(ctx)
+	if actor == "" {
+		actor = "anonymous"
+		if p, ok := peer.FromContext(ctx); ok {
+			actor = fmt.Sprintf("anonymous@%s", p.Addr.String())
+		}
+	}
+	st := status.Convert(err)
+	return models.AuditEntry{
+		Actor:     actor,
+		Method:    method,
+		Status:    st.Code().String(),
+		Message:   st.Message(),
+		CreatedAt: time.Now().UTC(),
+	}
+}
+
+func (a *Auditor) record(entry models.AuditEntry) {
+	ctx, cancel := context.WithTimeout(context.Background(), writeTimeout)
+	defer cancel()
+	if err := a.repo.Insert(ctx, entry); err != nil {
+		a.l.Error("failed to record audit entry", "method", entry.Method, "actor", entry.Actor, "err", err)
+	}
+}
+
+// auditedStream collects project, namespace and target resources
+// from messages as they are received
+type auditedStream struct {
+	grpc.ServerStream
+	projectName   string
+	namespaceName string
+	resources     []string
+}
+
+func (s *auditedStream) RecvMsg(m interface{}) error {
+	if err := s.ServerStream.RecvMsg(m); err != nil {
+		return err
+	}
+	projectName, namespaceName := scopeOfRequest(m)
+	if s.projectName == "" {
+		s.projectName, s.namespaceName = projectName, namespaceName
+	}
+	if resource := resourceOfRequest(m); resource != "" {
+		s.resources = append(s.resources, resource)
+	}
+	return nil
+}
+
+func scopeOfRequest(req interface{}) (projectName string, namespaceName string) {
+	if r, ok := req.(interface{ GetProjectName() string }); ok {
+		projectName = r.GetProjectName()
+	}
+	if r, ok := req.(interface{ GetNamespaceName() string }); ok {
+		namespaceName = r.GetNamespaceName()
+	}
+	switch r := req.(type) {
+	case *pb.RegisterProjectRequest:
+		projectName = r.GetProject().GetName()
+	case *pb.RegisterProjectNamespaceRequest:
+		namespaceName = r.GetNamespace().GetName()
+	}
+	return projectName, namespaceName
+}
+
+// resourceOfRequest returns names of the targets of request
+func resourceOfRequest(req interface{}) string {
+	switch r := req.(type) {
+	case *pb.DeployJobSpecificationRequest:
+		var names []string
+		for _, job := range r.GetJobs() {
+			names = append(names, job.GetName())
+		}
+		return strings.Join(names, ",")
+	case *pb.RunJobRequest:
+		var names []string
+		for _, job := range r.GetSpecifications() {
+			names = append(names, job.GetName())
+		}
+		return strings.Join(names, ",")
+	case *pb.CreateJobSpecificationRequest:
+		return r.GetSpec().GetName()
+	case *pb.DeleteJobSpecificationRequest:
+		return r.GetJobName()
+	case *pb.ReplayRequest:
+		return r.GetJobName()
+	case *pb.DeployResourceSpecificationRequest:
+		var names []string
+		for _, resource := range r.GetResources() {
+			names = append(names, resource.GetName())
+		}
+		return strings.Join(names, ",")
+	case *pb.CreateResourceRequest:
+		return r.GetResource().GetName()
+	case *pb.UpdateResourceRequest:
+		return r.GetResource().GetName()
+	case *pb.CreateBackupRequest:
+		return r.GetResourceName()
+	case *pb.RegisterSecretRequest:
+		return r.GetSecretName()
+	case *pb.UpdateSecretRequest:
+		return r.GetSecretName()
+	case *pb.DeleteSecretRequest:
+		return r.GetSecretName()
+	case *pb.UpdateProjectNamespaceConfigRequest:
+		// only names of changed config keys are recorded, not their values
+		var keys []string
+		for key := range r.GetSetConfig() {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		return strings.Join(append(keys, r.GetUnsetConfig()...), ",")
+	case *pb.ApplyClusterCommandRequest:
+		target := r.GetPeerId()
+		if r.GetRunId() != "" {
+			target = r.GetRunId()
+		}
+		if target == "" {
+			return r.GetType().String()
+		}
+		return fmt.Sprintf("%s %s", r.GetType().String(), target)
+	}
+	return ""
+}
+
+// NewAuditor creates an auditor recording calls of methods, caller is
+// identified using identify
+func NewAuditor(repo store.AuditRepository, identify IdentifyFunc, methods map[string]bool, l log.Logger) *Auditor {
+	return &Auditor{
+		repo:     repo,
+		identify: identify,
+		methods:  methods,
+		l:        l,
+	}
+}
@@ -0,0 +1,155 @@
+package audit_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/odpf/optimus/api/audit"
+	pb "github.com/odpf/optimus/api/proto/odpf/optimus/core/v1beta1"
+	"github.com/odpf/optimus/mock"
+	"github.com/odpf/optimus/models"
+	"github.com/odpf/salt/log"
+	"github.com/stretchr/testify/assert"
+	mocklib "github.com/stretchr/testify/mock"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type testServerStream struct {
+	grpc.ServerStream
+	msgs []*pb.DeployJobSpecificationRequest
+}
+
+func (s *testServerStream) Context() context.Context {
+	return context.Background()
+}
+
+func (s *testServerStream) RecvMsg(m interface{}) error {
+	req := m.(*pb.DeployJobSpecificationRequest)
+	req.ProjectName = s.msgs[0].ProjectName
+	req.NamespaceName = s.msgs[0].NamespaceName
+	req.Jobs = s.msgs[0].Jobs
+	s.msgs = s.msgs[1:]
+	return nil
+}
+
+func TestAuditor(t *testing.T) {
+	identify := func(ctx context.Context) string {
+		return "ci"
+	}
+
+	t.Run("RuntimeServiceMethods", func(t *testing.T) {
+		t.Run("should audit methods changing state except the ones called by running jobs and reading audit log", func(t *testing.T) {
+			assert.True(t, audit.RuntimeServiceMethods["/odpf.optimus.core.v1beta1.RuntimeService/DeployJobSpecification"])
+			assert.True(t, audit.RuntimeServiceMethods["/odpf.optimus.core.v1beta1.RuntimeService/DeleteProject"])
+			assert.False(t, audit.RuntimeServiceMethods["/odpf.optimus.core.v1beta1.RuntimeService/ListSecrets"])
+			assert.False(t, audit.RuntimeServiceMethods["/odpf.optimus.core.v1beta1.RuntimeService/RegisterInstance"])
+			assert.False(t, audit.RuntimeServiceMethods["/odpf.optimus.core.v1beta1.RuntimeService/RegisterJobEvent"])
+			assert.False(t, audit.RuntimeServiceMethods["/odpf.optimus.core.v1beta1.RuntimeService/ListAuditEntries"])
+			assert.True(t, audit.RuntimeServiceMethods["/odpf.optimus.core.v1beta1.RuntimeService/ReencryptSecrets"])
+		})
+	})
+	t.Run("UnaryServerInterceptor", func(t *testing.T) {
+		t.Run("should record outcome of audited call", func(t *testing.T) {
+			repo := new(mock.AuditRepository)
+			defer repo.AssertExpectations(t)
+			repo.On("Insert", mocklib.Anything, mocklib.MatchedBy(func(entry models.AuditEntry) bool {
+				return entry.Actor == "ci" &&
+					entry.Method == "/odpf.optimus.core.v1beta1.RuntimeService/RegisterSecret" &&
+					entry.ProjectName == "a-data-project" &&
+					entry.NamespaceName == "dev-team-1" &&
+					entry.Resource == "secret-1" &&
+					entry.Status == "AlreadyExists" &&
+					entry.Message == "secret already exist" &&
+					!entry.CreatedAt.IsZero()
+			})).Return(nil)
+
+			auditor := audit.NewAuditor(repo, identify, audit.RuntimeServiceMethods, log.NewNoop())
+			_, err := auditor.UnaryServerInterceptor()(context.Background(), &pb.RegisterSecretRequest{
+				ProjectName:   "a-data-project",
+				NamespaceName: "dev-team-1",
+				SecretName:    "secret-1",
+				Value:         "c2VjcmV0",
+			}, &grpc.UnaryServerInfo{FullMethod: "/odpf.optimus.core.v1beta1.RuntimeService/RegisterSecret"},
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					return nil, status.Error(codes.AlreadyExists, "secret already exist")
+				})
+			assert.Equal(t, codes.AlreadyExists, status.Code(err))
+		})
+		t.Run("should not fail call if recording fails", func(t *testing.T) {
+			repo := new(mock.AuditRepository)
+			defer repo.AssertExpectations(t)
+			repo.On("Insert", mocklib.Anything, mocklib.MatchedBy(func(entry models.AuditEntry) bool {
+				return entry.Status == "OK" && entry.Resource == "job-1"
+			})).Return(errors.New("connection refused"))
+
+			auditor := audit.NewAuditor(repo, identify, audit.RuntimeServiceMethods, log.NewNoop())
+			resp, err := auditor.UnaryServerInterceptor()(context.Background(), &pb.DeleteJobSpecificationRequest{
+				ProjectName: "a-data-project",
+				JobName:     "job-1",
+			}, &grpc.UnaryServerInfo{FullMethod: "/odpf.optimus.core.v1beta1.RuntimeService/DeleteJobSpecification"},
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					return &pb.DeleteJobSpecificationResponse{Success: true}, nil
+				})
+			assert.Nil(t, err)
+			assert.Equal(t, &pb.DeleteJobSpecificationResponse{Success: true}, resp)
+		})
+		t.Run("should not record calls of methods not audited", func(t *testing.T) {
+			repo := new(mock.AuditRepository)
+			defer repo.AssertExpectations(t)
+
+			auditor := audit.NewAuditor(repo, identify, audit.RuntimeServiceMethods, log.NewNoop())
+			_, err := auditor.UnaryServerInterceptor()(context.Background(), &pb.ListSecretsRequest{
+				ProjectName: "a-data-project",
+			}, &grpc.UnaryServerInfo{FullMethod: "/odpf.optimus.core.v1beta1.RuntimeService/ListSecrets"},
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					return &pb.ListSecretsResponse{}, nil
+				})
+			assert.Nil(t, err)
+		})
+	})
+	t.Run("StreamServerInterceptor", func(t *testing.T) {
+		t.Run("should record resources of all messages received on stream", func(t *testing.T) {
+			repo := new(mock.AuditRepository)
+			defer repo.AssertExpectations(t)
+			repo.On("Insert", mocklib.Anything, mocklib.MatchedBy(func(entry models.AuditEntry) bool {
+				return entry.Actor == "anonymous" &&
+					entry.Method == "/odpf.optimus.core.v1beta1.RuntimeService/DeployJobSpecification" &&
+					entry.ProjectName == "a-data-project" &&
+					entry.NamespaceName == "dev-team-1" &&
+					entry.Resource == "job-1,job-2,job-3" &&
+					entry.Status == "OK"
+			})).Return(nil)
+
+			auditor := audit.NewAuditor(repo, func(ctx context.Context) string { return "" },
+				audit.RuntimeServiceMethods, log.NewNoop())
+			stream := &testServerStream{
+				msgs: []*pb.DeployJobSpecificationRequest{
+					{
+						ProjectName:   "a-data-project",
+						NamespaceName: "dev-team-1",
+						Jobs:          []*pb.JobSpecification{{Name: "job-1"}, {Name: "job-2"}},
+					},
+					{
+						ProjectName:   "a-data-project",
+						NamespaceName: "dev-team-1",
+						Jobs:          []*pb.JobSpecification{{Name: "job-3"}},
+					},
+				},
+			}
+			err := auditor.StreamServerInterceptor()(nil, stream,
+				&grpc.StreamServerInfo{FullMethod: "/odpf.optimus.core.v1beta1.RuntimeService/DeployJobSpecification"},
+				func(srv interface{}, stream grpc.ServerStream) error {
+					for i := 0; i < 2; i++ {
+						if err := stream.RecvMsg(&pb.DeployJobSpecificationRequest{}); err != nil {
+							return err
+						}
+					}
+					return nil
+				})
+			assert.Nil(t, err)
+		})
+	})
+}
@@ -0,0 +1,189 @@
+// auth authenticates callers of optimus service using static tokens or
+// JWTs and authorizes them using their role in the requested project.
+package auth
+
+import (
+	"context"
+	"crypto/subtle"
+	"encoding/base64"
+	"strings"
+
+	"github.com/odpf/optimus/config"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// AllProjects grants a role in every project
+	AllProjects = "*"
+
+	authorizationHeader = "authorization"
+)
+
+// Role of a caller in a project, every role includes permissions of
+// the roles lower than it
+type Role int
+
+const (
+	// RoleNone only requires the caller to be authenticated
+	RoleNone Role = iota
+	RoleViewer
+	RoleDeployer
+	RoleAdmin
+)
+
+func (r Role) String() string {
+	switch r {
+	case RoleViewer:
+		return "viewer"
+	case RoleDeployer:
+		return "deployer"
+	case RoleAdmin:
+		return "admin"
+	}
+	return "none"
+}
+
+func ToRole(name string) (Role, error) {
+	switch strings.ToLower(name) {
+	case "viewer":
+		return RoleViewer, nil
+	case "deployer":
+		return RoleDeployer, nil
+	case "admin":
+		return RoleAdmin, nil
+	}
+	return RoleNone, errors.Errorf("unknown role %s", name)
+}
+
+// Identity of an authenticated caller
+type Identity struct {
+	Subject string
+
+	// Roles of caller by project name
+	Roles map[string]Role
+}
+
+// HasRole checks if caller has at least the provided role in project
+func (i Identity) HasRole(project string, role Role) bool {
+	if role == RoleNone {
+		return true
+	}
+	return i.Roles[AllProjects] >= role || (project != "" && i.Roles[project] >= role)
+}
+
+type identityKey struct{}
+
+// WithIdentity attaches identity of the caller to context
+func WithIdentity(ctx context.Context, identity Identity) context.Context {
+	return context.WithValue(ctx, identityKey{}, identity)
+}
+
+// IdentityFromContext returns identity of the caller attached to context
+func IdentityFromContext(ctx context.Context) (Identity, bool) {
+	identity, ok := ctx.Value(identityKey{}).(Identity)
+	return identity, ok
+}
+
+type staticToken struct {
+	token    []byte
+	identity Identity
+}
+
+// Authenticator validates credentials passed in authorization metadata
+// of requests, both basic and bearer schemes are accepted
+type Authenticator struct {
+	tokens []staticToken
+	jwt    *jwtVerifier
+}
+
+func (a *Authenticator) Authenticate(ctx context.Context) (Identity, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	values := md.Get(authorizationHeader)
+	if len(values) == 0 {
+		return Identity{}, status.Error(codes.Unauthenticated, "missing credentials")
+	}
+	scheme, credential := values[0], ""
+	if idx := strings.Index(values[0], " "); idx > 0 {
+		scheme, credential = values[0][:idx], strings.TrimSpace(values[0][idx+1:])
+	}
+
+	switch strings.ToLower(scheme) {
+	case "basic":
+		decoded, err := base64.StdEncoding.DecodeString(credential)
+		if err != nil {
+			return Identity{}, status.Error(codes.Unauthenticated, "malformed basic credentials")
+		}
+		if identity, ok := a.matchToken(decoded); ok {
+			return identity, nil
+		}
+	case "bearer":
+		if identity, ok := a.matchToken([]byte(credential)); ok {
+			return identity, nil
+		}
+		if a.jwt != nil && strings.Count(credential, ".") == 2 {
+			identity, err := a.jwt.Verify(credential)
+			if err != nil {
+				return Identity{}, status.Errorf(codes.Unauthenticated, "invalid token: %s", err)
+			}
+			return identity, nil
+		}
+	default:
+		return Identity{}, status.Errorf(codes.Unauthenticated, "unsupported authorization scheme %s", scheme)
+	}
+	return Identity{}, status.Error(codes.Unauthenticated, "invalid credentials")
+}
+
+func (a *Authenticator) matchToken(credential []byte) (Identity, bool) {
+	for _, t := range a.tokens {
+		if subtle.ConstantTimeCompare(t.token, credential) == 1 {
+			return t.identity, true
+		}
+	}
+	return Identity{}, false
+}
+
+func NewAuthenticator(conf config.AuthConfig) (*Authenticator, error) {
+	authenticator := &Authenticator{}
+	for _, tokenConf := range conf.Tokens {
+		if tokenConf.Token == "" {
+			return nil, errors.Errorf("token of %s can't be empty", tokenConf.Name)
+		}
+		roles, err := toRoles(tokenConf.Roles)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid roles of token %s", tokenConf.Name)
+		}
+		authenticator.tokens = append(authenticator.tokens, staticToken{
+			token: []byte(tokenConf.Token),
+			identity: Identity{
+				Subject: tokenConf.Name,
+				Roles:   roles,
+			},
+		})
+	}
+	if conf.JWT.HMACSecret != "" || conf.JWT.PublicKey != "" {
+		verifier, err := newJWTVerifier(conf.JWT)
+		if err != nil {
+			return nil, err
+		}
+		authenticator.jwt = verifier
+	}
+	return authenticator, nil
+}
+
+func toRoles(roleConfs []config.AuthRoleConfig) (map[string]Role, error) {
+	roles := map[string]Role{}
+	for _, roleConf := range roleConfs {
+		role, err := ToRole(roleConf.Role)
+		if err != nil {
+			return nil, err
+		}
+		if roleConf.Project == "" {
+			return nil, errors.Errorf("project of role %s can't be empty", roleConf.Role)
+		}
+		roles[roleConf.Project] = role
+	}
+	return roles, nil
+}
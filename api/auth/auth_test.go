@@ -0,0 +1,215 @@
+package auth_test
+
+import (
+	"context"
+	"crypto"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"testing"
+	"time"
+
+	"github.com/odpf/optimus/api/auth"
+	"github.com/odpf/optimus/config"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func signToken(t *testing.T, alg string, claims map[string]interface{}, sign func([]byte) []byte) string {
+	header, err := json.Marshal(map[string]string{"alg": alg, "typ": "JWT"})
+	assert.Nil(t, err)
+	payload, err := json.Marshal(claims)
+	assert.Nil(t, err)
+	signingInput := base64.RawURLEncoding.EncodeToString(header) + "." + base64.RawURLEncoding.EncodeToString(payload)
+	return signingInput + "." + base64.RawURLEncoding.EncodeToString(sign([]byte(signingInput)))
+}
+
+func hmacSigner(secret string) func([]byte) []byte {
+	return func(input []byte) []byte {
+		mac := hmac.New(sha256.New, []byte(secret))
+		mac.Write(input)
+		return mac.Sum(nil)
+	}
+}
+
+func contextWithAuthorization(value string) context.Context {
+	return metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", value))
+}
+
+func TestAuthenticator(t *testing.T) {
+	authConf := config.AuthConfig{
+		Enabled: true,
+		Tokens: []config.AuthTokenConfig{
+			{
+				Name:  "ci",
+				Token: "static-token",
+				Roles: []config.AuthRoleConfig{
+					{Project: "a-data-project", Role: "deployer"},
+					{Project: "*", Role: "viewer"},
+				},
+			},
+		},
+		JWT: config.AuthJWTConfig{
+			HMACSecret: "jwt-secret",
+			Issuer:     "https://issuer.example.io",
+			Audience:   "optimus",
+		},
+	}
+	authenticator, err := auth.NewAuthenticator(authConf)
+	assert.Nil(t, err)
+
+	t.Run("should authenticate static token passed as bearer credentials", func(t *testing.T) {
+		identity, err := authenticator.Authenticate(contextWithAuthorization("Bearer static-token"))
+		assert.Nil(t, err)
+		assert.Equal(t, auth.Identity{
+			Subject: "ci",
+			Roles: map[string]auth.Role{
+				"a-data-project": auth.RoleDeployer,
+				"*":              auth.RoleViewer,
+			},
+		}, identity)
+		assert.True(t, identity.HasRole("a-data-project", auth.RoleDeployer))
+		assert.True(t, identity.HasRole("b-data-project", auth.RoleViewer))
+		assert.False(t, identity.HasRole("b-data-project", auth.RoleDeployer))
+	})
+	t.Run("should authenticate static token passed as basic credentials", func(t *testing.T) {
+		identity, err := authenticator.Authenticate(contextWithAuthorization(
+			"Basic " + base64.StdEncoding.EncodeToString([]byte("static-token"))))
+		assert.Nil(t, err)
+		assert.Equal(t, "ci", identity.Subject)
+	})
+	t.Run("should fail for missing or invalid credentials", func(t *testing.T) {
+		_, err := authenticator.Authenticate(context.Background())
+		assert.Equal(t, codes.Unauthenticated, status.Code(err))
+
+		_, err = authenticator.Authenticate(contextWithAuthorization("Bearer unknown-token"))
+		assert.Equal(t, codes.Unauthenticated, status.Code(err))
+
+		_, err = authenticator.Authenticate(contextWithAuthorization("Digest static-token"))
+		assert.Equal(t, codes.Unauthenticated, status.Code(err))
+	})
+	t.Run("should authenticate JWT signed with HS256", func(t *testing.T) {
+		token := signToken(t, "HS256", map[string]interface{}{
+			"sub": "user@example.io",
+			"iss": "https://issuer.example.io",
+			"aud": []string{"optimus", "other"},
+			"exp": time.Now().Add(time.Hour).Unix(),
+			"optimus_roles": map[string]string{
+				"a-data-project": "admin",
+			},
+		}, hmacSigner("jwt-secret"))
+
+		identity, err := authenticator.Authenticate(contextWithAuthorization("Bearer " + token))
+		assert.Nil(t, err)
+		assert.Equal(t, auth.Identity{
+			Subject: "user@example.io",
+			Roles: map[string]auth.Role{
+				"a-data-project": auth.RoleAdmin,
+			},
+		}, identity)
+	})
+	t.Run("should fail for invalid JWTs", func(t *testing.T) {
+		validClaims := func() map[string]interface{} {
+			return map[string]interface{}{
+				"sub": "user@example.io",
+				"iss": "https://issuer.example.io",
+				"aud": "optimus",
+				"exp": time.Now().Add(time.Hour).Unix(),
+			}
+		}
+		testCases := []struct {
+			name  string
+			token string
+		}{
+			{
+				name:  "signed with different secret",
+				token: signToken(t, "HS256", validClaims(), hmacSigner("other-secret")),
+			},
+			{
+				name: "expired",
+				token: func() string {
+					claims := validClaims()
+					claims["exp"] = time.Now().Add(-time.Hour).Unix()
+					return signToken(t, "HS256", claims, hmacSigner("jwt-secret"))
+				}(),
+			},
+			{
+				name: "issued by different issuer",
+				token: func() string {
+					claims := validClaims()
+					claims["iss"] = "https://other.example.io"
+					return signToken(t, "HS256", claims, hmacSigner("jwt-secret"))
+				}(),
+			},
+			{
+				name: "issued for different audience",
+				token: func() string {
+					claims := validClaims()
+					claims["aud"] = "other"
+					return signToken(t, "HS256", claims, hmacSigner("jwt-secret"))
+				}(),
+			},
+			{
+				name:  "not signed",
+				token: signToken(t, "none", validClaims(), func([]byte) []byte { return nil }),
+			},
+		}
+		for _, testCase := range testCases {
+			t.Run(testCase.name, func(t *testing.T) {
+				_, err := authenticator.Authenticate(contextWithAuthorization("Bearer " + testCase.token))
+				assert.Equal(t, codes.Unauthenticated, status.Code(err))
+			})
+		}
+	})
+	t.Run("should authenticate JWT signed with RS256", func(t *testing.T) {
+		privateKey, err := rsa.GenerateKey(rand.Reader, 2048)
+		assert.Nil(t, err)
+		publicKey, err := x509.MarshalPKIXPublicKey(&privateKey.PublicKey)
+		assert.Nil(t, err)
+
+		rsaAuthenticator, err := auth.NewAuthenticator(config.AuthConfig{
+			JWT: config.AuthJWTConfig{
+				PublicKey:  string(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: publicKey})),
+				RolesClaim: "roles",
+			},
+		})
+		assert.Nil(t, err)
+
+		token := signToken(t, "RS256", map[string]interface{}{
+			"sub":   "user@example.io",
+			"roles": map[string]string{"*": "viewer"},
+		}, func(input []byte) []byte {
+			digest := sha256.Sum256(input)
+			signature, err := rsa.SignPKCS1v15(rand.Reader, privateKey, crypto.SHA256, digest[:])
+			assert.Nil(t, err)
+			return signature
+		})
+		identity, err := rsaAuthenticator.Authenticate(contextWithAuthorization("Bearer " + token))
+		assert.Nil(t, err)
+		assert.True(t, identity.HasRole("a-data-project", auth.RoleViewer))
+
+		// HS256 tokens are rejected if no secret is configured
+		_, err = rsaAuthenticator.Authenticate(contextWithAuthorization("Bearer " +
+			signToken(t, "HS256", map[string]interface{}{"sub": "user"}, hmacSigner(""))))
+		assert.Equal(t, codes.Unauthenticated, status.Code(err))
+	})
+	t.Run("should fail to create authenticator with unknown role", func(t *testing.T) {
+		_, err := auth.NewAuthenticator(config.AuthConfig{
+			Tokens: []config.AuthTokenConfig{
+				{
+					Name:  "ci",
+					Token: "static-token",
+					Roles: []config.AuthRoleConfig{{Project: "*", Role: "owner"}},
+				},
+			},
+		})
+		assert.NotNil(t, err)
+	})
+}
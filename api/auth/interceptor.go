@@ -0,0 +1,61 @@
+package auth
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+)
+
+// UnaryServerInterceptor authenticates every request and authorizes it
+// as per policy before passing it to handler
+func UnaryServerInterceptor(authenticator *Authenticator, policy Policy) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		identity, err := authenticator.Authenticate(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if err := policy.Authorize(info.FullMethod, identity, req); err != nil {
+			return nil, err
+		}
+		return handler(WithIdentity(ctx, identity), req)
+	}
+}
+
+// StreamServerInterceptor authenticates every stream and authorizes each
+// message received on it as per policy
+func StreamServerInterceptor(authenticator *Authenticator, policy Policy) grpc.StreamServerInterceptor {
+	return func(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		identity, err := authenticator.Authenticate(stream.Context())
+		if err != nil {
+			return err
+		}
+		return handler(srv, &authorizedStream{
+			ServerStream: stream,
+			ctx:          WithIdentity(stream.Context(), identity),
+			method:       info.FullMethod,
+			identity:     identity,
+			policy:       policy,
+		})
+	}
+}
+
+// authorizedStream authorizes messages as they are received because
+// project of the request is only known after reading them
+type authorizedStream struct {
+	grpc.ServerStream
+	ctx      context.Context
+	method   string
+	identity Identity
+	policy   Policy
+}
+
+func (s *authorizedStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *authorizedStream) RecvMsg(m interface{}) error {
+	if err := s.ServerStream.RecvMsg(m); err != nil {
+		return err
+	}
+	return s.policy.Authorize(s.method, s.identity, m)
+}
@@ -0,0 +1,184 @@
+package auth
+
+import (
+	"crypto"
+	"crypto/hmac"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"strings"
+	"time"
+
+	"github.com/odpf/optimus/config"
+	"github.com/pkg/errors"
+)
+
+const (
+	defaultRolesClaim = "optimus_roles"
+
+	// leeway allowed in validating time based claims
+	jwtClockSkew = time.Minute
+)
+
+// jwtVerifier validates JWTs signed with HS256 or RS256
+type jwtVerifier struct {
+	hmacSecret []byte
+	publicKey  *rsa.PublicKey
+
+	issuer     string
+	audience   string
+	rolesClaim string
+
+	now func() time.Time
+}
+
+type jwtClaims struct {
+	Subject   string          `json:"sub"`
+	Issuer    string          `json:"iss"`
+	Audience  json.RawMessage `json:"aud"`
+	ExpiresAt *float64        `json:"exp"`
+	NotBefore *float64        `json:"nbf"`
+}
+
+func (v *jwtVerifier) Verify(token string) (Identity, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return Identity{}, errors.New("malformed token")
+	}
+
+	var header struct {
+		Alg string `json:"alg"`
+	}
+	if err := decodeSegment(parts[0], &header); err != nil {
+		return Identity{}, errors.Wrap(err, "malformed header")
+	}
+	signature, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil {
+		return Identity{}, errors.Wrap(err, "malformed signature")
+	}
+	signingInput := []byte(parts[0] + "." + parts[1])
+	switch header.Alg {
+	case "HS256":
+		if v.hmacSecret == nil {
+			return Identity{}, errors.New("HS256 tokens are not accepted")
+		}
+		mac := hmac.New(sha256.New, v.hmacSecret)
+		mac.Write(signingInput)
+		if !hmac.Equal(signature, mac.Sum(nil)) {
+			return Identity{}, errors.New("signature mismatch")
+		}
+	case "RS256":
+		if v.publicKey == nil {
+			return Identity{}, errors.New("RS256 tokens are not accepted")
+		}
+		digest := sha256.Sum256(signingInput)
+		if err := rsa.VerifyPKCS1v15(v.publicKey, crypto.SHA256, digest[:], signature); err != nil {
+			return Identity{}, errors.New("signature mismatch")
+		}
+	default:
+		return Identity{}, errors.Errorf("unsupported signing algorithm %s", header.Alg)
+	}
+
+	var claims jwtClaims
+	if err := decodeSegment(parts[1], &claims); err != nil {
+		return Identity{}, errors.Wrap(err, "malformed claims")
+	}
+	if err := v.validateClaims(claims); err != nil {
+		return Identity{}, err
+	}
+
+	var rawClaims map[string]json.RawMessage
+	if err := decodeSegment(parts[1], &rawClaims); err != nil {
+		return Identity{}, errors.Wrap(err, "malformed claims")
+	}
+	roles := map[string]Role{}
+	if rawRoles, ok := rawClaims[v.rolesClaim]; ok {
+		var projectRoles map[string]string
+		if err := json.Unmarshal(rawRoles, &projectRoles); err != nil {
+			return Identity{}, errors.Wrapf(err, "malformed %s claim", v.rolesClaim)
+		}
+		for project, roleName := range projectRoles {
+			role, err := ToRole(roleName)
+			if err != nil {
+				return Identity{}, err
+			}
+			roles[project] = role
+		}
+	}
+	return Identity{
+		Subject: claims.Subject,
+		Roles:   roles,
+	}, nil
+}
+
+func (v *jwtVerifier) validateClaims(claims jwtClaims) error {
+	now := v.now()
+	if claims.ExpiresAt != nil && now.After(time.Unix(int64(*claims.ExpiresAt), 0).Add(jwtClockSkew)) {
+		return errors.New("token is expired")
+	}
+	if claims.NotBefore != nil && now.Add(jwtClockSkew).Before(time.Unix(int64(*claims.NotBefore), 0)) {
+		return errors.New("token is not valid yet")
+	}
+	if v.issuer != "" && claims.Issuer != v.issuer {
+		return errors.Errorf("unexpected issuer %s", claims.Issuer)
+	}
+	if v.audience != "" {
+		// audience could be a single string or an array of strings
+		var audiences []string
+		var audience string
+		if err := json.Unmarshal(claims.Audience, &audience); err == nil {
+			audiences = []string{audience}
+		} else if err := json.Unmarshal(claims.Audience, &audiences); err != nil {
+			return errors.New("malformed audience")
+		}
+		for _, aud := range audiences {
+			if aud == v.audience {
+				return nil
+			}
+		}
+		return errors.Errorf("token is not issued for audience %s", v.audience)
+	}
+	return nil
+}
+
+func decodeSegment(segment string, v interface{}) error {
+	raw, err := base64.RawURLEncoding.DecodeString(segment)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(raw, v)
+}
+
+func newJWTVerifier(conf config.AuthJWTConfig) (*jwtVerifier, error) {
+	verifier := &jwtVerifier{
+		issuer:     conf.Issuer,
+		audience:   conf.Audience,
+		rolesClaim: conf.RolesClaim,
+		now:        time.Now,
+	}
+	if verifier.rolesClaim == "" {
+		verifier.rolesClaim = defaultRolesClaim
+	}
+	if conf.HMACSecret != "" {
+		verifier.hmacSecret = []byte(conf.HMACSecret)
+	}
+	if conf.PublicKey != "" {
+		block, _ := pem.Decode([]byte(conf.PublicKey))
+		if block == nil {
+			return nil, errors.New("failed to decode jwt public key, expected PEM format")
+		}
+		key, err := x509.ParsePKIXPublicKey(block.Bytes)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to parse jwt public key")
+		}
+		rsaKey, ok := key.(*rsa.PublicKey)
+		if !ok {
+			return nil, errors.New("jwt public key should be an RSA key")
+		}
+		verifier.publicKey = rsaKey
+	}
+	return verifier, nil
+}
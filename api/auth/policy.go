@@ -0,0 +1,111 @@
+package auth
+
+import (
+	"fmt"
+
+	pb "github.com/odpf/optimus/api/proto/odpf/optimus/core/v1beta1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Policy maps full method names of rpcs to the role required to call them,
+// role is checked in the project of request. Requests without a project
+// need the role in all projects unless it is RoleNone.
+type Policy map[string]Role
+
+// Authorize checks if identity is allowed to call method with req,
+// methods missing in policy are always denied
+func (p Policy) Authorize(method string, identity Identity, req interface{}) error {
+	role, ok := p[method]
+	if !ok {
+		return status.Errorf(codes.PermissionDenied, "%s is not allowed", method)
+	}
+	project := projectOfRequest(req)
+	if !identity.HasRole(project, role) {
+		if project == "" {
+			project = AllProjects
+		}
+		return status.Errorf(codes.PermissionDenied, "%s requires %s role in project %s", method, role, project)
+	}
+	return nil
+}
+
+func projectOfRequest(req interface{}) string {
+	switch r := req.(type) {
+	case interface{ GetProjectName() string }:
+		return r.GetProjectName()
+	case interface {
+		GetProject() *pb.ProjectSpecification
+	}:
+		return r.GetProject().GetName()
+	}
+	return ""
+}
+
+// Methods returns the methods of policy which require at least role
+func (p Policy) Methods(role Role) map[string]bool {
+	methods := map[string]bool{}
+	for method, required := range p {
+		if required >= role {
+			methods[method] = true
+		}
+	}
+	return methods
+}
+
+// RuntimeMethod returns the full name of rpc method of RuntimeService
+func RuntimeMethod(name string) string {
+	return fmt.Sprintf("/%s/%s", pb.RuntimeService_ServiceDesc.ServiceName, name)
+}
+
+// RuntimeServicePolicy is the policy of all the methods of RuntimeService
+var RuntimeServicePolicy = Policy{
+	RuntimeMethod("Version"):      RoleNone,
+	RuntimeMethod("ListProjects"): RoleNone,
+	RuntimeMethod("GetWindow"):    RoleNone,
+
+	RuntimeMethod("GetJobSpecification"):       RoleViewer,
+	RuntimeMethod("ListJobSpecification"):      RoleViewer,
+	RuntimeMethod("GetJobTask"):                RoleViewer,
+	RuntimeMethod("CheckJobSpecification"):     RoleViewer,
+	RuntimeMethod("CheckJobSpecifications"):    RoleViewer,
+	RuntimeMethod("ListSecrets"):               RoleViewer,
+	RuntimeMethod("ListProjectNamespaces"):     RoleViewer,
+	RuntimeMethod("GetProject"):                RoleViewer,
+	RuntimeMethod("GetProjectNamespace"):       RoleViewer,
+	RuntimeMethod("JobStatus"):                 RoleViewer,
+	RuntimeMethod("ListJobEvents"):             RoleViewer,
+	RuntimeMethod("ListResourceSpecification"): RoleViewer,
+	RuntimeMethod("ReadResource"):              RoleViewer,
+	RuntimeMethod("ReplayDryRun"):              RoleViewer,
+	RuntimeMethod("GetReplayStatus"):           RoleViewer,
+	RuntimeMethod("ListReplays"):               RoleViewer,
+	RuntimeMethod("BackupDryRun"):              RoleViewer,
+	RuntimeMethod("ListBackups"):               RoleViewer,
+	RuntimeMethod("GetBackup"):                 RoleViewer,
+	RuntimeMethod("GetClusterState"):           RoleViewer,
+
+	RuntimeMethod("DeployJobSpecification"):      RoleDeployer,
+	RuntimeMethod("CreateJobSpecification"):      RoleDeployer,
+	RuntimeMethod("DeleteJobSpecification"):      RoleDeployer,
+	RuntimeMethod("RegisterInstance"):            RoleDeployer,
+	RuntimeMethod("RegisterJobEvent"):            RoleDeployer,
+	RuntimeMethod("DeployResourceSpecification"): RoleDeployer,
+	RuntimeMethod("CreateResource"):              RoleDeployer,
+	RuntimeMethod("UpdateResource"):              RoleDeployer,
+	RuntimeMethod("Replay"):                      RoleDeployer,
+	RuntimeMethod("CreateBackup"):                RoleDeployer,
+	RuntimeMethod("RunJob"):                      RoleDeployer,
+
+	RuntimeMethod("RegisterProject"):              RoleAdmin,
+	RuntimeMethod("RegisterProjectNamespace"):     RoleAdmin,
+	RuntimeMethod("DeleteProjectNamespace"):       RoleAdmin,
+	RuntimeMethod("UpdateProjectNamespaceConfig"): RoleAdmin,
+	RuntimeMethod("DeleteProject"):                RoleAdmin,
+	RuntimeMethod("RegisterSecret"):               RoleAdmin,
+	RuntimeMethod("UpdateSecret"):                 RoleAdmin,
+	RuntimeMethod("DeleteSecret"):                 RoleAdmin,
+	RuntimeMethod("ApplyClusterCommand"):          RoleAdmin,
+	RuntimeMethod("ListAuditEntries"):             RoleAdmin,
+	RuntimeMethod("ReencryptSecrets"):             RoleAdmin,
+}
@@ -0,0 +1,179 @@
+package auth_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/odpf/optimus/api/auth"
+	pb "github.com/odpf/optimus/api/proto/odpf/optimus/core/v1beta1"
+	"github.com/odpf/optimus/config"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type testServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+	msg *pb.DeployJobSpecificationRequest
+}
+
+func (s *testServerStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *testServerStream) RecvMsg(m interface{}) error {
+	req := m.(*pb.DeployJobSpecificationRequest)
+	req.ProjectName = s.msg.ProjectName
+	return nil
+}
+
+func TestPolicy(t *testing.T) {
+	viewer := auth.Identity{
+		Subject: "viewer",
+		Roles:   map[string]auth.Role{"a-data-project": auth.RoleViewer},
+	}
+	admin := auth.Identity{
+		Subject: "admin",
+		Roles:   map[string]auth.Role{auth.AllProjects: auth.RoleAdmin},
+	}
+
+	t.Run("should have a role for every method of runtime service", func(t *testing.T) {
+		for _, method := range pb.RuntimeService_ServiceDesc.Methods {
+			_, ok := auth.RuntimeServicePolicy["/"+pb.RuntimeService_ServiceDesc.ServiceName+"/"+method.MethodName]
+			assert.True(t, ok, method.MethodName)
+		}
+		for _, stream := range pb.RuntimeService_ServiceDesc.Streams {
+			_, ok := auth.RuntimeServicePolicy["/"+pb.RuntimeService_ServiceDesc.ServiceName+"/"+stream.StreamName]
+			assert.True(t, ok, stream.StreamName)
+		}
+	})
+	t.Run("should list methods requiring at least role", func(t *testing.T) {
+		policy := auth.Policy{
+			"/a": auth.RoleNone,
+			"/b": auth.RoleViewer,
+			"/c": auth.RoleDeployer,
+			"/d": auth.RoleAdmin,
+		}
+		assert.Equal(t, map[string]bool{"/c": true, "/d": true}, policy.Methods(auth.RoleDeployer))
+	})
+	t.Run("should authorize using role in project of request", func(t *testing.T) {
+		policy := auth.RuntimeServicePolicy
+
+		err := policy.Authorize("/odpf.optimus.core.v1beta1.RuntimeService/ListSecrets", viewer,
+			&pb.ListSecretsRequest{ProjectName: "a-data-project"})
+		assert.Nil(t, err)
+
+		err = policy.Authorize("/odpf.optimus.core.v1beta1.RuntimeService/ListSecrets", viewer,
+			&pb.ListSecretsRequest{ProjectName: "b-data-project"})
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+		err = policy.Authorize("/odpf.optimus.core.v1beta1.RuntimeService/DeleteSecret", viewer,
+			&pb.DeleteSecretRequest{ProjectName: "a-data-project"})
+		assert.Equal(t, "rpc error: code = PermissionDenied desc = /odpf.optimus.core.v1beta1.RuntimeService/DeleteSecret requires admin role in project a-data-project", err.Error())
+
+		err = policy.Authorize("/odpf.optimus.core.v1beta1.RuntimeService/RegisterProject", admin,
+			&pb.RegisterProjectRequest{Project: &pb.ProjectSpecification{Name: "c-data-project"}})
+		assert.Nil(t, err)
+	})
+	t.Run("should need role in all projects for requests without project", func(t *testing.T) {
+		policy := auth.RuntimeServicePolicy
+
+		err := policy.Authorize("/odpf.optimus.core.v1beta1.RuntimeService/Version", viewer, &pb.VersionRequest{})
+		assert.Nil(t, err)
+
+		err = policy.Authorize("/odpf.optimus.core.v1beta1.RuntimeService/ApplyClusterCommand", viewer,
+			&pb.ApplyClusterCommandRequest{})
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+		err = policy.Authorize("/odpf.optimus.core.v1beta1.RuntimeService/ApplyClusterCommand", admin,
+			&pb.ApplyClusterCommandRequest{})
+		assert.Nil(t, err)
+
+		err = policy.Authorize("/odpf.optimus.core.v1beta1.RuntimeService/ReencryptSecrets", viewer,
+			&pb.ReencryptSecretsRequest{})
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+		err = policy.Authorize("/odpf.optimus.core.v1beta1.RuntimeService/ListAuditEntries", viewer,
+			&pb.ListAuditEntriesRequest{})
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+		err = policy.Authorize("/odpf.optimus.core.v1beta1.RuntimeService/ListAuditEntries", admin,
+			&pb.ListAuditEntriesRequest{})
+		assert.Nil(t, err)
+	})
+	t.Run("should deny methods not in policy", func(t *testing.T) {
+		err := auth.RuntimeServicePolicy.Authorize("/grpc.health.v1.Health/Check", admin, nil)
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	})
+}
+
+func TestInterceptors(t *testing.T) {
+	authenticator, err := auth.NewAuthenticator(config.AuthConfig{
+		Tokens: []config.AuthTokenConfig{
+			{
+				Name:  "ci",
+				Token: "static-token",
+				Roles: []config.AuthRoleConfig{{Project: "a-data-project", Role: "deployer"}},
+			},
+		},
+	})
+	assert.Nil(t, err)
+
+	t.Run("UnaryServerInterceptor", func(t *testing.T) {
+		interceptor := auth.UnaryServerInterceptor(authenticator, auth.RuntimeServicePolicy)
+		info := &grpc.UnaryServerInfo{FullMethod: "/odpf.optimus.core.v1beta1.RuntimeService/RunJob"}
+
+		t.Run("should pass identity of caller to handler", func(t *testing.T) {
+			resp, err := interceptor(contextWithAuthorization("Bearer static-token"),
+				&pb.RunJobRequest{ProjectName: "a-data-project"}, info,
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					identity, ok := auth.IdentityFromContext(ctx)
+					assert.True(t, ok)
+					return identity.Subject, nil
+				})
+			assert.Nil(t, err)
+			assert.Equal(t, "ci", resp)
+		})
+		t.Run("should not call handler if caller is not authorized", func(t *testing.T) {
+			_, err := interceptor(contextWithAuthorization("Bearer static-token"),
+				&pb.RunJobRequest{ProjectName: "b-data-project"}, info,
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					t.Fatal("handler should not be called")
+					return nil, nil
+				})
+			assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+			_, err = interceptor(context.Background(),
+				&pb.RunJobRequest{ProjectName: "a-data-project"}, info,
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					t.Fatal("handler should not be called")
+					return nil, nil
+				})
+			assert.Equal(t, codes.Unauthenticated, status.Code(err))
+		})
+	})
+	t.Run("StreamServerInterceptor", func(t *testing.T) {
+		interceptor := auth.StreamServerInterceptor(authenticator, auth.RuntimeServicePolicy)
+		info := &grpc.StreamServerInfo{FullMethod: "/odpf.optimus.core.v1beta1.RuntimeService/DeployJobSpecification"}
+		handler := func(srv interface{}, stream grpc.ServerStream) error {
+			req := &pb.DeployJobSpecificationRequest{}
+			return stream.RecvMsg(req)
+		}
+
+		t.Run("should authorize messages received on stream", func(t *testing.T) {
+			err := interceptor(nil, &testServerStream{
+				ctx: contextWithAuthorization("Bearer static-token"),
+				msg: &pb.DeployJobSpecificationRequest{ProjectName: "a-data-project"},
+			}, info, handler)
+			assert.Nil(t, err)
+
+			err = interceptor(nil, &testServerStream{
+				ctx: contextWithAuthorization("Bearer static-token"),
+				msg: &pb.DeployJobSpecificationRequest{ProjectName: "b-data-project"},
+			}, info, handler)
+			assert.Equal(t, codes.PermissionDenied, status.Code(err))
+		})
+	})
+}
@@ -13,6 +13,7 @@ import (
 	"github.com/odpf/optimus/core/tree"
 	"github.com/odpf/optimus/models"
 	"github.com/pkg/errors"
+	"google.golang.org/protobuf/types/known/structpb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
@@ -334,6 +335,41 @@ func (adapt *Adapter) ToInstanceProto(spec models.InstanceSpec) (*pb.InstanceSpe
 	}, nil
 }
 
+func (adapt *Adapter) ToJobEventRecordProto(record models.JobEventRecord) *pb.RegisteredJobEvent {
+	registeredEvent := &pb.RegisteredJobEvent{
+		Event: &pb.JobEvent{
+			Type:  pb.JobEvent_Type(pb.JobEvent_Type_value[utils.ToEnumProto(string(record.Event.Type), "type")]),
+			Value: &structpb.Struct{Fields: record.Event.Value},
+		},
+		CreatedAt: timestamppb.New(record.CreatedAt),
+	}
+	if !record.ScheduledAt.IsZero() {
+		registeredEvent.ScheduledAt = timestamppb.New(record.ScheduledAt)
+	}
+	return registeredEvent
+}
+
+func (adapt *Adapter) ToInstanceStatusProto(spec models.InstanceSpec) *pb.InstanceStatus {
+	instanceStatus := &pb.InstanceStatus{
+		Name:     spec.Name,
+		Type:     spec.Type.String(),
+		State:    spec.Status.String(),
+		Attempt:  int32(spec.Attempt),
+		ExitCode: int32(spec.ExitCode),
+		Reason:   spec.Reason,
+
+		PreviousExitCode: int32(spec.PreviousExitCode),
+		PreviousReason:   spec.PreviousReason,
+	}
+	if !spec.StartedAt.IsZero() {
+		instanceStatus.StartedAt = timestamppb.New(spec.StartedAt)
+	}
+	if !spec.FinishedAt.IsZero() {
+		instanceStatus.FinishedAt = timestamppb.New(spec.FinishedAt)
+	}
+	return instanceStatus
+}
+
 func (adapt *Adapter) FromInstanceProto(conf *pb.InstanceSpec) (models.InstanceSpec, error) {
 	if conf == nil {
 		return models.InstanceSpec{}, nil
@@ -0,0 +1,76 @@
+package v1beta1
+
+import (
+	"context"
+
+	pb "github.com/odpf/optimus/api/proto/odpf/optimus/core/v1beta1"
+	"github.com/odpf/optimus/models"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	defaultReencryptBatchSize = 100
+)
+
+func (sv *RuntimeServiceServer) ListAuditEntries(ctx context.Context, req *pb.ListAuditEntriesRequest) (*pb.ListAuditEntriesResponse, error) {
+	filter := models.AuditFilter{
+		ProjectName: req.GetProjectName(),
+		Actor:       req.GetActor(),
+		Limit:       int(req.GetLimit()),
+	}
+	if req.GetSince() != nil {
+		filter.Since = req.GetSince().AsTime()
+	}
+	if req.GetUntil() != nil {
+		filter.Until = req.GetUntil().AsTime()
+	}
+
+	entries, err := sv.adminSvc.ListAuditEntries(ctx, filter)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "%s: failed to list audit entries", err.Error())
+	}
+	var entriesProto []*pb.AuditEntry
+	for _, entry := range entries {
+		entriesProto = append(entriesProto, toAuditEntryProto(entry))
+	}
+	return &pb.ListAuditEntriesResponse{
+		Entries: entriesProto,
+	}, nil
+}
+
+// ReencryptSecrets re-encrypts secrets of all projects, secrets re-encrypted
+// before a failure stay re-encrypted and the call can be retried
+func (sv *RuntimeServiceServer) ReencryptSecrets(ctx context.Context, req *pb.ReencryptSecretsRequest) (*pb.ReencryptSecretsResponse, error) {
+	batchSize := int(req.GetBatchSize())
+	if batchSize < 0 {
+		return nil, status.Error(codes.InvalidArgument, "batch size can't be negative")
+	}
+	if batchSize == 0 {
+		batchSize = defaultReencryptBatchSize
+	}
+
+	reencrypted, keyID, err := sv.adminSvc.ReencryptSecrets(ctx, batchSize)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "%s: failed to re-encrypt secrets after re-encrypting %d secrets",
+			err.Error(), reencrypted)
+	}
+	return &pb.ReencryptSecretsResponse{
+		Reencrypted: int32(reencrypted),
+		KeyId:       keyID,
+	}, nil
+}
+
+func toAuditEntryProto(entry models.AuditEntry) *pb.AuditEntry {
+	return &pb.AuditEntry{
+		Actor:         entry.Actor,
+		Method:        entry.Method,
+		ProjectName:   entry.ProjectName,
+		NamespaceName: entry.NamespaceName,
+		Resource:      entry.Resource,
+		Status:        entry.Status,
+		Message:       entry.Message,
+		CreatedAt:     timestamppb.New(entry.CreatedAt),
+	}
+}
@@ -0,0 +1,107 @@
+package v1beta1_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	v1 "github.com/odpf/optimus/api/handler/v1beta1"
+	pb "github.com/odpf/optimus/api/proto/odpf/optimus/core/v1beta1"
+	"github.com/odpf/optimus/mock"
+	"github.com/odpf/optimus/models"
+	"github.com/odpf/salt/log"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestAdminOnRuntimeServer(t *testing.T) {
+	ctx := context.Background()
+	newServer := func(adminService v1.AdminService) *v1.RuntimeServiceServer {
+		return v1.NewRuntimeServiceServer(
+			log.NewNoop(),
+			"someVersion1.0",
+			nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil,
+			adminService,
+		)
+	}
+
+	t.Run("ListAuditEntries", func(t *testing.T) {
+		t.Run("should list audit entries matching filter", func(t *testing.T) {
+			since := time.Date(2021, 10, 1, 0, 0, 0, 0, time.UTC)
+			createdAt := time.Date(2021, 10, 2, 0, 0, 0, 0, time.UTC)
+			adminService := new(mock.AdminService)
+			adminService.On("ListAuditEntries", ctx, models.AuditFilter{
+				ProjectName: "a-data-project",
+				Actor:       "ci",
+				Since:       since,
+				Limit:       10,
+			}).Return([]models.AuditEntry{
+				{
+					Actor:         "ci",
+					Method:        "/odpf.optimus.core.v1beta1.RuntimeService/DeleteSecret",
+					ProjectName:   "a-data-project",
+					NamespaceName: "dev-test-namespace-1",
+					Resource:      "secret-1",
+					Status:        "OK",
+					CreatedAt:     createdAt,
+				},
+			}, nil)
+			defer adminService.AssertExpectations(t)
+
+			resp, err := newServer(adminService).ListAuditEntries(ctx, &pb.ListAuditEntriesRequest{
+				ProjectName: "a-data-project",
+				Actor:       "ci",
+				Since:       timestamppb.New(since),
+				Limit:       10,
+			})
+			assert.Nil(t, err)
+			assert.Equal(t, []*pb.AuditEntry{
+				{
+					Actor:         "ci",
+					Method:        "/odpf.optimus.core.v1beta1.RuntimeService/DeleteSecret",
+					ProjectName:   "a-data-project",
+					NamespaceName: "dev-test-namespace-1",
+					Resource:      "secret-1",
+					Status:        "OK",
+					CreatedAt:     timestamppb.New(createdAt),
+				},
+			}, resp.GetEntries())
+		})
+		t.Run("should fail if audit entries can't be listed", func(t *testing.T) {
+			adminService := new(mock.AdminService)
+			adminService.On("ListAuditEntries", ctx, models.AuditFilter{}).Return([]models.AuditEntry{}, errors.New("connection refused"))
+			defer adminService.AssertExpectations(t)
+
+			_, err := newServer(adminService).ListAuditEntries(ctx, &pb.ListAuditEntriesRequest{})
+			assert.Equal(t, codes.Internal, status.Code(err))
+		})
+	})
+	t.Run("ReencryptSecrets", func(t *testing.T) {
+		t.Run("should re-encrypt secrets in batches of default size if not requested", func(t *testing.T) {
+			adminService := new(mock.AdminService)
+			adminService.On("ReencryptSecrets", ctx, 100).Return(3, "key-2", nil)
+			defer adminService.AssertExpectations(t)
+
+			resp, err := newServer(adminService).ReencryptSecrets(ctx, &pb.ReencryptSecretsRequest{})
+			assert.Nil(t, err)
+			assert.Equal(t, int32(3), resp.GetReencrypted())
+			assert.Equal(t, "key-2", resp.GetKeyId())
+		})
+		t.Run("should report re-encrypted secrets if it fails midway", func(t *testing.T) {
+			adminService := new(mock.AdminService)
+			adminService.On("ReencryptSecrets", ctx, 10).Return(20, "key-2", errors.New("unknown key key-0"))
+			defer adminService.AssertExpectations(t)
+
+			_, err := newServer(adminService).ReencryptSecrets(ctx, &pb.ReencryptSecretsRequest{BatchSize: 10})
+			assert.Equal(t, codes.Internal, status.Code(err))
+			assert.Contains(t, err.Error(), "after re-encrypting 20 secrets")
+		})
+		t.Run("should fail if batch size is negative", func(t *testing.T) {
+			_, err := newServer(nil).ReencryptSecrets(ctx, &pb.ReencryptSecretsRequest{BatchSize: -1})
+			assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		})
+	})
+}
@@ -0,0 +1,321 @@
+package v1beta1
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/google/uuid"
+	pb "github.com/odpf/optimus/api/proto/odpf/optimus/core/v1beta1"
+	"github.com/odpf/optimus/models"
+	"github.com/odpf/optimus/store"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (sv *RuntimeServiceServer) GetProject(ctx context.Context, req *pb.GetProjectRequest) (*pb.GetProjectResponse, error) {
+	projectRepo := sv.projectRepoFactory.New()
+	projSpec, err := projectRepo.GetByName(ctx, req.GetProjectName())
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "%s: project %s not found", err.Error(), req.GetProjectName())
+	}
+
+	namespaceRepo := sv.namespaceRepoFactory.New(projSpec)
+	namespaceSpecs, err := namespaceRepo.GetAll(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "error while fetching namespaces: \n%s", err.Error())
+	}
+
+	namespaceSpecsProto := []*pb.NamespaceSpecification{}
+	for _, namespace := range namespaceSpecs {
+		namespaceSpecsProto = append(namespaceSpecsProto, sv.adapter.ToNamespaceProto(namespace))
+	}
+	return &pb.GetProjectResponse{
+		Project:    sv.adapter.ToProjectProto(projSpec),
+		Namespaces: namespaceSpecsProto,
+	}, nil
+}
+
+func (sv *RuntimeServiceServer) GetProjectNamespace(ctx context.Context, req *pb.GetProjectNamespaceRequest) (*pb.GetProjectNamespaceResponse, error) {
+	projectRepo := sv.projectRepoFactory.New()
+	projSpec, err := projectRepo.GetByName(ctx, req.GetProjectName())
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "%s: project %s not found", err.Error(), req.GetProjectName())
+	}
+
+	namespaceRepo := sv.namespaceRepoFactory.New(projSpec)
+	namespaceSpec, err := namespaceRepo.GetByName(ctx, req.GetNamespaceName())
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "%s: namespace %s not found", err.Error(), req.GetNamespaceName())
+	}
+
+	return &pb.GetProjectNamespaceResponse{
+		Namespace:       sv.adapter.ToNamespaceProto(namespaceSpec),
+		EffectiveConfig: namespaceSpec.EffectiveConfig(),
+	}, nil
+}
+
+// UpdateProjectNamespaceConfig changes only the requested keys of namespace config,
+// keys are upper cased the same way as when namespace is registered
+func (sv *RuntimeServiceServer) UpdateProjectNamespaceConfig(ctx context.Context, req *pb.UpdateProjectNamespaceConfigRequest) (*pb.UpdateProjectNamespaceConfigResponse, error) {
+	if len(req.GetSetConfig()) == 0 && len(req.GetUnsetConfig()) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "no config to set or unset")
+	}
+
+	projectRepo := sv.projectRepoFactory.New()
+	projSpec, err := projectRepo.GetByName(ctx, req.GetProjectName())
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "%s: project %s not found", err.Error(), req.GetProjectName())
+	}
+
+	namespaceRepo := sv.namespaceRepoFactory.New(projSpec)
+	namespaceSpec, err := namespaceRepo.GetByName(ctx, req.GetNamespaceName())
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "%s: namespace %s not found", err.Error(), req.GetNamespaceName())
+	}
+
+	namespaceConf := map[string]string{}
+	for key, val := range namespaceSpec.Config {
+		namespaceConf[key] = val
+	}
+	for key, val := range req.GetSetConfig() {
+		namespaceConf[strings.ToUpper(key)] = val
+	}
+	for _, key := range req.GetUnsetConfig() {
+		if _, ok := namespaceConf[strings.ToUpper(key)]; !ok {
+			return nil, status.Errorf(codes.InvalidArgument, "config %s is not set for namespace %s", key, namespaceSpec.Name)
+		}
+		delete(namespaceConf, strings.ToUpper(key))
+	}
+	namespaceSpec.Config = namespaceConf
+
+	if err = namespaceRepo.Save(ctx, namespaceSpec); err != nil {
+		if errors.Is(err, store.ErrEmptyConfig) {
+			return nil, status.Errorf(codes.FailedPrecondition, "%s", err.Error())
+		}
+		return nil, status.Errorf(codes.Internal, "%s: failed to save namespace %s for project %s", err.Error(), namespaceSpec.Name, projSpec.Name)
+	}
+
+	return &pb.UpdateProjectNamespaceConfigResponse{
+		Success:         true,
+		Message:         fmt.Sprintf("config of namespace %s has been updated", namespaceSpec.Name),
+		Namespace:       sv.adapter.ToNamespaceProto(namespaceSpec),
+		EffectiveConfig: namespaceSpec.EffectiveConfig(),
+	}, nil
+}
+
+func (sv *RuntimeServiceServer) DeleteProjectNamespace(ctx context.Context, req *pb.DeleteProjectNamespaceRequest) (*pb.DeleteProjectNamespaceResponse, error) {
+	projectRepo := sv.projectRepoFactory.New()
+	projSpec, err := projectRepo.GetByName(ctx, req.GetProjectName())
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "%s: project %s not found", err.Error(), req.GetProjectName())
+	}
+
+	namespaceRepo := sv.namespaceRepoFactory.New(projSpec)
+	namespaceSpec, err := namespaceRepo.GetByName(ctx, req.GetNamespaceName())
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "%s: namespace %s not found", err.Error(), req.GetNamespaceName())
+	}
+
+	plan, err := namespaceRepo.GetDeletionPlan(ctx, namespaceSpec.Name)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "%s: failed to list contents of namespace %s", err.Error(), namespaceSpec.Name)
+	}
+	if err := sv.checkExternalDownstream(ctx, plan, projSpec, namespaceSpec.Name); err != nil {
+		return nil, err
+	}
+	secretRepo := sv.secretRepoFactory.New(projSpec, namespaceSpec)
+	externalSecrets, err := listExternalSecrets(ctx, secretRepo, plan, namespaceSpec.ID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "%s: failed to list secrets of namespace %s", err.Error(), namespaceSpec.Name)
+	}
+	plan.Secrets = append(plan.Secrets, externalSecrets...)
+	sort.Strings(plan.Secrets)
+	if req.GetDryRun() {
+		return &pb.DeleteProjectNamespaceResponse{
+			Success: true,
+			Message: fmt.Sprintf("namespace %s can be deleted", namespaceSpec.Name),
+			Plan:    toDeletionPlanProto(plan),
+		}, nil
+	}
+
+	for _, name := range externalSecrets {
+		if err := secretRepo.Delete(ctx, name); err != nil {
+			return nil, status.Errorf(codes.Internal, "%s: failed to delete secret %s of namespace %s", err.Error(), name, namespaceSpec.Name)
+		}
+	}
+	if err := namespaceRepo.Delete(ctx, namespaceSpec.Name); err != nil {
+		return nil, status.Errorf(codes.Internal, "%s: failed to delete namespace %s", err.Error(), namespaceSpec.Name)
+	}
+	// jobs are removed from scheduler once they are gone from db, so that they
+	// are never left in db without being scheduled
+	if err := sv.deleteScheduledJobs(ctx, namespaceSpec, plan.Namespaces[0].Jobs); err != nil {
+		return nil, status.Errorf(codes.Internal, "namespace %s has been deleted but its jobs are left in scheduler: %s",
+			namespaceSpec.Name, err.Error())
+	}
+	return &pb.DeleteProjectNamespaceResponse{
+		Success: true,
+		Message: fmt.Sprintf("namespace %s has been deleted", namespaceSpec.Name),
+		Plan:    toDeletionPlanProto(plan),
+	}, nil
+}
+
+func (sv *RuntimeServiceServer) DeleteProject(ctx context.Context, req *pb.DeleteProjectRequest) (*pb.DeleteProjectResponse, error) {
+	projectRepo := sv.projectRepoFactory.New()
+	projSpec, err := projectRepo.GetByName(ctx, req.GetProjectName())
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "%s: project %s not found", err.Error(), req.GetProjectName())
+	}
+
+	plan, err := projectRepo.GetDeletionPlan(ctx, projSpec.Name)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "%s: failed to list contents of project %s", err.Error(), projSpec.Name)
+	}
+	if err := sv.checkExternalDownstream(ctx, plan, projSpec, ""); err != nil {
+		return nil, err
+	}
+	secretRepo := sv.secretRepoFactory.New(projSpec, models.NamespaceSpec{})
+	externalSecrets, err := listExternalSecrets(ctx, secretRepo, plan, uuid.Nil)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "%s: failed to list secrets of project %s", err.Error(), projSpec.Name)
+	}
+	plan.Secrets = append(plan.Secrets, externalSecrets...)
+	sort.Strings(plan.Secrets)
+	if req.GetDryRun() {
+		return &pb.DeleteProjectResponse{
+			Success: true,
+			Message: fmt.Sprintf("project %s can be deleted", projSpec.Name),
+			Plan:    toDeletionPlanProto(plan),
+		}, nil
+	}
+
+	// namespaces are looked up before they are removed, to remove their jobs
+	// from scheduler afterwards
+	namespaceRepo := sv.namespaceRepoFactory.New(projSpec)
+	namespaceSpecs := map[string]models.NamespaceSpec{}
+	for _, namespacePlan := range plan.Namespaces {
+		if len(namespacePlan.Jobs) == 0 {
+			continue
+		}
+		namespaceSpec, err := namespaceRepo.GetByName(ctx, namespacePlan.Name)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "%s: failed to find namespace %s", err.Error(), namespacePlan.Name)
+		}
+		namespaceSpecs[namespacePlan.Name] = namespaceSpec
+	}
+	// secrets outside of db are removed first so that deletion can be retried
+	// while the project, along with credentials of its secret backend, still exists
+	for _, name := range externalSecrets {
+		if err := secretRepo.Delete(ctx, name); err != nil {
+			return nil, status.Errorf(codes.Internal, "%s: failed to delete secret %s of project %s", err.Error(), name, projSpec.Name)
+		}
+	}
+	if err := projectRepo.Delete(ctx, projSpec.Name); err != nil {
+		return nil, status.Errorf(codes.Internal, "%s: failed to delete project %s", err.Error(), projSpec.Name)
+	}
+	for _, namespacePlan := range plan.Namespaces {
+		if err := sv.deleteScheduledJobs(ctx, namespaceSpecs[namespacePlan.Name], namespacePlan.Jobs); err != nil {
+			return nil, status.Errorf(codes.Internal, "project %s has been deleted but its jobs are left in scheduler: %s",
+				projSpec.Name, err.Error())
+		}
+	}
+	return &pb.DeleteProjectResponse{
+		Success: true,
+		Message: fmt.Sprintf("project %s has been deleted", projSpec.Name),
+		Plan:    toDeletionPlanProto(plan),
+	}, nil
+}
+
+// checkExternalDownstream fails if jobs outside of the scope being deleted,
+// in any project, depend on the jobs in scope
+func (sv *RuntimeServiceServer) checkExternalDownstream(ctx context.Context, plan models.DeletionPlan,
+	scopeProject models.ProjectSpec, scopeNamespace string) error {
+	hasJobs := false
+	for _, namespacePlan := range plan.Namespaces {
+		hasJobs = hasJobs || len(namespacePlan.Jobs) > 0
+	}
+	if !hasJobs {
+		return nil
+	}
+
+	projects, err := sv.projectRepoFactory.New().GetAll(ctx)
+	if err != nil {
+		return status.Errorf(codes.Internal, "%s: failed to retrieve saved projects", err.Error())
+	}
+	var downstream []string
+	for _, projSpec := range projects {
+		if scopeNamespace == "" && projSpec.Name == scopeProject.Name {
+			continue
+		}
+		jobSpecs, err := sv.jobSvc.GetExternalDownstream(ctx, projSpec, scopeProject, scopeNamespace)
+		if err != nil {
+			return status.Errorf(codes.Internal, "%s: failed to resolve dependencies of project %s", err.Error(), projSpec.Name)
+		}
+		for _, jobSpec := range jobSpecs {
+			downstream = append(downstream, fmt.Sprintf("%s/%s", projSpec.Name, jobSpec.Name))
+		}
+	}
+	if len(downstream) > 0 {
+		scope := scopeProject.Name
+		if scopeNamespace != "" {
+			scope = fmt.Sprintf("%s/%s", scopeProject.Name, scopeNamespace)
+		}
+		return status.Errorf(codes.FailedPrecondition, "%s can't be deleted as it has downstream jobs: %s",
+			scope, strings.Join(downstream, ", "))
+	}
+	return nil
+}
+
+// listExternalSecrets lists secrets of project kept by its secret backend
+// outside of db, these are not part of plan of db and not removed with it.
+// Only secrets of the namespace are listed if namespaceID is set
+func listExternalSecrets(ctx context.Context, secretRepo store.ProjectSecretRepository, plan models.DeletionPlan,
+	namespaceID uuid.UUID) ([]string, error) {
+	inDB := map[string]bool{}
+	for _, name := range plan.Secrets {
+		inDB[name] = true
+	}
+	secrets, err := secretRepo.GetAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var external []string
+	for _, secret := range secrets {
+		if namespaceID != uuid.Nil && secret.NamespaceID != namespaceID {
+			continue
+		}
+		if !inDB[secret.Name] {
+			external = append(external, secret.Name)
+		}
+	}
+	return external, nil
+}
+
+func (sv *RuntimeServiceServer) deleteScheduledJobs(ctx context.Context, namespaceSpec models.NamespaceSpec, jobNames []string) error {
+	if len(jobNames) == 0 {
+		return nil
+	}
+	if err := sv.scheduler.DeleteJobs(ctx, namespaceSpec, jobNames, nil); err != nil {
+		return status.Errorf(codes.Internal, "%s: failed to delete jobs of namespace %s from scheduler",
+			err.Error(), namespaceSpec.Name)
+	}
+	return nil
+}
+
+func toDeletionPlanProto(plan models.DeletionPlan) *pb.DeletionPlan {
+	planProto := &pb.DeletionPlan{
+		Secrets: plan.Secrets,
+		Replays: int32(plan.Replays),
+		Backups: int32(plan.Backups),
+	}
+	for _, namespacePlan := range plan.Namespaces {
+		planProto.Namespaces = append(planProto.Namespaces, &pb.DeletionPlan_Namespace{
+			Name:      namespacePlan.Name,
+			Jobs:      namespacePlan.Jobs,
+			Resources: namespacePlan.Resources,
+		})
+	}
+	return planProto
+}
@@ -0,0 +1,541 @@
+package v1beta1_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	v1 "github.com/odpf/optimus/api/handler/v1beta1"
+	pb "github.com/odpf/optimus/api/proto/odpf/optimus/core/v1beta1"
+	"github.com/odpf/optimus/mock"
+	"github.com/odpf/optimus/models"
+	"github.com/odpf/optimus/store"
+	"github.com/odpf/salt/log"
+	"github.com/stretchr/testify/assert"
+	mock2 "github.com/stretchr/testify/mock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestProjectDeletionOnRuntimeServer(t *testing.T) {
+	noop := log.NewNoop()
+	ctx := context.Background()
+
+	projectSpec := models.ProjectSpec{
+		ID:   uuid.New(),
+		Name: "a-data-project",
+		Config: map[string]string{
+			"BUCKET": "gs://some_folder",
+		},
+	}
+	otherProjectSpec := models.ProjectSpec{
+		ID:   uuid.New(),
+		Name: "b-data-project",
+	}
+	namespaceSpec := models.NamespaceSpec{
+		ID:          uuid.New(),
+		Name:        "dev-test-namespace-1",
+		ProjectSpec: projectSpec,
+	}
+	namespacePlan := models.DeletionPlan{
+		Namespaces: []models.NamespaceDeletionPlan{
+			{
+				Name:      namespaceSpec.Name,
+				Jobs:      []string{"a-data-job"},
+				Resources: []string{"a-data-project.dataset.table"},
+			},
+		},
+		Secrets: []string{"secret-1"},
+		Replays: 2,
+		Backups: 1,
+	}
+	namespacePlanProto := &pb.DeletionPlan{
+		Namespaces: []*pb.DeletionPlan_Namespace{
+			{
+				Name:      namespaceSpec.Name,
+				Jobs:      []string{"a-data-job"},
+				Resources: []string{"a-data-project.dataset.table"},
+			},
+		},
+		Secrets: []string{"secret-1"},
+		Replays: 2,
+		Backups: 1,
+	}
+
+	newServer := func(jobService models.JobService, projectRepository *mock.ProjectRepository,
+		namespaceRepository *mock.NamespaceRepository, scheduler models.SchedulerUnit,
+		secretRepository *mock.ProjectSecretRepository) *v1.RuntimeServiceServer {
+		projectRepoFactory := new(mock.ProjectRepoFactory)
+		projectRepoFactory.On("New").Return(projectRepository)
+		namespaceRepoFactory := new(mock.NamespaceRepoFactory)
+		namespaceRepoFactory.On("New", projectSpec).Return(namespaceRepository)
+		secretRepoFactory := new(mock.ProjectSecretRepoFactory)
+		secretRepoFactory.On("New", projectSpec, models.NamespaceSpec{}).Return(secretRepository)
+		secretRepoFactory.On("New", projectSpec, namespaceSpec).Return(secretRepository)
+		return v1.NewRuntimeServiceServer(
+			noop,
+			"someVersion1.0",
+			jobService,
+			nil, nil,
+			projectRepoFactory,
+			namespaceRepoFactory,
+			secretRepoFactory,
+			nil,
+			nil,
+			nil,
+			scheduler,
+			nil,
+			nil,
+		)
+	}
+
+	t.Run("DeleteProjectNamespace", func(t *testing.T) {
+		t.Run("should list contents of namespace without deleting in dry run", func(t *testing.T) {
+			projectRepository := new(mock.ProjectRepository)
+			projectRepository.On("GetByName", ctx, projectSpec.Name).Return(projectSpec, nil)
+			projectRepository.On("GetAll", ctx).Return([]models.ProjectSpec{projectSpec, otherProjectSpec}, nil)
+			defer projectRepository.AssertExpectations(t)
+
+			namespaceRepository := new(mock.NamespaceRepository)
+			namespaceRepository.On("GetByName", ctx, namespaceSpec.Name).Return(namespaceSpec, nil)
+			namespaceRepository.On("GetDeletionPlan", ctx, namespaceSpec.Name).Return(namespacePlan, nil)
+			defer namespaceRepository.AssertExpectations(t)
+
+			jobService := new(mock.JobService)
+			jobService.On("GetExternalDownstream", ctx, projectSpec, projectSpec, namespaceSpec.Name).Return([]models.JobSpec{}, nil)
+			jobService.On("GetExternalDownstream", ctx, otherProjectSpec, projectSpec, namespaceSpec.Name).Return([]models.JobSpec{}, nil)
+			defer jobService.AssertExpectations(t)
+
+			scheduler := new(mock.Scheduler)
+			defer scheduler.AssertExpectations(t)
+
+			secretRepository := new(mock.ProjectSecretRepository)
+			secretRepository.On("GetAll", ctx).Return([]models.ProjectSecretItem{
+				{Name: "secret-1", NamespaceID: namespaceSpec.ID},
+				{Name: "vault-secret", NamespaceID: namespaceSpec.ID},
+				{Name: "vault-project-secret"},
+				{Name: "vault-other-namespace-secret", NamespaceID: uuid.New()},
+			}, nil)
+			defer secretRepository.AssertExpectations(t)
+
+			planProto := proto.Clone(namespacePlanProto).(*pb.DeletionPlan)
+			planProto.Secrets = []string{"secret-1", "vault-secret"}
+
+			runtimeServiceServer := newServer(jobService, projectRepository, namespaceRepository, scheduler, secretRepository)
+			resp, err := runtimeServiceServer.DeleteProjectNamespace(ctx, &pb.DeleteProjectNamespaceRequest{
+				ProjectName:   projectSpec.Name,
+				NamespaceName: namespaceSpec.Name,
+				DryRun:        true,
+			})
+			assert.Nil(t, err)
+			assert.Equal(t, &pb.DeleteProjectNamespaceResponse{
+				Success: true,
+				Message: "namespace dev-test-namespace-1 can be deleted",
+				Plan:    planProto,
+			}, resp)
+		})
+		t.Run("should delete secrets outside of db and namespace and then its jobs from scheduler", func(t *testing.T) {
+			projectRepository := new(mock.ProjectRepository)
+			projectRepository.On("GetByName", ctx, projectSpec.Name).Return(projectSpec, nil)
+			projectRepository.On("GetAll", ctx).Return([]models.ProjectSpec{projectSpec}, nil)
+			defer projectRepository.AssertExpectations(t)
+
+			namespaceRepository := new(mock.NamespaceRepository)
+			namespaceRepository.On("GetByName", ctx, namespaceSpec.Name).Return(namespaceSpec, nil)
+			namespaceRepository.On("GetDeletionPlan", ctx, namespaceSpec.Name).Return(namespacePlan, nil)
+			var deleted []string
+			namespaceRepository.On("Delete", ctx, namespaceSpec.Name).Return(nil).Run(func(args mock2.Arguments) {
+				deleted = append(deleted, "namespace")
+			})
+			defer namespaceRepository.AssertExpectations(t)
+
+			jobService := new(mock.JobService)
+			jobService.On("GetExternalDownstream", ctx, projectSpec, projectSpec, namespaceSpec.Name).Return([]models.JobSpec{}, nil)
+			defer jobService.AssertExpectations(t)
+
+			scheduler := new(mock.Scheduler)
+			scheduler.On("DeleteJobs", ctx, namespaceSpec, []string{"a-data-job"}, nil).Return(nil).Run(func(args mock2.Arguments) {
+				deleted = append(deleted, "jobs")
+			})
+			defer scheduler.AssertExpectations(t)
+
+			secretRepository := new(mock.ProjectSecretRepository)
+			secretRepository.On("GetAll", ctx).Return([]models.ProjectSecretItem{
+				{Name: "secret-1", NamespaceID: namespaceSpec.ID},
+				{Name: "vault-secret", NamespaceID: namespaceSpec.ID},
+			}, nil)
+			secretRepository.On("Delete", ctx, "vault-secret").Return(nil).Run(func(args mock2.Arguments) {
+				deleted = append(deleted, "vault-secret")
+			})
+			defer secretRepository.AssertExpectations(t)
+
+			runtimeServiceServer := newServer(jobService, projectRepository, namespaceRepository, scheduler, secretRepository)
+			resp, err := runtimeServiceServer.DeleteProjectNamespace(ctx, &pb.DeleteProjectNamespaceRequest{
+				ProjectName:   projectSpec.Name,
+				NamespaceName: namespaceSpec.Name,
+			})
+			assert.Nil(t, err)
+			assert.Equal(t, "namespace dev-test-namespace-1 has been deleted", resp.GetMessage())
+			assert.Equal(t, []string{"secret-1", "vault-secret"}, resp.GetPlan().GetSecrets())
+			assert.Equal(t, []string{"vault-secret", "namespace", "jobs"}, deleted)
+		})
+		t.Run("should report jobs left in scheduler if they can't be deleted after namespace", func(t *testing.T) {
+			projectRepository := new(mock.ProjectRepository)
+			projectRepository.On("GetByName", ctx, projectSpec.Name).Return(projectSpec, nil)
+			projectRepository.On("GetAll", ctx).Return([]models.ProjectSpec{projectSpec}, nil)
+
+			namespaceRepository := new(mock.NamespaceRepository)
+			namespaceRepository.On("GetByName", ctx, namespaceSpec.Name).Return(namespaceSpec, nil)
+			namespaceRepository.On("GetDeletionPlan", ctx, namespaceSpec.Name).Return(namespacePlan, nil)
+			namespaceRepository.On("Delete", ctx, namespaceSpec.Name).Return(nil)
+			defer namespaceRepository.AssertExpectations(t)
+
+			jobService := new(mock.JobService)
+			jobService.On("GetExternalDownstream", ctx, projectSpec, projectSpec, namespaceSpec.Name).Return([]models.JobSpec{}, nil)
+
+			scheduler := new(mock.Scheduler)
+			scheduler.On("DeleteJobs", ctx, namespaceSpec, []string{"a-data-job"}, nil).Return(errors.New("bucket not found"))
+
+			secretRepository := new(mock.ProjectSecretRepository)
+			secretRepository.On("GetAll", ctx).Return([]models.ProjectSecretItem{}, nil)
+
+			runtimeServiceServer := newServer(jobService, projectRepository, namespaceRepository, scheduler, secretRepository)
+			_, err := runtimeServiceServer.DeleteProjectNamespace(ctx, &pb.DeleteProjectNamespaceRequest{
+				ProjectName:   projectSpec.Name,
+				NamespaceName: namespaceSpec.Name,
+			})
+			assert.Equal(t, codes.Internal, status.Code(err))
+			assert.Contains(t, err.Error(), "namespace dev-test-namespace-1 has been deleted but its jobs are left in scheduler")
+			assert.Contains(t, err.Error(), "bucket not found")
+		})
+		t.Run("should refuse to delete namespace if jobs outside of it depend on its jobs", func(t *testing.T) {
+			projectRepository := new(mock.ProjectRepository)
+			projectRepository.On("GetByName", ctx, projectSpec.Name).Return(projectSpec, nil)
+			projectRepository.On("GetAll", ctx).Return([]models.ProjectSpec{projectSpec, otherProjectSpec}, nil)
+			defer projectRepository.AssertExpectations(t)
+
+			namespaceRepository := new(mock.NamespaceRepository)
+			namespaceRepository.On("GetByName", ctx, namespaceSpec.Name).Return(namespaceSpec, nil)
+			namespaceRepository.On("GetDeletionPlan", ctx, namespaceSpec.Name).Return(namespacePlan, nil)
+			defer namespaceRepository.AssertExpectations(t)
+
+			jobService := new(mock.JobService)
+			jobService.On("GetExternalDownstream", ctx, projectSpec, projectSpec, namespaceSpec.Name).
+				Return([]models.JobSpec{{Name: "downstream-job"}}, nil)
+			jobService.On("GetExternalDownstream", ctx, otherProjectSpec, projectSpec, namespaceSpec.Name).
+				Return([]models.JobSpec{{Name: "inter-project-job"}}, nil)
+			defer jobService.AssertExpectations(t)
+
+			scheduler := new(mock.Scheduler)
+			defer scheduler.AssertExpectations(t)
+
+			runtimeServiceServer := newServer(jobService, projectRepository, namespaceRepository, scheduler, nil)
+			_, err := runtimeServiceServer.DeleteProjectNamespace(ctx, &pb.DeleteProjectNamespaceRequest{
+				ProjectName:   projectSpec.Name,
+				NamespaceName: namespaceSpec.Name,
+			})
+			assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+			assert.Equal(t, "rpc error: code = FailedPrecondition desc = a-data-project/dev-test-namespace-1 can't be deleted "+
+				"as it has downstream jobs: a-data-project/downstream-job, b-data-project/inter-project-job", err.Error())
+		})
+	})
+	t.Run("DeleteProject", func(t *testing.T) {
+		t.Run("should delete project and then jobs of all its namespaces from scheduler", func(t *testing.T) {
+			projectPlan := models.DeletionPlan{
+				Namespaces: []models.NamespaceDeletionPlan{
+					namespacePlan.Namespaces[0],
+					{Name: "dev-test-namespace-2"},
+				},
+				Secrets: []string{"secret-1", "secret-2"},
+			}
+
+			projectRepository := new(mock.ProjectRepository)
+			projectRepository.On("GetByName", ctx, projectSpec.Name).Return(projectSpec, nil)
+			projectRepository.On("GetDeletionPlan", ctx, projectSpec.Name).Return(projectPlan, nil)
+			projectRepository.On("GetAll", ctx).Return([]models.ProjectSpec{projectSpec, otherProjectSpec}, nil)
+			var deleted []string
+			projectRepository.On("Delete", ctx, projectSpec.Name).Return(nil).Run(func(args mock2.Arguments) {
+				deleted = append(deleted, "project")
+			})
+			defer projectRepository.AssertExpectations(t)
+
+			namespaceRepository := new(mock.NamespaceRepository)
+			namespaceRepository.On("GetByName", ctx, namespaceSpec.Name).Return(namespaceSpec, nil)
+			defer namespaceRepository.AssertExpectations(t)
+
+			jobService := new(mock.JobService)
+			jobService.On("GetExternalDownstream", ctx, otherProjectSpec, projectSpec, "").Return([]models.JobSpec{}, nil)
+			defer jobService.AssertExpectations(t)
+
+			scheduler := new(mock.Scheduler)
+			scheduler.On("DeleteJobs", ctx, namespaceSpec, []string{"a-data-job"}, nil).Return(nil).Run(func(args mock2.Arguments) {
+				deleted = append(deleted, "jobs")
+			})
+			defer scheduler.AssertExpectations(t)
+
+			secretRepository := new(mock.ProjectSecretRepository)
+			secretRepository.On("GetAll", ctx).Return([]models.ProjectSecretItem{
+				{Name: "secret-1"}, {Name: "secret-2"},
+			}, nil)
+			defer secretRepository.AssertExpectations(t)
+
+			runtimeServiceServer := newServer(jobService, projectRepository, namespaceRepository, scheduler, secretRepository)
+			resp, err := runtimeServiceServer.DeleteProject(ctx, &pb.DeleteProjectRequest{
+				ProjectName: projectSpec.Name,
+			})
+			assert.Nil(t, err)
+			assert.Equal(t, "project a-data-project has been deleted", resp.GetMessage())
+			assert.Equal(t, 2, len(resp.GetPlan().GetNamespaces()))
+			assert.Equal(t, []string{"secret-1", "secret-2"}, resp.GetPlan().GetSecrets())
+			assert.Equal(t, []string{"project", "jobs"}, deleted)
+		})
+		t.Run("should list secrets kept outside of db without deleting in dry run", func(t *testing.T) {
+			projectPlan := models.DeletionPlan{
+				Namespaces: []models.NamespaceDeletionPlan{{Name: namespaceSpec.Name}},
+				Secrets:    []string{"SECRET_BACKEND_AUTH"},
+			}
+
+			projectRepository := new(mock.ProjectRepository)
+			projectRepository.On("GetByName", ctx, projectSpec.Name).Return(projectSpec, nil)
+			projectRepository.On("GetDeletionPlan", ctx, projectSpec.Name).Return(projectPlan, nil)
+			defer projectRepository.AssertExpectations(t)
+
+			secretRepository := new(mock.ProjectSecretRepository)
+			secretRepository.On("GetAll", ctx).Return([]models.ProjectSecretItem{
+				{Name: "vault-secret"}, {Name: "SECRET_BACKEND_AUTH"},
+			}, nil)
+			defer secretRepository.AssertExpectations(t)
+
+			runtimeServiceServer := newServer(nil, projectRepository, nil, nil, secretRepository)
+			resp, err := runtimeServiceServer.DeleteProject(ctx, &pb.DeleteProjectRequest{
+				ProjectName: projectSpec.Name,
+				DryRun:      true,
+			})
+			assert.Nil(t, err)
+			assert.Equal(t, "project a-data-project can be deleted", resp.GetMessage())
+			assert.Equal(t, []string{"SECRET_BACKEND_AUTH", "vault-secret"}, resp.GetPlan().GetSecrets())
+		})
+		t.Run("should delete secrets kept outside of db before project", func(t *testing.T) {
+			projectPlan := models.DeletionPlan{
+				Namespaces: []models.NamespaceDeletionPlan{{Name: namespaceSpec.Name}},
+				Secrets:    []string{"SECRET_BACKEND_AUTH"},
+			}
+
+			projectRepository := new(mock.ProjectRepository)
+			projectRepository.On("GetByName", ctx, projectSpec.Name).Return(projectSpec, nil)
+			projectRepository.On("GetDeletionPlan", ctx, projectSpec.Name).Return(projectPlan, nil)
+			projectRepository.On("Delete", ctx, projectSpec.Name).Return(nil)
+			defer projectRepository.AssertExpectations(t)
+
+			secretRepository := new(mock.ProjectSecretRepository)
+			secretRepository.On("GetAll", ctx).Return([]models.ProjectSecretItem{
+				{Name: "vault-secret"}, {Name: "SECRET_BACKEND_AUTH"},
+			}, nil)
+			secretRepository.On("Delete", ctx, "vault-secret").Return(nil)
+			defer secretRepository.AssertExpectations(t)
+
+			runtimeServiceServer := newServer(nil, projectRepository, nil, nil, secretRepository)
+			resp, err := runtimeServiceServer.DeleteProject(ctx, &pb.DeleteProjectRequest{
+				ProjectName: projectSpec.Name,
+			})
+			assert.Nil(t, err)
+			assert.Equal(t, "project a-data-project has been deleted", resp.GetMessage())
+		})
+		t.Run("should not delete project if secrets outside of db can't be deleted", func(t *testing.T) {
+			projectPlan := models.DeletionPlan{
+				Namespaces: []models.NamespaceDeletionPlan{{Name: namespaceSpec.Name}},
+			}
+
+			projectRepository := new(mock.ProjectRepository)
+			projectRepository.On("GetByName", ctx, projectSpec.Name).Return(projectSpec, nil)
+			projectRepository.On("GetDeletionPlan", ctx, projectSpec.Name).Return(projectPlan, nil)
+			defer projectRepository.AssertExpectations(t)
+
+			secretRepository := new(mock.ProjectSecretRepository)
+			secretRepository.On("GetAll", ctx).Return([]models.ProjectSecretItem{{Name: "vault-secret"}}, nil)
+			secretRepository.On("Delete", ctx, "vault-secret").Return(errors.New("permission denied"))
+			defer secretRepository.AssertExpectations(t)
+
+			runtimeServiceServer := newServer(nil, projectRepository, nil, nil, secretRepository)
+			_, err := runtimeServiceServer.DeleteProject(ctx, &pb.DeleteProjectRequest{
+				ProjectName: projectSpec.Name,
+			})
+			assert.Equal(t, codes.Internal, status.Code(err))
+		})
+	})
+}
+
+func TestProjectConfigOnRuntimeServer(t *testing.T) {
+	noop := log.NewNoop()
+	ctx := context.Background()
+
+	projectSpec := models.ProjectSpec{
+		ID:   uuid.New(),
+		Name: "a-data-project",
+		Config: map[string]string{
+			"BUCKET":      "gs://some_folder",
+			"ENVIRONMENT": "integration",
+		},
+	}
+	namespaceSpec := models.NamespaceSpec{
+		ID:   uuid.New(),
+		Name: "dev-test-namespace-1",
+		Config: map[string]string{
+			"BUCKET":  "gs://namespace_folder",
+			"DATASET": "playground",
+		},
+		ProjectSpec: projectSpec,
+	}
+
+	newServer := func(projectRepository *mock.ProjectRepository, namespaceRepository *mock.NamespaceRepository) *v1.RuntimeServiceServer {
+		projectRepoFactory := new(mock.ProjectRepoFactory)
+		projectRepoFactory.On("New").Return(projectRepository)
+		namespaceRepoFactory := new(mock.NamespaceRepoFactory)
+		namespaceRepoFactory.On("New", projectSpec).Return(namespaceRepository)
+		return v1.NewRuntimeServiceServer(
+			noop,
+			"someVersion1.0",
+			nil,
+			nil, nil,
+			projectRepoFactory,
+			namespaceRepoFactory,
+			nil,
+			v1.NewAdapter(nil, nil),
+			nil,
+			nil,
+			nil,
+			nil,
+			nil,
+		)
+	}
+
+	t.Run("GetProject", func(t *testing.T) {
+		t.Run("should return config of project along with its namespaces", func(t *testing.T) {
+			projectRepository := new(mock.ProjectRepository)
+			projectRepository.On("GetByName", ctx, projectSpec.Name).Return(projectSpec, nil)
+			defer projectRepository.AssertExpectations(t)
+
+			namespaceRepository := new(mock.NamespaceRepository)
+			namespaceRepository.On("GetAll", ctx).Return([]models.NamespaceSpec{namespaceSpec}, nil)
+			defer namespaceRepository.AssertExpectations(t)
+
+			runtimeServiceServer := newServer(projectRepository, namespaceRepository)
+			resp, err := runtimeServiceServer.GetProject(ctx, &pb.GetProjectRequest{
+				ProjectName: projectSpec.Name,
+			})
+			assert.Nil(t, err)
+			assert.Equal(t, &pb.GetProjectResponse{
+				Project: &pb.ProjectSpecification{
+					Name:   projectSpec.Name,
+					Config: projectSpec.Config,
+				},
+				Namespaces: []*pb.NamespaceSpecification{
+					{
+						Name:   namespaceSpec.Name,
+						Config: namespaceSpec.Config,
+					},
+				},
+			}, resp)
+		})
+		t.Run("should return not found if project is not registered", func(t *testing.T) {
+			projectRepository := new(mock.ProjectRepository)
+			projectRepository.On("GetByName", ctx, projectSpec.Name).Return(models.ProjectSpec{}, store.ErrResourceNotFound)
+			defer projectRepository.AssertExpectations(t)
+
+			runtimeServiceServer := newServer(projectRepository, nil)
+			_, err := runtimeServiceServer.GetProject(ctx, &pb.GetProjectRequest{
+				ProjectName: projectSpec.Name,
+			})
+			assert.Equal(t, codes.NotFound, status.Code(err))
+		})
+	})
+	t.Run("GetProjectNamespace", func(t *testing.T) {
+		t.Run("should return config of namespace merged with config of project", func(t *testing.T) {
+			projectRepository := new(mock.ProjectRepository)
+			projectRepository.On("GetByName", ctx, projectSpec.Name).Return(projectSpec, nil)
+			defer projectRepository.AssertExpectations(t)
+
+			namespaceRepository := new(mock.NamespaceRepository)
+			namespaceRepository.On("GetByName", ctx, namespaceSpec.Name).Return(namespaceSpec, nil)
+			defer namespaceRepository.AssertExpectations(t)
+
+			runtimeServiceServer := newServer(projectRepository, namespaceRepository)
+			resp, err := runtimeServiceServer.GetProjectNamespace(ctx, &pb.GetProjectNamespaceRequest{
+				ProjectName:   projectSpec.Name,
+				NamespaceName: namespaceSpec.Name,
+			})
+			assert.Nil(t, err)
+			assert.Equal(t, &pb.GetProjectNamespaceResponse{
+				Namespace: &pb.NamespaceSpecification{
+					Name:   namespaceSpec.Name,
+					Config: namespaceSpec.Config,
+				},
+				EffectiveConfig: map[string]string{
+					"BUCKET":      "gs://namespace_folder",
+					"DATASET":     "playground",
+					"ENVIRONMENT": "integration",
+				},
+			}, resp)
+		})
+	})
+	t.Run("UpdateProjectNamespaceConfig", func(t *testing.T) {
+		t.Run("should set and unset only requested keys of namespace config", func(t *testing.T) {
+			projectRepository := new(mock.ProjectRepository)
+			projectRepository.On("GetByName", ctx, projectSpec.Name).Return(projectSpec, nil)
+			defer projectRepository.AssertExpectations(t)
+
+			updatedNamespaceSpec := namespaceSpec
+			updatedNamespaceSpec.Config = map[string]string{
+				"DATASET":     "playground",
+				"ENVIRONMENT": "staging",
+			}
+			namespaceRepository := new(mock.NamespaceRepository)
+			namespaceRepository.On("GetByName", ctx, namespaceSpec.Name).Return(namespaceSpec, nil)
+			namespaceRepository.On("Save", ctx, updatedNamespaceSpec).Return(nil)
+			defer namespaceRepository.AssertExpectations(t)
+
+			runtimeServiceServer := newServer(projectRepository, namespaceRepository)
+			resp, err := runtimeServiceServer.UpdateProjectNamespaceConfig(ctx, &pb.UpdateProjectNamespaceConfigRequest{
+				ProjectName:   projectSpec.Name,
+				NamespaceName: namespaceSpec.Name,
+				SetConfig:     map[string]string{"environment": "staging"},
+				UnsetConfig:   []string{"bucket"},
+			})
+			assert.Nil(t, err)
+			assert.Equal(t, "config of namespace dev-test-namespace-1 has been updated", resp.GetMessage())
+			assert.Equal(t, map[string]string{
+				"BUCKET":      "gs://some_folder",
+				"DATASET":     "playground",
+				"ENVIRONMENT": "staging",
+			}, resp.GetEffectiveConfig())
+			assert.Equal(t, "gs://namespace_folder", namespaceSpec.Config["BUCKET"])
+		})
+		t.Run("should fail if config to unset is not set for namespace", func(t *testing.T) {
+			projectRepository := new(mock.ProjectRepository)
+			projectRepository.On("GetByName", ctx, projectSpec.Name).Return(projectSpec, nil)
+			defer projectRepository.AssertExpectations(t)
+
+			namespaceRepository := new(mock.NamespaceRepository)
+			namespaceRepository.On("GetByName", ctx, namespaceSpec.Name).Return(namespaceSpec, nil)
+			defer namespaceRepository.AssertExpectations(t)
+
+			runtimeServiceServer := newServer(projectRepository, namespaceRepository)
+			_, err := runtimeServiceServer.UpdateProjectNamespaceConfig(ctx, &pb.UpdateProjectNamespaceConfigRequest{
+				ProjectName:   projectSpec.Name,
+				NamespaceName: namespaceSpec.Name,
+				UnsetConfig:   []string{"ENVIRONMENT"},
+			})
+			assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		})
+		t.Run("should fail if nothing is requested to change", func(t *testing.T) {
+			runtimeServiceServer := newServer(nil, nil)
+			_, err := runtimeServiceServer.UpdateProjectNamespaceConfig(ctx, &pb.UpdateProjectNamespaceConfigRequest{
+				ProjectName:   projectSpec.Name,
+				NamespaceName: namespaceSpec.Name,
+			})
+			assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		})
+	})
+}
@@ -52,6 +52,16 @@ type SecretRepoFactory interface {
 
 type JobEventService interface {
 	Register(context.Context, models.NamespaceSpec, models.JobSpec, models.JobEvent) error
+	List(ctx context.Context, namespace models.NamespaceSpec, jobName string, filter models.JobEventFilter) ([]models.JobEventRecord, error)
+}
+
+// AdminService serves the state of optimus service itself, like its audit
+// log and encryption of stored secrets
+type AdminService interface {
+	ListAuditEntries(ctx context.Context, filter models.AuditFilter) ([]models.AuditEntry, error)
+	// ReencryptSecrets returns the number of secrets re-encrypted, along with
+	// id of the key they are encrypted with
+	ReencryptSecrets(ctx context.Context, batchSize int) (int, string, error)
 }
 
 type ProtoAdapter interface {
@@ -67,6 +77,9 @@ type ProtoAdapter interface {
 
 	FromInstanceProto(*pb.InstanceSpec) (models.InstanceSpec, error)
 	ToInstanceProto(models.InstanceSpec) (*pb.InstanceSpec, error)
+	ToInstanceStatusProto(models.InstanceSpec) *pb.InstanceStatus
+
+	ToJobEventRecordProto(models.JobEventRecord) *pb.RegisteredJobEvent
 
 	FromResourceProto(res *pb.ResourceSpecification, storeName string) (models.ResourceSpec, error)
 	ToResourceProto(res models.ResourceSpec) (*pb.ResourceSpecification, error)
@@ -86,6 +99,8 @@ type RuntimeServiceServer struct {
 	secretRepoFactory    SecretRepoFactory
 	runSvc               models.RunService
 	scheduler            models.SchedulerUnit
+	clusterSvc           models.ClusterService
+	adminSvc             AdminService
 	l                    log.Logger
 
 	progressObserver progress.Observer
@@ -293,9 +308,15 @@ func (sv *RuntimeServiceServer) CheckJobSpecifications(req *pb.CheckJobSpecifica
 }
 
 func (sv *RuntimeServiceServer) RegisterProject(ctx context.Context, req *pb.RegisterProjectRequest) (*pb.RegisterProjectResponse, error) {
-	projectRepo := sv.projectRepoFactory.New()
 	projectSpec := sv.adapter.FromProjectProto(req.GetProject())
+	switch projectSpec.Config[models.ProjectSecretBackend] {
+	case "", models.SecretBackendPostgres, models.SecretBackendVault:
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "unsupported secret backend %s of project %s",
+			projectSpec.Config[models.ProjectSecretBackend], projectSpec.Name)
+	}
 
+	projectRepo := sv.projectRepoFactory.New()
 	if err := projectRepo.Save(ctx, projectSpec); err != nil {
 		if errors.Is(err, store.ErrEmptyConfig) {
 			return nil, status.Errorf(codes.FailedPrecondition, "%s", err.Error())
@@ -513,8 +534,15 @@ func (sv *RuntimeServiceServer) RegisterInstance(ctx context.Context, req *pb.Re
 		if err != nil {
 			return nil, status.Errorf(codes.NotFound, "%s: failed to find scheduled run of job %s", err.Error(), req.JobrunId)
 		}
+		// caller is authorized for the requested project only
+		if namespaceSpec.ProjectSpec.Name != projSpec.Name {
+			return nil, status.Errorf(codes.NotFound, "job run %s not found in project %s", req.JobrunId, projSpec.Name)
+		}
 	}
 
+	// secrets of project are available for templating in instance context
+	namespaceSpec.ProjectSpec.Secret = projSpec.Secret
+
 	instance, err := sv.runSvc.Register(ctx, namespaceSpec, jobRun, instanceType, req.GetInstanceName())
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "%s: failed to register instance of jobrun %s", err.Error(), jobRun)
@@ -566,9 +594,14 @@ func (sv *RuntimeServiceServer) JobStatus(ctx context.Context, req *pb.JobStatus
 	var adaptedJobStatus []*pb.JobStatus
 	for _, jobStatus := range jobStatuses {
 		ts := timestamppb.New(jobStatus.ScheduledAt)
+		var instances []*pb.InstanceStatus
+		for _, instance := range jobStatus.Instances {
+			instances = append(instances, sv.adapter.ToInstanceStatusProto(instance))
+		}
 		adaptedJobStatus = append(adaptedJobStatus, &pb.JobStatus{
 			State:       jobStatus.State.String(),
 			ScheduledAt: ts,
+			Instances:   instances,
 		})
 	}
 	return &pb.JobStatusResponse{
@@ -613,6 +646,53 @@ func (sv *RuntimeServiceServer) RegisterJobEvent(ctx context.Context, req *pb.Re
 	return &pb.RegisterJobEventResponse{}, nil
 }
 
+func (sv *RuntimeServiceServer) ListJobEvents(ctx context.Context, req *pb.ListJobEventsRequest) (*pb.ListJobEventsResponse, error) {
+	projectRepo := sv.projectRepoFactory.New()
+	projSpec, err := projectRepo.GetByName(ctx, req.GetProjectName())
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "%s: project %s not found", err.Error(), req.GetProjectName())
+	}
+
+	namespaceRepo := sv.namespaceRepoFactory.New(projSpec)
+	namespaceSpec, err := namespaceRepo.GetByName(ctx, req.GetNamespaceName())
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "%s: namespace %s not found", err.Error(), req.GetNamespaceName())
+	}
+
+	if req.GetJobName() == "" {
+		return nil, status.Error(codes.InvalidArgument, "job name must be provided")
+	}
+	// events of jobs which are deleted are still listed
+	filter := models.JobEventFilter{
+		Limit: int(req.GetLimit()),
+	}
+	if req.GetSince() != nil {
+		filter.Since = req.GetSince().AsTime()
+	}
+	if req.GetUntil() != nil {
+		filter.Until = req.GetUntil().AsTime()
+	}
+	for _, eventType := range req.GetTypes() {
+		if eventType == pb.JobEvent_TYPE_UNSPECIFIED {
+			return nil, status.Error(codes.InvalidArgument, "type of event must be specified")
+		}
+		filter.Types = append(filter.Types, models.JobEventType(utils.FromEnumProto(eventType.String(), "TYPE")))
+	}
+
+	records, err := sv.jobEventSvc.List(ctx, namespaceSpec, req.GetJobName(), filter)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "%s: failed to list events of job %s", err.Error(), req.GetJobName())
+	}
+
+	var events []*pb.RegisteredJobEvent
+	for _, record := range records {
+		events = append(events, sv.adapter.ToJobEventRecordProto(record))
+	}
+	return &pb.ListJobEventsResponse{
+		Events: events,
+	}, nil
+}
+
 func (sv *RuntimeServiceServer) GetWindow(ctx context.Context, req *pb.GetWindowRequest) (*pb.GetWindowResponse, error) {
 	scheduledTime := req.ScheduledAt.AsTime()
 	err := req.ScheduledAt.CheckValid()
@@ -1176,6 +1256,72 @@ func (sv *RuntimeServiceServer) RunJob(ctx context.Context, req *pb.RunJobReques
 	return &pb.RunJobResponse{}, nil
 }
 
+func (sv *RuntimeServiceServer) GetClusterState(ctx context.Context, req *pb.GetClusterStateRequest) (*pb.GetClusterStateResponse, error) {
+	if sv.clusterSvc == nil {
+		return nil, status.Error(codes.Unavailable, "server is not running as a scheduler cluster peer")
+	}
+	clusterState, err := sv.clusterSvc.GetState(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "error while getting cluster state: %v", err)
+	}
+
+	var members []*pb.ClusterMember
+	for _, member := range clusterState.Members {
+		var allocations []*pb.ClusterAllocation
+		for _, allocation := range member.Allocations {
+			allocations = append(allocations, &pb.ClusterAllocation{
+				RunId:       allocation.RunID,
+				Status:      allocation.Status,
+				AllocatedAt: timestamppb.New(allocation.AllocatedAt),
+			})
+		}
+		members = append(members, &pb.ClusterMember{
+			Name:        member.Name,
+			Address:     member.Address,
+			Status:      member.Status,
+			Drained:     member.Drained,
+			Allocations: allocations,
+		})
+	}
+	return &pb.GetClusterStateResponse{
+		Leader:           clusterState.Leader,
+		AllocationPaused: clusterState.AllocationPaused,
+		Members:          members,
+	}, nil
+}
+
+func (sv *RuntimeServiceServer) ApplyClusterCommand(ctx context.Context, req *pb.ApplyClusterCommandRequest) (*pb.ApplyClusterCommandResponse, error) {
+	if sv.clusterSvc == nil {
+		return nil, status.Error(codes.Unavailable, "server is not running as a scheduler cluster peer")
+	}
+
+	var err error
+	switch req.Type {
+	case pb.ApplyClusterCommandRequest_TYPE_CANCEL_RUN:
+		runID, parseErr := uuid.Parse(req.RunId)
+		if parseErr != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "error while parsing run ID: %v", parseErr)
+		}
+		err = sv.clusterSvc.CancelJobRun(ctx, runID)
+	case pb.ApplyClusterCommandRequest_TYPE_DRAIN_PEER, pb.ApplyClusterCommandRequest_TYPE_UNDRAIN_PEER:
+		if req.PeerId == "" {
+			return nil, status.Error(codes.InvalidArgument, "peer ID is required")
+		}
+		err = sv.clusterSvc.DrainPeer(ctx, req.PeerId, req.Type == pb.ApplyClusterCommandRequest_TYPE_DRAIN_PEER)
+	case pb.ApplyClusterCommandRequest_TYPE_PAUSE_ALLOCATION, pb.ApplyClusterCommandRequest_TYPE_RESUME_ALLOCATION:
+		err = sv.clusterSvc.PauseAllocation(ctx, req.Type == pb.ApplyClusterCommandRequest_TYPE_PAUSE_ALLOCATION)
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "unsupported cluster command %s", req.Type)
+	}
+	if err != nil {
+		if errors.Is(err, models.ErrNotClusterLeader) {
+			return nil, status.Errorf(codes.FailedPrecondition, "%s, send the command to cluster leader", err.Error())
+		}
+		return nil, status.Errorf(codes.Internal, "error while applying cluster command: %v", err)
+	}
+	return &pb.ApplyClusterCommandResponse{}, nil
+}
+
 func NewRuntimeServiceServer(
 	l log.Logger,
 	version string,
@@ -1189,6 +1335,8 @@ func NewRuntimeServiceServer(
 	progressObserver progress.Observer,
 	instSvc models.RunService,
 	scheduler models.SchedulerUnit,
+	clusterSvc models.ClusterService,
+	adminSvc AdminService,
 ) *RuntimeServiceServer {
 	return &RuntimeServiceServer{
 		l:                    l,
@@ -1202,6 +1350,8 @@ func NewRuntimeServiceServer(
 		progressObserver:     progressObserver,
 		runSvc:               instSvc,
 		scheduler:            scheduler,
+		clusterSvc:           clusterSvc,
+		adminSvc:             adminSvc,
 		secretRepoFactory:    secretRepoFactory,
 	}
 }
@@ -52,6 +52,8 @@ func TestRuntimeServiceServer(t *testing.T) {
 				nil,
 				nil,
 				nil,
+				nil,
+				nil,
 			)
 			versionRequest := pb.VersionRequest{Client: Version}
 			resp, err := runtimeServiceServer.Version(context.Background(), &versionRequest)
@@ -86,6 +88,7 @@ func TestRuntimeServiceServer(t *testing.T) {
 			Config: map[string]string{
 				"bucket": "gs://some_folder",
 			},
+			ProjectSpec: projectSpec,
 		}
 
 		basePlugin1 := new(mock.BasePlugin)
@@ -187,6 +190,8 @@ func TestRuntimeServiceServer(t *testing.T) {
 				nil,
 				instanceService,
 				nil,
+				nil,
+				nil,
 			)
 
 			versionRequest := pb.RegisterInstanceRequest{ProjectName: projectName, JobName: jobName,
@@ -255,6 +260,8 @@ func TestRuntimeServiceServer(t *testing.T) {
 				nil,
 				instanceService,
 				nil,
+				nil,
+				nil,
 			)
 
 			versionRequest := pb.RegisterInstanceRequest{
@@ -288,6 +295,48 @@ func TestRuntimeServiceServer(t *testing.T) {
 
 			assert.Equal(t, expectedResponse, resp)
 		})
+		t.Run("should not find job run of another project", func(t *testing.T) {
+			projectRepository := new(mock.ProjectRepository)
+			projectRepository.On("GetByName", ctx, projectName).Return(projectSpec, nil)
+			defer projectRepository.AssertExpectations(t)
+
+			projectRepoFactory := new(mock.ProjectRepoFactory)
+			projectRepoFactory.On("New").Return(projectRepository)
+			defer projectRepoFactory.AssertExpectations(t)
+
+			otherNamespaceSpec := namespaceSpec
+			otherNamespaceSpec.ProjectSpec = models.ProjectSpec{Name: "other-project"}
+			instanceService := new(mock.RunService)
+			instanceService.On("GetByID", ctx, jobRun.ID).Return(jobRun, otherNamespaceSpec, nil)
+			defer instanceService.AssertExpectations(t)
+
+			runtimeServiceServer := v1.NewRuntimeServiceServer(
+				log,
+				"",
+				nil,
+				nil,
+				nil,
+				projectRepoFactory,
+				nil,
+				nil,
+				v1.NewAdapter(nil, nil),
+				nil,
+				instanceService,
+				nil,
+				nil,
+				nil,
+			)
+
+			versionRequest := pb.RegisterInstanceRequest{
+				ProjectName:  projectName,
+				JobrunId:     jobRun.ID.String(),
+				InstanceType: pb.InstanceSpec_Type(pb.InstanceSpec_Type_value[utils.ToEnumProto(string(models.InstanceTypeTask), "TYPE")]),
+				InstanceName: instanceSpec.Name,
+			}
+			resp, err := runtimeServiceServer.RegisterInstance(context.Background(), &versionRequest)
+			assert.Nil(t, resp)
+			assert.Equal(t, codes.NotFound, status.Code(err))
+		})
 	})
 
 	t.Run("RegisterProject", func(t *testing.T) {
@@ -325,6 +374,8 @@ func TestRuntimeServiceServer(t *testing.T) {
 				nil,
 				nil,
 				nil,
+				nil,
+				nil,
 			)
 
 			projectRequest := pb.RegisterProjectRequest{Project: adapter.ToProjectProto(projectSpec)}
@@ -366,6 +417,8 @@ func TestRuntimeServiceServer(t *testing.T) {
 				nil,
 				nil,
 				nil,
+				nil,
+				nil,
 			)
 
 			projectRequest := pb.RegisterProjectRequest{Project: adapter.ToProjectProto(projectSpec)}
@@ -376,6 +429,35 @@ func TestRuntimeServiceServer(t *testing.T) {
 				Message: "project saved successfully.",
 			}, resp)
 		})
+		t.Run("should return error if secret backend of project is not supported", func(t *testing.T) {
+			projectSpec := models.ProjectSpec{
+				Name: "a-data-project",
+				Config: map[string]string{
+					models.ProjectSecretBackend: "unknown",
+				},
+			}
+			adapter := v1.NewAdapter(nil, nil)
+
+			runtimeServiceServer := v1.NewRuntimeServiceServer(
+				log,
+				"someVersion1.0",
+				nil, nil, nil,
+				nil,
+				nil,
+				nil,
+				adapter,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+			)
+
+			projectRequest := pb.RegisterProjectRequest{Project: adapter.ToProjectProto(projectSpec)}
+			resp, err := runtimeServiceServer.RegisterProject(context.Background(), &projectRequest)
+			assert.Equal(t, "rpc error: code = InvalidArgument desc = unsupported secret backend unknown of project a-data-project", err.Error())
+			assert.Nil(t, resp)
+		})
 	})
 
 	t.Run("RegisterProjectNamespace", func(t *testing.T) {
@@ -427,6 +509,8 @@ func TestRuntimeServiceServer(t *testing.T) {
 				nil,
 				nil,
 				nil,
+				nil,
+				nil,
 			)
 
 			namespaceRequest := pb.RegisterProjectNamespaceRequest{
@@ -485,6 +569,8 @@ func TestRuntimeServiceServer(t *testing.T) {
 				nil,
 				nil,
 				nil,
+				nil,
+				nil,
 			)
 
 			namespaceRequest := pb.RegisterProjectNamespaceRequest{
@@ -590,6 +676,8 @@ func TestRuntimeServiceServer(t *testing.T) {
 				nil,
 				nil,
 				nil,
+				nil,
+				nil,
 			)
 
 			jobProto, _ := adapter.ToJobProto(jobSpec)
@@ -718,6 +806,8 @@ func TestRuntimeServiceServer(t *testing.T) {
 				nil,
 				nil,
 				nil,
+				nil,
+				nil,
 			)
 
 			jobSpecsAdapted := []*pb.JobSpecification{}
@@ -822,6 +912,8 @@ func TestRuntimeServiceServer(t *testing.T) {
 				nil,
 				nil,
 				nil,
+				nil,
+				nil,
 			)
 
 			jobSpecAdapted, _ := adapter.ToJobProto(jobSpecs[0])
@@ -930,6 +1022,8 @@ func TestRuntimeServiceServer(t *testing.T) {
 				nil,
 				nil,
 				nil,
+				nil,
+				nil,
 			)
 
 			taskSpecExpected := &pb.JobTask{
@@ -1043,6 +1137,8 @@ func TestRuntimeServiceServer(t *testing.T) {
 				nil,
 				nil,
 				nil,
+				nil,
+				nil,
 			)
 
 			taskSpecExpected := &pb.JobTask{
@@ -1114,6 +1210,8 @@ func TestRuntimeServiceServer(t *testing.T) {
 				nil,
 				nil,
 				nil,
+				nil,
+				nil,
 			)
 
 			namespaceAdapted := adapter.ToNamespaceProto(namespaceSpec)
@@ -1217,6 +1315,8 @@ func TestRuntimeServiceServer(t *testing.T) {
 				nil,
 				nil,
 				nil,
+				nil,
+				nil,
 			)
 
 			deployRequest := pb.DeleteJobSpecificationRequest{ProjectName: projectName, JobName: jobSpec.Name, NamespaceName: namespaceSpec.Name}
@@ -1284,6 +1384,8 @@ func TestRuntimeServiceServer(t *testing.T) {
 				nil,
 				nil,
 				scheduler,
+				nil,
+				nil,
 			)
 
 			req := &pb.JobStatusRequest{
@@ -1375,6 +1477,8 @@ func TestRuntimeServiceServer(t *testing.T) {
 				nil,
 				nil,
 				nil,
+				nil,
+				nil,
 			)
 			req := &pb.RegisterJobEventRequest{
 				ProjectName:   projectSpec.Name,
@@ -1390,6 +1494,148 @@ func TestRuntimeServiceServer(t *testing.T) {
 		})
 	})
 
+	t.Run("ListJobEvents", func(t *testing.T) {
+		Version := "1.0.0"
+		projectSpec := models.ProjectSpec{
+			ID:   uuid.Must(uuid.NewRandom()),
+			Name: "a-data-project",
+		}
+		namespaceSpec := models.NamespaceSpec{
+			ID:          uuid.Must(uuid.NewRandom()),
+			Name:        "game_jam",
+			ProjectSpec: projectSpec,
+		}
+		eventValues, _ := structpb.NewStruct(
+			map[string]interface{}{
+				"scheduled_at": "2021-10-01T00:00:00Z",
+			},
+		)
+
+		t.Run("should list events of job matching filter", func(t *testing.T) {
+			projectRepository := new(mock.ProjectRepository)
+			projectRepository.On("GetByName", ctx, projectSpec.Name).Return(projectSpec, nil)
+			defer projectRepository.AssertExpectations(t)
+
+			projectRepoFactory := new(mock.ProjectRepoFactory)
+			projectRepoFactory.On("New").Return(projectRepository)
+			defer projectRepoFactory.AssertExpectations(t)
+
+			namespaceRepository := new(mock.NamespaceRepository)
+			namespaceRepository.On("GetByName", ctx, namespaceSpec.Name).Return(namespaceSpec, nil)
+			defer namespaceRepository.AssertExpectations(t)
+
+			namespaceRepoFact := new(mock.NamespaceRepoFactory)
+			namespaceRepoFact.On("New", projectSpec).Return(namespaceRepository)
+			defer namespaceRepoFact.AssertExpectations(t)
+
+			since := time.Date(2021, 9, 1, 0, 0, 0, 0, time.UTC)
+			until := time.Date(2021, 10, 1, 0, 0, 0, 0, time.UTC)
+			scheduledAt := time.Date(2021, 9, 30, 0, 0, 0, 0, time.UTC)
+			createdAt := time.Date(2021, 9, 30, 1, 0, 0, 0, time.UTC)
+			eventSvc := new(mock.EventService)
+			eventSvc.On("List", ctx, namespaceSpec, "transform-tables", models.JobEventFilter{
+				Types: []models.JobEventType{models.JobEventTypeFailure, models.JobEventTypeRetry},
+				Since: since,
+				Until: until,
+				Limit: 10,
+			}).Return([]models.JobEventRecord{
+				{
+					Namespace: namespaceSpec,
+					JobName:   "transform-tables",
+					Event: models.JobEvent{
+						Type:  models.JobEventTypeFailure,
+						Value: eventValues.GetFields(),
+					},
+					ScheduledAt: scheduledAt,
+					CreatedAt:   createdAt,
+				},
+				{
+					Namespace: namespaceSpec,
+					JobName:   "transform-tables",
+					Event: models.JobEvent{
+						Type: models.JobEventTypeRetry,
+					},
+					CreatedAt: createdAt.Add(-time.Minute),
+				},
+			}, nil)
+			defer eventSvc.AssertExpectations(t)
+
+			runtimeServiceServer := v1.NewRuntimeServiceServer(
+				log,
+				Version,
+				nil, eventSvc, nil,
+				projectRepoFactory,
+				namespaceRepoFact,
+				nil,
+				v1.NewAdapter(nil, nil),
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+			)
+			resp, err := runtimeServiceServer.ListJobEvents(ctx, &pb.ListJobEventsRequest{
+				ProjectName:   projectSpec.Name,
+				NamespaceName: namespaceSpec.Name,
+				JobName:       "transform-tables",
+				Since:         timestamppb.New(since),
+				Until:         timestamppb.New(until),
+				Types:         []pb.JobEvent_Type{pb.JobEvent_TYPE_FAILURE, pb.JobEvent_TYPE_RETRY},
+				Limit:         10,
+			})
+			assert.Nil(t, err)
+			assert.Equal(t, 2, len(resp.Events))
+			assert.Equal(t, pb.JobEvent_TYPE_FAILURE, resp.Events[0].Event.Type)
+			assert.Equal(t, "2021-10-01T00:00:00Z", resp.Events[0].Event.Value.Fields["scheduled_at"].GetStringValue())
+			assert.Equal(t, scheduledAt, resp.Events[0].ScheduledAt.AsTime())
+			assert.Equal(t, createdAt, resp.Events[0].CreatedAt.AsTime())
+			assert.Equal(t, pb.JobEvent_TYPE_RETRY, resp.Events[1].Event.Type)
+			assert.Nil(t, resp.Events[1].ScheduledAt)
+		})
+		t.Run("should fail if type of event is not specified", func(t *testing.T) {
+			projectRepository := new(mock.ProjectRepository)
+			projectRepository.On("GetByName", ctx, projectSpec.Name).Return(projectSpec, nil)
+			defer projectRepository.AssertExpectations(t)
+
+			projectRepoFactory := new(mock.ProjectRepoFactory)
+			projectRepoFactory.On("New").Return(projectRepository)
+			defer projectRepoFactory.AssertExpectations(t)
+
+			namespaceRepository := new(mock.NamespaceRepository)
+			namespaceRepository.On("GetByName", ctx, namespaceSpec.Name).Return(namespaceSpec, nil)
+			defer namespaceRepository.AssertExpectations(t)
+
+			namespaceRepoFact := new(mock.NamespaceRepoFactory)
+			namespaceRepoFact.On("New", projectSpec).Return(namespaceRepository)
+			defer namespaceRepoFact.AssertExpectations(t)
+
+			eventSvc := new(mock.EventService)
+			defer eventSvc.AssertExpectations(t)
+
+			runtimeServiceServer := v1.NewRuntimeServiceServer(
+				log,
+				Version,
+				nil, eventSvc, nil,
+				projectRepoFactory,
+				namespaceRepoFact,
+				nil,
+				v1.NewAdapter(nil, nil),
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+			)
+			_, err := runtimeServiceServer.ListJobEvents(ctx, &pb.ListJobEventsRequest{
+				ProjectName:   projectSpec.Name,
+				NamespaceName: namespaceSpec.Name,
+				JobName:       "transform-tables",
+				Types:         []pb.JobEvent_Type{pb.JobEvent_TYPE_UNSPECIFIED},
+			})
+			assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		})
+	})
+
 	t.Run("GetWindow", func(t *testing.T) {
 		t.Run("should return the correct window date range", func(t *testing.T) {
 			Version := "1.0.1"
@@ -1405,6 +1651,8 @@ func TestRuntimeServiceServer(t *testing.T) {
 				nil,
 				nil,
 				nil,
+				nil,
+				nil,
 			)
 			scheduledAt := time.Date(2020, 11, 11, 0, 0, 0, 0, time.UTC)
 			scheduledAtTimestamp := timestamppb.New(scheduledAt)
@@ -1434,6 +1682,8 @@ func TestRuntimeServiceServer(t *testing.T) {
 				nil,
 				nil,
 				nil,
+				nil,
+				nil,
 			)
 			scheduledAt := time.Date(2020, 11, 11, 0, 0, 0, 0, time.UTC)
 			scheduledAtTimestamp := timestamppb.New(scheduledAt)
@@ -1539,6 +1789,8 @@ func TestRuntimeServiceServer(t *testing.T) {
 				nil,
 				nil,
 				nil,
+				nil,
+				nil,
 			)
 
 			resp, err := runtimeServiceServer.CreateResource(ctx, &req)
@@ -1638,6 +1890,8 @@ func TestRuntimeServiceServer(t *testing.T) {
 				nil,
 				nil,
 				nil,
+				nil,
+				nil,
 			)
 
 			resp, err := runtimeServiceServer.UpdateResource(ctx, &req)
@@ -1734,6 +1988,8 @@ func TestRuntimeServiceServer(t *testing.T) {
 				nil,
 				nil,
 				nil,
+				nil,
+				nil,
 			)
 			replayRequest := pb.ReplayDryRunRequest{
 				ProjectName:                 projectName,
@@ -1802,6 +2058,8 @@ func TestRuntimeServiceServer(t *testing.T) {
 				nil,
 				nil,
 				nil,
+				nil,
+				nil,
 			)
 			replayRequest := pb.ReplayDryRunRequest{
 				ProjectName:                 projectName,
@@ -1857,6 +2115,8 @@ func TestRuntimeServiceServer(t *testing.T) {
 				nil,
 				nil,
 				nil,
+				nil,
+				nil,
 			)
 			replayRequest := pb.ReplayDryRunRequest{
 				ProjectName:   projectName,
@@ -1914,6 +2174,8 @@ func TestRuntimeServiceServer(t *testing.T) {
 				nil,
 				nil,
 				nil,
+				nil,
+				nil,
 			)
 			replayRequest := pb.ReplayDryRunRequest{
 				ProjectName:                 projectName,
@@ -2013,6 +2275,8 @@ func TestRuntimeServiceServer(t *testing.T) {
 				nil,
 				nil,
 				nil,
+				nil,
+				nil,
 			)
 			replayRequest := pb.ReplayRequest{
 				ProjectName:                 projectName,
@@ -2070,6 +2334,8 @@ func TestRuntimeServiceServer(t *testing.T) {
 				nil,
 				nil,
 				nil,
+				nil,
+				nil,
 			)
 			replayRequest := pb.ReplayRequest{
 				ProjectName:                 projectName,
@@ -2113,6 +2379,8 @@ func TestRuntimeServiceServer(t *testing.T) {
 				nil,
 				nil,
 				nil,
+				nil,
+				nil,
 			)
 			replayRequest := pb.ReplayRequest{
 				ProjectName:                 projectName,
@@ -2170,6 +2438,8 @@ func TestRuntimeServiceServer(t *testing.T) {
 				nil,
 				nil,
 				nil,
+				nil,
+				nil,
 			)
 			replayRequest := pb.ReplayRequest{
 				ProjectName:                 projectName,
@@ -2209,6 +2479,8 @@ func TestRuntimeServiceServer(t *testing.T) {
 				nil,
 				nil,
 				nil,
+				nil,
+				nil,
 			)
 			replayRequest := pb.ReplayRequest{
 				ProjectName:                 projectName,
@@ -2259,6 +2531,8 @@ func TestRuntimeServiceServer(t *testing.T) {
 				nil,
 				nil,
 				nil,
+				nil,
+				nil,
 			)
 			replayRequest := pb.ReplayRequest{
 				ProjectName:                 projectName,
@@ -2316,6 +2590,8 @@ func TestRuntimeServiceServer(t *testing.T) {
 				nil,
 				nil,
 				nil,
+				nil,
+				nil,
 			)
 			replayRequest := pb.ReplayRequest{
 				ProjectName:                 projectName,
@@ -2374,6 +2650,8 @@ func TestRuntimeServiceServer(t *testing.T) {
 				nil,
 				nil,
 				nil,
+				nil,
+				nil,
 			)
 			replayRequest := pb.ReplayRequest{
 				ProjectName:                 projectName,
@@ -2471,6 +2749,8 @@ func TestRuntimeServiceServer(t *testing.T) {
 				nil,
 				nil,
 				nil,
+				nil,
+				nil,
 			)
 
 			expectedReplayStatusNodeResponse, err := adapter.ToReplayStatusTreeNode(replayState.Node)
@@ -2514,6 +2794,8 @@ func TestRuntimeServiceServer(t *testing.T) {
 				nil,
 				nil,
 				nil,
+				nil,
+				nil,
 			)
 
 			replayRequestPb := pb.GetReplayStatusRequest{
@@ -2622,6 +2904,8 @@ func TestRuntimeServiceServer(t *testing.T) {
 				nil,
 				nil,
 				nil,
+				nil,
+				nil,
 			)
 
 			replayRequestPb := pb.ListReplaysRequest{
@@ -2660,6 +2944,8 @@ func TestRuntimeServiceServer(t *testing.T) {
 				nil,
 				nil,
 				nil,
+				nil,
+				nil,
 			)
 
 			replayRequestPb := pb.ListReplaysRequest{
@@ -2776,6 +3062,8 @@ func TestRuntimeServiceServer(t *testing.T) {
 				nil,
 				nil,
 				nil,
+				nil,
+				nil,
 			)
 
 			backupRequestPb := pb.BackupDryRunRequest{
@@ -2884,6 +3172,8 @@ func TestRuntimeServiceServer(t *testing.T) {
 				nil,
 				nil,
 				nil,
+				nil,
+				nil,
 			)
 
 			backupRequestPb := pb.BackupDryRunRequest{
@@ -2992,6 +3282,8 @@ func TestRuntimeServiceServer(t *testing.T) {
 				nil,
 				nil,
 				nil,
+				nil,
+				nil,
 			)
 
 			backupRequestPb := pb.BackupDryRunRequest{
@@ -3037,6 +3329,8 @@ func TestRuntimeServiceServer(t *testing.T) {
 				nil,
 				nil,
 				nil,
+				nil,
+				nil,
 			)
 
 			backupRequestPb := pb.BackupDryRunRequest{
@@ -3080,6 +3374,8 @@ func TestRuntimeServiceServer(t *testing.T) {
 				nil,
 				nil,
 				nil,
+				nil,
+				nil,
 			)
 
 			backupRequestPb := pb.BackupDryRunRequest{
@@ -3133,6 +3429,8 @@ func TestRuntimeServiceServer(t *testing.T) {
 				nil,
 				nil,
 				nil,
+				nil,
+				nil,
 			)
 
 			backupRequestPb := pb.BackupDryRunRequest{
@@ -3189,6 +3487,8 @@ func TestRuntimeServiceServer(t *testing.T) {
 				nil,
 				nil,
 				nil,
+				nil,
+				nil,
 			)
 
 			backupRequestPb := pb.BackupDryRunRequest{
@@ -3268,6 +3568,8 @@ func TestRuntimeServiceServer(t *testing.T) {
 				nil,
 				nil,
 				nil,
+				nil,
+				nil,
 			)
 
 			backupRequestPb := pb.BackupDryRunRequest{
@@ -3359,6 +3661,8 @@ func TestRuntimeServiceServer(t *testing.T) {
 				nil,
 				nil,
 				nil,
+				nil,
+				nil,
 			)
 
 			backupRequestPb := pb.BackupDryRunRequest{
@@ -3483,6 +3787,8 @@ func TestRuntimeServiceServer(t *testing.T) {
 				nil,
 				nil,
 				nil,
+				nil,
+				nil,
 			)
 			backupResponse, err := runtimeServiceServer.CreateBackup(ctx, &backupRequestPb)
 
@@ -3595,6 +3901,8 @@ func TestRuntimeServiceServer(t *testing.T) {
 				nil,
 				nil,
 				nil,
+				nil,
+				nil,
 			)
 			backupResponse, err := runtimeServiceServer.CreateBackup(context.Background(), &backupRequestPb)
 
@@ -3707,6 +4015,8 @@ func TestRuntimeServiceServer(t *testing.T) {
 				nil,
 				nil,
 				nil,
+				nil,
+				nil,
 			)
 			backupResponse, err := runtimeServiceServer.CreateBackup(context.Background(), &backupRequestPb)
 
@@ -3752,6 +4062,8 @@ func TestRuntimeServiceServer(t *testing.T) {
 				nil,
 				nil,
 				nil,
+				nil,
+				nil,
 			)
 			backupResponse, err := runtimeServiceServer.CreateBackup(context.Background(), &backupRequestPb)
 
@@ -3797,6 +4109,8 @@ func TestRuntimeServiceServer(t *testing.T) {
 				nil,
 				nil,
 				nil,
+				nil,
+				nil,
 			)
 			backupResponse, err := runtimeServiceServer.CreateBackup(context.Background(), &backupRequestPb)
 
@@ -3850,6 +4164,8 @@ func TestRuntimeServiceServer(t *testing.T) {
 				nil,
 				nil,
 				nil,
+				nil,
+				nil,
 			)
 			backupResponse, err := runtimeServiceServer.CreateBackup(context.Background(), &backupRequestPb)
 
@@ -3906,6 +4222,8 @@ func TestRuntimeServiceServer(t *testing.T) {
 				nil,
 				nil,
 				nil,
+				nil,
+				nil,
 			)
 			backupResponse, err := runtimeServiceServer.CreateBackup(context.Background(), &backupRequestPb)
 
@@ -3985,6 +4303,8 @@ func TestRuntimeServiceServer(t *testing.T) {
 				nil,
 				nil,
 				nil,
+				nil,
+				nil,
 			)
 			backupResponse, err := runtimeServiceServer.CreateBackup(context.Background(), &backupRequestPb)
 
@@ -4078,6 +4398,8 @@ func TestRuntimeServiceServer(t *testing.T) {
 				nil,
 				nil,
 				nil,
+				nil,
+				nil,
 			)
 			backupResponse, err := runtimeServiceServer.CreateBackup(context.Background(), &backupRequestPb)
 
@@ -4167,6 +4489,8 @@ func TestRuntimeServiceServer(t *testing.T) {
 				nil,
 				nil,
 				nil,
+				nil,
+				nil,
 			)
 			backupResponse, err := runtimeServiceServer.ListBackups(context.Background(), &listBackupsReq)
 
@@ -4200,6 +4524,8 @@ func TestRuntimeServiceServer(t *testing.T) {
 				nil,
 				nil,
 				nil,
+				nil,
+				nil,
 			)
 			backupResponse, err := runtimeServiceServer.ListBackups(context.Background(), &listBackupsReq)
 
@@ -4233,6 +4559,8 @@ func TestRuntimeServiceServer(t *testing.T) {
 				nil,
 				nil,
 				nil,
+				nil,
+				nil,
 			)
 			backupResponse, err := runtimeServiceServer.ListBackups(context.Background(), &listBackupsReq)
 
@@ -4306,6 +4634,8 @@ func TestRuntimeServiceServer(t *testing.T) {
 				nil,
 				nil,
 				nil,
+				nil,
+				nil,
 			)
 			backupResponse, err := runtimeServiceServer.GetBackup(context.Background(), &getBackupDetailReq)
 
@@ -4339,6 +4669,8 @@ func TestRuntimeServiceServer(t *testing.T) {
 				nil,
 				nil,
 				nil,
+				nil,
+				nil,
 			)
 			backupResponse, err := runtimeServiceServer.GetBackup(context.Background(), &getBackupDetailReq)
 
@@ -4372,6 +4704,8 @@ func TestRuntimeServiceServer(t *testing.T) {
 				nil,
 				nil,
 				nil,
+				nil,
+				nil,
 			)
 			backupResponse, err := runtimeServiceServer.GetBackup(context.Background(), &getBackupDetailReq)
 
@@ -4405,6 +4739,8 @@ func TestRuntimeServiceServer(t *testing.T) {
 				nil,
 				nil,
 				nil,
+				nil,
+				nil,
 			)
 			backupResponse, err := runtimeServiceServer.GetBackup(context.Background(), &getBackupDetailReq)
 
@@ -4451,6 +4787,8 @@ func TestRuntimeServiceServer(t *testing.T) {
 				nil,
 				nil,
 				nil,
+				nil,
+				nil,
 			)
 			backupResponse, err := runtimeServiceServer.GetBackup(context.Background(), &getBackupDetailReq)
 
@@ -4497,6 +4835,8 @@ func TestRuntimeServiceServer(t *testing.T) {
 				nil,
 				nil,
 				nil,
+				nil,
+				nil,
 			)
 			backupResponse, err := runtimeServiceServer.GetBackup(context.Background(), &getBackupDetailReq)
 
@@ -4504,4 +4844,160 @@ func TestRuntimeServiceServer(t *testing.T) {
 			assert.Contains(t, err.Error(), "invalid backup URN")
 		})
 	})
+	t.Run("GetClusterState", func(t *testing.T) {
+		t.Run("should return members of cluster with their allocations", func(t *testing.T) {
+			allocatedAt := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+			clusterSvc := new(mock.ClusterService)
+			clusterSvc.On("GetState", ctx).Return(models.ClusterState{
+				Leader: "node-1",
+				Members: []models.ClusterMember{
+					{
+						Name:    "node-1",
+						Address: "localhost:7000",
+						Status:  "alive",
+						Allocations: []models.ClusterAllocation{
+							{RunID: "a", Status: models.RunStateRunning.String(), AllocatedAt: allocatedAt},
+						},
+					},
+				},
+			}, nil)
+			defer clusterSvc.AssertExpectations(t)
+
+			runtimeServiceServer := v1.NewRuntimeServiceServer(
+				log,
+				"Version",
+				nil, nil, nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				clusterSvc,
+				nil,
+			)
+			resp, err := runtimeServiceServer.GetClusterState(ctx, &pb.GetClusterStateRequest{})
+			assert.Nil(t, err)
+			assert.Equal(t, "node-1", resp.Leader)
+			assert.Len(t, resp.Members, 1)
+			assert.Equal(t, "localhost:7000", resp.Members[0].Address)
+			assert.Equal(t, "a", resp.Members[0].Allocations[0].RunId)
+			assert.Equal(t, allocatedAt, resp.Members[0].Allocations[0].AllocatedAt.AsTime())
+		})
+		t.Run("should return unavailable if server is not a cluster peer", func(t *testing.T) {
+			runtimeServiceServer := v1.NewRuntimeServiceServer(
+				log,
+				"Version",
+				nil, nil, nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+			)
+			resp, err := runtimeServiceServer.GetClusterState(ctx, &pb.GetClusterStateRequest{})
+			assert.Nil(t, resp)
+			assert.Equal(t, codes.Unavailable, status.Code(err))
+		})
+	})
+	t.Run("ApplyClusterCommand", func(t *testing.T) {
+		t.Run("should cancel job run", func(t *testing.T) {
+			runID := uuid.Must(uuid.NewRandom())
+			clusterSvc := new(mock.ClusterService)
+			clusterSvc.On("CancelJobRun", ctx, runID).Return(nil)
+			defer clusterSvc.AssertExpectations(t)
+
+			runtimeServiceServer := v1.NewRuntimeServiceServer(
+				log,
+				"Version",
+				nil, nil, nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				clusterSvc,
+				nil,
+			)
+			_, err := runtimeServiceServer.ApplyClusterCommand(ctx, &pb.ApplyClusterCommandRequest{
+				Type:  pb.ApplyClusterCommandRequest_TYPE_CANCEL_RUN,
+				RunId: runID.String(),
+			})
+			assert.Nil(t, err)
+		})
+		t.Run("should undrain peer", func(t *testing.T) {
+			clusterSvc := new(mock.ClusterService)
+			clusterSvc.On("DrainPeer", ctx, "node-2", false).Return(nil)
+			defer clusterSvc.AssertExpectations(t)
+
+			runtimeServiceServer := v1.NewRuntimeServiceServer(
+				log,
+				"Version",
+				nil, nil, nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				clusterSvc,
+				nil,
+			)
+			_, err := runtimeServiceServer.ApplyClusterCommand(ctx, &pb.ApplyClusterCommandRequest{
+				Type:   pb.ApplyClusterCommandRequest_TYPE_UNDRAIN_PEER,
+				PeerId: "node-2",
+			})
+			assert.Nil(t, err)
+		})
+		t.Run("should return failed precondition if server is not the cluster leader", func(t *testing.T) {
+			clusterSvc := new(mock.ClusterService)
+			clusterSvc.On("PauseAllocation", ctx, true).Return(models.ErrNotClusterLeader)
+			defer clusterSvc.AssertExpectations(t)
+
+			runtimeServiceServer := v1.NewRuntimeServiceServer(
+				log,
+				"Version",
+				nil, nil, nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				clusterSvc,
+				nil,
+			)
+			_, err := runtimeServiceServer.ApplyClusterCommand(ctx, &pb.ApplyClusterCommandRequest{
+				Type: pb.ApplyClusterCommandRequest_TYPE_PAUSE_ALLOCATION,
+			})
+			assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+		})
+		t.Run("should return invalid argument for unspecified command", func(t *testing.T) {
+			runtimeServiceServer := v1.NewRuntimeServiceServer(
+				log,
+				"Version",
+				nil, nil, nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				new(mock.ClusterService),
+				nil,
+			)
+			_, err := runtimeServiceServer.ApplyClusterCommand(ctx, &pb.ApplyClusterCommandRequest{})
+			assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		})
+	})
 }
@@ -3,11 +3,15 @@ package v1beta1
 import (
 	"context"
 	"encoding/base64"
+	"strings"
 
 	pb "github.com/odpf/optimus/api/proto/odpf/optimus/core/v1beta1"
 	"github.com/odpf/optimus/models"
+	"github.com/odpf/optimus/store"
+	"github.com/pkg/errors"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 func (sv *RuntimeServiceServer) RegisterSecret(ctx context.Context, req *pb.RegisterSecretRequest) (*pb.RegisterSecretResponse, error) {
@@ -78,6 +82,62 @@ func (sv *RuntimeServiceServer) UpdateSecret(ctx context.Context, req *pb.Update
 	}, nil
 }
 
+func (sv *RuntimeServiceServer) ListSecrets(ctx context.Context, req *pb.ListSecretsRequest) (*pb.ListSecretsResponse, error) {
+	projectRepo := sv.projectRepoFactory.New()
+	projSpec, err := projectRepo.GetByName(ctx, req.GetProjectName())
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "%s: project %s not found", err.Error(), req.GetProjectName())
+	}
+
+	secretRepo := sv.secretRepoFactory.New(projSpec, models.NamespaceSpec{})
+	secretItems, err := secretRepo.GetSecrets(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "%s: failed to list secrets", err.Error())
+	}
+
+	var secrets []*pb.ListSecretsResponse_Secret
+	for _, item := range secretItems {
+		secretType := pb.ListSecretsResponse_SECRET_TYPE_USER_DEFINED
+		if item.Type == models.SecretTypeSystemDefined {
+			secretType = pb.ListSecretsResponse_SECRET_TYPE_SYSTEM_DEFINED
+		}
+		secrets = append(secrets, &pb.ListSecretsResponse_Secret{
+			Name:      item.Name,
+			Digest:    item.Digest,
+			Namespace: item.Namespace,
+			Type:      secretType,
+			UpdatedAt: timestamppb.New(item.UpdatedAt),
+		})
+	}
+	return &pb.ListSecretsResponse{
+		Secrets: secrets,
+	}, nil
+}
+
+func (sv *RuntimeServiceServer) DeleteSecret(ctx context.Context, req *pb.DeleteSecretRequest) (*pb.DeleteSecretResponse, error) {
+	if strings.HasPrefix(req.GetSecretName(), models.SecretTypeSystemDefinedPrefix) {
+		return nil, status.Errorf(codes.PermissionDenied, "secret %s is managed by system and can't be deleted", req.GetSecretName())
+	}
+
+	projectRepo := sv.projectRepoFactory.New()
+	projSpec, err := projectRepo.GetByName(ctx, req.GetProjectName())
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "%s: project %s not found", err.Error(), req.GetProjectName())
+	}
+
+	secretRepo := sv.secretRepoFactory.New(projSpec, models.NamespaceSpec{})
+	if err := secretRepo.Delete(ctx, req.GetSecretName()); err != nil {
+		if errors.Is(err, store.ErrResourceNotFound) {
+			return nil, status.Errorf(codes.NotFound, "%s: secret %s not found", err.Error(), req.GetSecretName())
+		}
+		return nil, status.Errorf(codes.Internal, "%s: failed to delete secret %s", err.Error(), req.GetSecretName())
+	}
+
+	return &pb.DeleteSecretResponse{
+		Success: true,
+	}, nil
+}
+
 func getDecodedSecret(encodedString string) (string, error) {
 	if encodedString == "" {
 		return "", status.Error(codes.InvalidArgument, "empty value for secret")
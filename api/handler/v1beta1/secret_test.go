@@ -4,15 +4,20 @@ import (
 	"context"
 	"encoding/base64"
 	"testing"
+	"time"
 
 	"github.com/google/uuid"
 	v1 "github.com/odpf/optimus/api/handler/v1beta1"
 	pb "github.com/odpf/optimus/api/proto/odpf/optimus/core/v1beta1"
 	"github.com/odpf/optimus/mock"
 	"github.com/odpf/optimus/models"
+	"github.com/odpf/optimus/store"
 	"github.com/odpf/salt/log"
 	"github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 func TestSecretManagementOnRuntimeServer(t *testing.T) {
@@ -55,6 +60,8 @@ func TestSecretManagementOnRuntimeServer(t *testing.T) {
 				"someVersion1.0",
 				nil, nil, nil, nil, nil,
 				nil, nil, nil, nil, nil,
+				nil,
+				nil,
 			)
 
 			secretRequest := pb.RegisterSecretRequest{
@@ -76,6 +83,8 @@ func TestSecretManagementOnRuntimeServer(t *testing.T) {
 				"someVersion1.0",
 				nil, nil, nil, nil, nil,
 				nil, nil, nil, nil, nil,
+				nil,
+				nil,
 			)
 
 			secretRequest := pb.RegisterSecretRequest{
@@ -122,6 +131,8 @@ func TestSecretManagementOnRuntimeServer(t *testing.T) {
 				nil,
 				nil,
 				nil,
+				nil,
+				nil,
 			)
 
 			secretRequest := pb.RegisterSecretRequest{
@@ -168,6 +179,8 @@ func TestSecretManagementOnRuntimeServer(t *testing.T) {
 				nil,
 				nil,
 				nil,
+				nil,
+				nil,
 			)
 
 			secretRequest := pb.RegisterSecretRequest{
@@ -212,6 +225,8 @@ func TestSecretManagementOnRuntimeServer(t *testing.T) {
 				nil,
 				nil,
 				nil,
+				nil,
+				nil,
 			)
 
 			secretRequest := pb.UpdateSecretRequest{
@@ -257,6 +272,8 @@ func TestSecretManagementOnRuntimeServer(t *testing.T) {
 				nil,
 				nil,
 				nil,
+				nil,
+				nil,
 			)
 
 			secretRequest := pb.UpdateSecretRequest{
@@ -269,4 +286,163 @@ func TestSecretManagementOnRuntimeServer(t *testing.T) {
 			assert.Equal(t, "rpc error: code = Internal desc = random error: failed to update secret hello", err.Error())
 		})
 	})
+	t.Run("ListSecrets", func(t *testing.T) {
+		t.Run("should list metadata of secrets in project", func(t *testing.T) {
+			defer projectRepository.AssertExpectations(t)
+			defer projectRepoFactory.AssertExpectations(t)
+
+			updatedAt := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+			projectSecretRepository := new(mock.ProjectSecretRepository)
+			projectSecretRepository.On("GetSecrets", ctx).Return([]models.SecretItemInfo{
+				{
+					Name:      "_OPTIMUS_STORAGE",
+					Digest:    "digest-1",
+					Type:      models.SecretTypeSystemDefined,
+					UpdatedAt: updatedAt,
+				},
+				{
+					Name:      "hello",
+					Digest:    "digest-2",
+					Namespace: namespaceSpec.Name,
+					Type:      models.SecretTypeUserDefined,
+					UpdatedAt: updatedAt,
+				},
+			}, nil)
+			defer projectSecretRepository.AssertExpectations(t)
+
+			projectSecretRepoFactory := new(mock.ProjectSecretRepoFactory)
+			projectSecretRepoFactory.On("New", projectSpec, models.NamespaceSpec{}).Return(projectSecretRepository)
+			defer projectSecretRepoFactory.AssertExpectations(t)
+
+			runtimeServiceServer := v1.NewRuntimeServiceServer(
+				noop,
+				"someVersion1.0",
+				nil, nil, nil,
+				projectRepoFactory,
+				namespaceRepoFactory,
+				projectSecretRepoFactory,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+			)
+
+			resp, err := runtimeServiceServer.ListSecrets(ctx, &pb.ListSecretsRequest{ProjectName: projectSpec.Name})
+			assert.Nil(t, err)
+			assert.Equal(t, &pb.ListSecretsResponse{
+				Secrets: []*pb.ListSecretsResponse_Secret{
+					{
+						Name:      "_OPTIMUS_STORAGE",
+						Digest:    "digest-1",
+						Type:      pb.ListSecretsResponse_SECRET_TYPE_SYSTEM_DEFINED,
+						UpdatedAt: timestamppb.New(updatedAt),
+					},
+					{
+						Name:      "hello",
+						Digest:    "digest-2",
+						Namespace: namespaceSpec.Name,
+						Type:      pb.ListSecretsResponse_SECRET_TYPE_USER_DEFINED,
+						UpdatedAt: timestamppb.New(updatedAt),
+					},
+				},
+			}, resp)
+		})
+	})
+	t.Run("DeleteSecret", func(t *testing.T) {
+		t.Run("should delete a secret successfully", func(t *testing.T) {
+			defer projectRepository.AssertExpectations(t)
+			defer projectRepoFactory.AssertExpectations(t)
+
+			projectSecretRepository := new(mock.ProjectSecretRepository)
+			projectSecretRepository.On("Delete", ctx, "hello").Return(nil)
+			defer projectSecretRepository.AssertExpectations(t)
+
+			projectSecretRepoFactory := new(mock.ProjectSecretRepoFactory)
+			projectSecretRepoFactory.On("New", projectSpec, models.NamespaceSpec{}).Return(projectSecretRepository)
+			defer projectSecretRepoFactory.AssertExpectations(t)
+
+			runtimeServiceServer := v1.NewRuntimeServiceServer(
+				noop,
+				"someVersion1.0",
+				nil, nil, nil,
+				projectRepoFactory,
+				namespaceRepoFactory,
+				projectSecretRepoFactory,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+			)
+
+			resp, err := runtimeServiceServer.DeleteSecret(ctx, &pb.DeleteSecretRequest{
+				ProjectName: projectSpec.Name,
+				SecretName:  "hello",
+			})
+			assert.Nil(t, err)
+			assert.Equal(t, &pb.DeleteSecretResponse{
+				Success: true,
+			}, resp)
+		})
+		t.Run("should return not found if secret doesn't exist", func(t *testing.T) {
+			projectSecretRepository := new(mock.ProjectSecretRepository)
+			projectSecretRepository.On("Delete", ctx, "hello").Return(store.ErrResourceNotFound)
+			defer projectSecretRepository.AssertExpectations(t)
+
+			projectSecretRepoFactory := new(mock.ProjectSecretRepoFactory)
+			projectSecretRepoFactory.On("New", projectSpec, models.NamespaceSpec{}).Return(projectSecretRepository)
+			defer projectSecretRepoFactory.AssertExpectations(t)
+
+			runtimeServiceServer := v1.NewRuntimeServiceServer(
+				noop,
+				"someVersion1.0",
+				nil, nil, nil,
+				projectRepoFactory,
+				namespaceRepoFactory,
+				projectSecretRepoFactory,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+			)
+
+			resp, err := runtimeServiceServer.DeleteSecret(ctx, &pb.DeleteSecretRequest{
+				ProjectName: projectSpec.Name,
+				SecretName:  "hello",
+			})
+			assert.Nil(t, resp)
+			assert.Equal(t, codes.NotFound, status.Code(err))
+		})
+		t.Run("should not delete system defined secrets", func(t *testing.T) {
+			projectSecretRepoFactory := new(mock.ProjectSecretRepoFactory)
+			defer projectSecretRepoFactory.AssertExpectations(t)
+
+			runtimeServiceServer := v1.NewRuntimeServiceServer(
+				noop,
+				"someVersion1.0",
+				nil, nil, nil,
+				projectRepoFactory,
+				namespaceRepoFactory,
+				projectSecretRepoFactory,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+			)
+
+			resp, err := runtimeServiceServer.DeleteSecret(ctx, &pb.DeleteSecretRequest{
+				ProjectName: projectSpec.Name,
+				SecretName:  "_OPTIMUS_STORAGE",
+			})
+			assert.Nil(t, resp)
+			assert.Equal(t, codes.PermissionDenied, status.Code(err))
+		})
+	})
 }
@@ -9,6 +9,7 @@ package optimus
 import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
 )
@@ -23,10 +24,14 @@ const (
 type CommandLogType int32
 
 const (
-	CommandLogType_COMMAND_LOG_TYPE_UNSPECIFIED  CommandLogType = 0
-	CommandLogType_COMMAND_LOG_TYPE_NOOP         CommandLogType = 1
-	CommandLogType_COMMAND_LOG_TYPE_SCHEDULE_JOB CommandLogType = 2
-	CommandLogType_COMMAND_LOG_TYPE_UPDATE_JOB   CommandLogType = 3
+	CommandLogType_COMMAND_LOG_TYPE_UNSPECIFIED      CommandLogType = 0
+	CommandLogType_COMMAND_LOG_TYPE_NOOP             CommandLogType = 1
+	CommandLogType_COMMAND_LOG_TYPE_SCHEDULE_JOB     CommandLogType = 2
+	CommandLogType_COMMAND_LOG_TYPE_UPDATE_JOB       CommandLogType = 3
+	CommandLogType_COMMAND_LOG_TYPE_RELEASE_PEER     CommandLogType = 4
+	CommandLogType_COMMAND_LOG_TYPE_CANCEL_JOB       CommandLogType = 5
+	CommandLogType_COMMAND_LOG_TYPE_DRAIN_PEER       CommandLogType = 6
+	CommandLogType_COMMAND_LOG_TYPE_PAUSE_ALLOCATION CommandLogType = 7
 )
 
 // Enum value maps for CommandLogType.
@@ -36,12 +41,20 @@ var (
 		1: "COMMAND_LOG_TYPE_NOOP",
 		2: "COMMAND_LOG_TYPE_SCHEDULE_JOB",
 		3: "COMMAND_LOG_TYPE_UPDATE_JOB",
+		4: "COMMAND_LOG_TYPE_RELEASE_PEER",
+		5: "COMMAND_LOG_TYPE_CANCEL_JOB",
+		6: "COMMAND_LOG_TYPE_DRAIN_PEER",
+		7: "COMMAND_LOG_TYPE_PAUSE_ALLOCATION",
 	}
 	CommandLogType_value = map[string]int32{
-		"COMMAND_LOG_TYPE_UNSPECIFIED":  0,
-		"COMMAND_LOG_TYPE_NOOP":         1,
-		"COMMAND_LOG_TYPE_SCHEDULE_JOB": 2,
-		"COMMAND_LOG_TYPE_UPDATE_JOB":   3,
+		"COMMAND_LOG_TYPE_UNSPECIFIED":      0,
+		"COMMAND_LOG_TYPE_NOOP":             1,
+		"COMMAND_LOG_TYPE_SCHEDULE_JOB":     2,
+		"COMMAND_LOG_TYPE_UPDATE_JOB":       3,
+		"COMMAND_LOG_TYPE_RELEASE_PEER":     4,
+		"COMMAND_LOG_TYPE_CANCEL_JOB":       5,
+		"COMMAND_LOG_TYPE_DRAIN_PEER":       6,
+		"COMMAND_LOG_TYPE_PAUSE_ALLOCATION": 7,
 	}
 )
 
@@ -289,6 +302,283 @@ func (x *CommandUpdateJob) GetPatches() []*CommandUpdateJob_Patch {
 	return nil
 }
 
+// CommandReleasePeer will be sent once a peer leaves the cluster, all the
+// jobs scheduled to it are released to be scheduled again
+type CommandReleasePeer struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PeerId string `protobuf:"bytes,1,opt,name=peer_id,json=peerId,proto3" json:"peer_id,omitempty"`
+}
+
+func (x *CommandReleasePeer) Reset() {
+	*x = CommandReleasePeer{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_odpf_optimus_cluster_v1beta1_command_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CommandReleasePeer) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CommandReleasePeer) ProtoMessage() {}
+
+func (x *CommandReleasePeer) ProtoReflect() protoreflect.Message {
+	mi := &file_odpf_optimus_cluster_v1beta1_command_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CommandReleasePeer.ProtoReflect.Descriptor instead.
+func (*CommandReleasePeer) Descriptor() ([]byte, []int) {
+	return file_odpf_optimus_cluster_v1beta1_command_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *CommandReleasePeer) GetPeerId() string {
+	if x != nil {
+		return x.PeerId
+	}
+	return ""
+}
+
+// CommandCancelJob will be sent to stop the execution of a job run, run is
+// released from the peer it was scheduled to
+type CommandCancelJob struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RunId string `protobuf:"bytes,1,opt,name=run_id,json=runId,proto3" json:"run_id,omitempty"`
+}
+
+func (x *CommandCancelJob) Reset() {
+	*x = CommandCancelJob{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_odpf_optimus_cluster_v1beta1_command_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CommandCancelJob) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CommandCancelJob) ProtoMessage() {}
+
+func (x *CommandCancelJob) ProtoReflect() protoreflect.Message {
+	mi := &file_odpf_optimus_cluster_v1beta1_command_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CommandCancelJob.ProtoReflect.Descriptor instead.
+func (*CommandCancelJob) Descriptor() ([]byte, []int) {
+	return file_odpf_optimus_cluster_v1beta1_command_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *CommandCancelJob) GetRunId() string {
+	if x != nil {
+		return x.RunId
+	}
+	return ""
+}
+
+// CommandDrainPeer will be sent to stop or resume scheduling new jobs to a
+// peer, jobs already scheduled to it are not affected
+type CommandDrainPeer struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PeerId string `protobuf:"bytes,1,opt,name=peer_id,json=peerId,proto3" json:"peer_id,omitempty"`
+	Drain  bool   `protobuf:"varint,2,opt,name=drain,proto3" json:"drain,omitempty"`
+}
+
+func (x *CommandDrainPeer) Reset() {
+	*x = CommandDrainPeer{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_odpf_optimus_cluster_v1beta1_command_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CommandDrainPeer) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CommandDrainPeer) ProtoMessage() {}
+
+func (x *CommandDrainPeer) ProtoReflect() protoreflect.Message {
+	mi := &file_odpf_optimus_cluster_v1beta1_command_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CommandDrainPeer.ProtoReflect.Descriptor instead.
+func (*CommandDrainPeer) Descriptor() ([]byte, []int) {
+	return file_odpf_optimus_cluster_v1beta1_command_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *CommandDrainPeer) GetPeerId() string {
+	if x != nil {
+		return x.PeerId
+	}
+	return ""
+}
+
+func (x *CommandDrainPeer) GetDrain() bool {
+	if x != nil {
+		return x.Drain
+	}
+	return false
+}
+
+// CommandPauseAllocation will be sent to stop or resume scheduling new jobs
+// to all the peers of cluster
+type CommandPauseAllocation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Pause bool `protobuf:"varint,1,opt,name=pause,proto3" json:"pause,omitempty"`
+}
+
+func (x *CommandPauseAllocation) Reset() {
+	*x = CommandPauseAllocation{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_odpf_optimus_cluster_v1beta1_command_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CommandPauseAllocation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CommandPauseAllocation) ProtoMessage() {}
+
+func (x *CommandPauseAllocation) ProtoReflect() protoreflect.Message {
+	mi := &file_odpf_optimus_cluster_v1beta1_command_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CommandPauseAllocation.ProtoReflect.Descriptor instead.
+func (*CommandPauseAllocation) Descriptor() ([]byte, []int) {
+	return file_odpf_optimus_cluster_v1beta1_command_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *CommandPauseAllocation) GetPause() bool {
+	if x != nil {
+		return x.Pause
+	}
+	return false
+}
+
+// Snapshot is the persisted form of cluster state, version is bumped for
+// every change in the state which is not backward compatible
+type Snapshot struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Version          int32                      `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+	Allocations      []*Snapshot_PeerAllocation `protobuf:"bytes,2,rep,name=allocations,proto3" json:"allocations,omitempty"`
+	DrainedPeers     []string                   `protobuf:"bytes,3,rep,name=drained_peers,json=drainedPeers,proto3" json:"drained_peers,omitempty"`
+	AllocationPaused bool                       `protobuf:"varint,4,opt,name=allocation_paused,json=allocationPaused,proto3" json:"allocation_paused,omitempty"`
+}
+
+func (x *Snapshot) Reset() {
+	*x = Snapshot{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_odpf_optimus_cluster_v1beta1_command_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Snapshot) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Snapshot) ProtoMessage() {}
+
+func (x *Snapshot) ProtoReflect() protoreflect.Message {
+	mi := &file_odpf_optimus_cluster_v1beta1_command_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Snapshot.ProtoReflect.Descriptor instead.
+func (*Snapshot) Descriptor() ([]byte, []int) {
+	return file_odpf_optimus_cluster_v1beta1_command_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *Snapshot) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *Snapshot) GetAllocations() []*Snapshot_PeerAllocation {
+	if x != nil {
+		return x.Allocations
+	}
+	return nil
+}
+
+func (x *Snapshot) GetDrainedPeers() []string {
+	if x != nil {
+		return x.DrainedPeers
+	}
+	return nil
+}
+
+func (x *Snapshot) GetAllocationPaused() bool {
+	if x != nil {
+		return x.AllocationPaused
+	}
+	return false
+}
+
 type CommandUpdateJob_Patch struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -301,7 +591,7 @@ type CommandUpdateJob_Patch struct {
 func (x *CommandUpdateJob_Patch) Reset() {
 	*x = CommandUpdateJob_Patch{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_odpf_optimus_cluster_v1beta1_command_proto_msgTypes[4]
+		mi := &file_odpf_optimus_cluster_v1beta1_command_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -314,7 +604,7 @@ func (x *CommandUpdateJob_Patch) String() string {
 func (*CommandUpdateJob_Patch) ProtoMessage() {}
 
 func (x *CommandUpdateJob_Patch) ProtoReflect() protoreflect.Message {
-	mi := &file_odpf_optimus_cluster_v1beta1_command_proto_msgTypes[4]
+	mi := &file_odpf_optimus_cluster_v1beta1_command_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -344,6 +634,124 @@ func (x *CommandUpdateJob_Patch) GetStatus() string {
 	return ""
 }
 
+type Snapshot_PeerAllocation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PeerId string          `protobuf:"bytes,1,opt,name=peer_id,json=peerId,proto3" json:"peer_id,omitempty"`
+	Jobs   []*Snapshot_Job `protobuf:"bytes,2,rep,name=jobs,proto3" json:"jobs,omitempty"`
+}
+
+func (x *Snapshot_PeerAllocation) Reset() {
+	*x = Snapshot_PeerAllocation{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_odpf_optimus_cluster_v1beta1_command_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Snapshot_PeerAllocation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Snapshot_PeerAllocation) ProtoMessage() {}
+
+func (x *Snapshot_PeerAllocation) ProtoReflect() protoreflect.Message {
+	mi := &file_odpf_optimus_cluster_v1beta1_command_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Snapshot_PeerAllocation.ProtoReflect.Descriptor instead.
+func (*Snapshot_PeerAllocation) Descriptor() ([]byte, []int) {
+	return file_odpf_optimus_cluster_v1beta1_command_proto_rawDescGZIP(), []int{8, 0}
+}
+
+func (x *Snapshot_PeerAllocation) GetPeerId() string {
+	if x != nil {
+		return x.PeerId
+	}
+	return ""
+}
+
+func (x *Snapshot_PeerAllocation) GetJobs() []*Snapshot_Job {
+	if x != nil {
+		return x.Jobs
+	}
+	return nil
+}
+
+type Snapshot_Job struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RunId       string                 `protobuf:"bytes,1,opt,name=run_id,json=runId,proto3" json:"run_id,omitempty"`
+	Status      string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	AllocatedAt *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=allocated_at,json=allocatedAt,proto3" json:"allocated_at,omitempty"`
+}
+
+func (x *Snapshot_Job) Reset() {
+	*x = Snapshot_Job{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_odpf_optimus_cluster_v1beta1_command_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Snapshot_Job) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Snapshot_Job) ProtoMessage() {}
+
+func (x *Snapshot_Job) ProtoReflect() protoreflect.Message {
+	mi := &file_odpf_optimus_cluster_v1beta1_command_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Snapshot_Job.ProtoReflect.Descriptor instead.
+func (*Snapshot_Job) Descriptor() ([]byte, []int) {
+	return file_odpf_optimus_cluster_v1beta1_command_proto_rawDescGZIP(), []int{8, 1}
+}
+
+func (x *Snapshot_Job) GetRunId() string {
+	if x != nil {
+		return x.RunId
+	}
+	return ""
+}
+
+func (x *Snapshot_Job) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *Snapshot_Job) GetAllocatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.AllocatedAt
+	}
+	return nil
+}
+
 var File_odpf_optimus_cluster_v1beta1_command_proto protoreflect.FileDescriptor
 
 var file_odpf_optimus_cluster_v1beta1_command_proto_rawDesc = []byte{
@@ -351,32 +759,74 @@ var file_odpf_optimus_cluster_v1beta1_command_proto_rawDesc = []byte{
 	0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x63,
 	0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x1c, 0x6f, 0x64,
 	0x70, 0x66, 0x2e, 0x6f, 0x70, 0x74, 0x69, 0x6d, 0x75, 0x73, 0x2e, 0x63, 0x6c, 0x75, 0x73, 0x74,
-	0x65, 0x72, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x22, 0x68, 0x0a, 0x0a, 0x43, 0x6f,
-	0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x4c, 0x6f, 0x67, 0x12, 0x40, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2c, 0x2e, 0x6f, 0x64, 0x70, 0x66, 0x2e, 0x6f, 0x70,
-	0x74, 0x69, 0x6d, 0x75, 0x73, 0x2e, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31,
-	0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x4c, 0x6f, 0x67,
-	0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x61,
-	0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x70, 0x61, 0x79,
-	0x6c, 0x6f, 0x61, 0x64, 0x22, 0x1d, 0x0a, 0x0b, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x4e,
-	0x6f, 0x6f, 0x70, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x02, 0x69, 0x64, 0x22, 0x46, 0x0a, 0x12, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x53, 0x63,
-	0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x4a, 0x6f, 0x62, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x65, 0x65,
-	0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x65, 0x65, 0x72,
-	0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x72, 0x75, 0x6e, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x02, 0x20,
-	0x03, 0x28, 0x09, 0x52, 0x06, 0x72, 0x75, 0x6e, 0x49, 0x64, 0x73, 0x22, 0xb3, 0x01, 0x0a, 0x10,
-	0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4a, 0x6f, 0x62,
+	0x65, 0x72, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x68, 0x0a, 0x0a, 0x43,
+	0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x4c, 0x6f, 0x67, 0x12, 0x40, 0x0a, 0x04, 0x74, 0x79, 0x70,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2c, 0x2e, 0x6f, 0x64, 0x70, 0x66, 0x2e, 0x6f,
+	0x70, 0x74, 0x69, 0x6d, 0x75, 0x73, 0x2e, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x76,
+	0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x4c, 0x6f,
+	0x67, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x70,
+	0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x70, 0x61,
+	0x79, 0x6c, 0x6f, 0x61, 0x64, 0x22, 0x1d, 0x0a, 0x0b, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64,
+	0x4e, 0x6f, 0x6f, 0x70, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x02, 0x69, 0x64, 0x22, 0x46, 0x0a, 0x12, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x53,
+	0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x4a, 0x6f, 0x62, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x65,
+	0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x65, 0x65,
+	0x72, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x72, 0x75, 0x6e, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x72, 0x75, 0x6e, 0x49, 0x64, 0x73, 0x22, 0xb3, 0x01, 0x0a,
+	0x10, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4a, 0x6f,
+	0x62, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x65, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x70, 0x65, 0x65, 0x72, 0x49, 0x64, 0x12, 0x4e, 0x0a, 0x07, 0x70, 0x61,
+	0x74, 0x63, 0x68, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x34, 0x2e, 0x6f, 0x64,
+	0x70, 0x66, 0x2e, 0x6f, 0x70, 0x74, 0x69, 0x6d, 0x75, 0x73, 0x2e, 0x63, 0x6c, 0x75, 0x73, 0x74,
+	0x65, 0x72, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x61,
+	0x6e, 0x64, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4a, 0x6f, 0x62, 0x2e, 0x50, 0x61, 0x74, 0x63,
+	0x68, 0x52, 0x07, 0x70, 0x61, 0x74, 0x63, 0x68, 0x65, 0x73, 0x1a, 0x36, 0x0a, 0x05, 0x50, 0x61,
+	0x74, 0x63, 0x68, 0x12, 0x15, 0x0a, 0x06, 0x72, 0x75, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x72, 0x75, 0x6e, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x22, 0x2d, 0x0a, 0x12, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x52, 0x65, 0x6c,
+	0x65, 0x61, 0x73, 0x65, 0x50, 0x65, 0x65, 0x72, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x65, 0x65, 0x72,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x65, 0x65, 0x72, 0x49,
+	0x64, 0x22, 0x29, 0x0a, 0x10, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x43, 0x61, 0x6e, 0x63,
+	0x65, 0x6c, 0x4a, 0x6f, 0x62, 0x12, 0x15, 0x0a, 0x06, 0x72, 0x75, 0x6e, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x72, 0x75, 0x6e, 0x49, 0x64, 0x22, 0x41, 0x0a, 0x10,
+	0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x44, 0x72, 0x61, 0x69, 0x6e, 0x50, 0x65, 0x65, 0x72,
 	0x12, 0x17, 0x0a, 0x07, 0x70, 0x65, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x06, 0x70, 0x65, 0x65, 0x72, 0x49, 0x64, 0x12, 0x4e, 0x0a, 0x07, 0x70, 0x61, 0x74,
-	0x63, 0x68, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x34, 0x2e, 0x6f, 0x64, 0x70,
+	0x09, 0x52, 0x06, 0x70, 0x65, 0x65, 0x72, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x72, 0x61,
+	0x69, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x64, 0x72, 0x61, 0x69, 0x6e, 0x22,
+	0x2e, 0x0a, 0x16, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x50, 0x61, 0x75, 0x73, 0x65, 0x41,
+	0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x61, 0x75,
+	0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x70, 0x61, 0x75, 0x73, 0x65, 0x22,
+	0xaf, 0x03, 0x0a, 0x08, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x12, 0x18, 0x0a, 0x07,
+	0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x76,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x57, 0x0a, 0x0b, 0x61, 0x6c, 0x6c, 0x6f, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x35, 0x2e, 0x6f, 0x64,
+	0x70, 0x66, 0x2e, 0x6f, 0x70, 0x74, 0x69, 0x6d, 0x75, 0x73, 0x2e, 0x63, 0x6c, 0x75, 0x73, 0x74,
+	0x65, 0x72, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x53, 0x6e, 0x61, 0x70, 0x73,
+	0x68, 0x6f, 0x74, 0x2e, 0x50, 0x65, 0x65, 0x72, 0x41, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x0b, 0x61, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12,
+	0x23, 0x0a, 0x0d, 0x64, 0x72, 0x61, 0x69, 0x6e, 0x65, 0x64, 0x5f, 0x70, 0x65, 0x65, 0x72, 0x73,
+	0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0c, 0x64, 0x72, 0x61, 0x69, 0x6e, 0x65, 0x64, 0x50,
+	0x65, 0x65, 0x72, 0x73, 0x12, 0x2b, 0x0a, 0x11, 0x61, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x70, 0x61, 0x75, 0x73, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x10, 0x61, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x61, 0x75, 0x73, 0x65,
+	0x64, 0x1a, 0x69, 0x0a, 0x0e, 0x50, 0x65, 0x65, 0x72, 0x41, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x65, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x65, 0x65, 0x72, 0x49, 0x64, 0x12, 0x3e, 0x0a, 0x04,
+	0x6a, 0x6f, 0x62, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x6f, 0x64, 0x70,
 	0x66, 0x2e, 0x6f, 0x70, 0x74, 0x69, 0x6d, 0x75, 0x73, 0x2e, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65,
-	0x72, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e,
-	0x64, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4a, 0x6f, 0x62, 0x2e, 0x50, 0x61, 0x74, 0x63, 0x68,
-	0x52, 0x07, 0x70, 0x61, 0x74, 0x63, 0x68, 0x65, 0x73, 0x1a, 0x36, 0x0a, 0x05, 0x50, 0x61, 0x74,
-	0x63, 0x68, 0x12, 0x15, 0x0a, 0x06, 0x72, 0x75, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x05, 0x72, 0x75, 0x6e, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61,
-	0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
-	0x73, 0x2a, 0x91, 0x01, 0x0a, 0x0e, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x4c, 0x6f, 0x67,
+	0x72, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68,
+	0x6f, 0x74, 0x2e, 0x4a, 0x6f, 0x62, 0x52, 0x04, 0x6a, 0x6f, 0x62, 0x73, 0x1a, 0x73, 0x0a, 0x03,
+	0x4a, 0x6f, 0x62, 0x12, 0x15, 0x0a, 0x06, 0x72, 0x75, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x72, 0x75, 0x6e, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x12, 0x3d, 0x0a, 0x0c, 0x61, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x65, 0x64, 0x5f,
+	0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x52, 0x0b, 0x61, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x65, 0x64, 0x41,
+	0x74, 0x2a, 0x9d, 0x02, 0x0a, 0x0e, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x4c, 0x6f, 0x67,
 	0x54, 0x79, 0x70, 0x65, 0x12, 0x20, 0x0a, 0x1c, 0x43, 0x4f, 0x4d, 0x4d, 0x41, 0x4e, 0x44, 0x5f,
 	0x4c, 0x4f, 0x47, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49,
 	0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x19, 0x0a, 0x15, 0x43, 0x4f, 0x4d, 0x4d, 0x41, 0x4e,
@@ -385,9 +835,18 @@ var file_odpf_optimus_cluster_v1beta1_command_proto_rawDesc = []byte{
 	0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x53, 0x43, 0x48, 0x45, 0x44, 0x55, 0x4c, 0x45, 0x5f, 0x4a,
 	0x4f, 0x42, 0x10, 0x02, 0x12, 0x1f, 0x0a, 0x1b, 0x43, 0x4f, 0x4d, 0x4d, 0x41, 0x4e, 0x44, 0x5f,
 	0x4c, 0x4f, 0x47, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x50, 0x44, 0x41, 0x54, 0x45, 0x5f,
-	0x4a, 0x4f, 0x42, 0x10, 0x03, 0x42, 0x20, 0x5a, 0x1e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
-	0x63, 0x6f, 0x6d, 0x2f, 0x6f, 0x64, 0x70, 0x66, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x6e, 0x2f,
-	0x6f, 0x70, 0x74, 0x69, 0x6d, 0x75, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x4a, 0x4f, 0x42, 0x10, 0x03, 0x12, 0x21, 0x0a, 0x1d, 0x43, 0x4f, 0x4d, 0x4d, 0x41, 0x4e, 0x44,
+	0x5f, 0x4c, 0x4f, 0x47, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x52, 0x45, 0x4c, 0x45, 0x41, 0x53,
+	0x45, 0x5f, 0x50, 0x45, 0x45, 0x52, 0x10, 0x04, 0x12, 0x1f, 0x0a, 0x1b, 0x43, 0x4f, 0x4d, 0x4d,
+	0x41, 0x4e, 0x44, 0x5f, 0x4c, 0x4f, 0x47, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x43, 0x41, 0x4e,
+	0x43, 0x45, 0x4c, 0x5f, 0x4a, 0x4f, 0x42, 0x10, 0x05, 0x12, 0x1f, 0x0a, 0x1b, 0x43, 0x4f, 0x4d,
+	0x4d, 0x41, 0x4e, 0x44, 0x5f, 0x4c, 0x4f, 0x47, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x44, 0x52,
+	0x41, 0x49, 0x4e, 0x5f, 0x50, 0x45, 0x45, 0x52, 0x10, 0x06, 0x12, 0x25, 0x0a, 0x21, 0x43, 0x4f,
+	0x4d, 0x4d, 0x41, 0x4e, 0x44, 0x5f, 0x4c, 0x4f, 0x47, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x50,
+	0x41, 0x55, 0x53, 0x45, 0x5f, 0x41, 0x4c, 0x4c, 0x4f, 0x43, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x10,
+	0x07, 0x42, 0x20, 0x5a, 0x1e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
+	0x6f, 0x64, 0x70, 0x66, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x6e, 0x2f, 0x6f, 0x70, 0x74, 0x69,
+	0x6d, 0x75, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -403,23 +862,34 @@ func file_odpf_optimus_cluster_v1beta1_command_proto_rawDescGZIP() []byte {
 }
 
 var file_odpf_optimus_cluster_v1beta1_command_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_odpf_optimus_cluster_v1beta1_command_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_odpf_optimus_cluster_v1beta1_command_proto_msgTypes = make([]protoimpl.MessageInfo, 12)
 var file_odpf_optimus_cluster_v1beta1_command_proto_goTypes = []interface{}{
-	(CommandLogType)(0),            // 0: odpf.optimus.cluster.v1beta1.CommandLogType
-	(*CommandLog)(nil),             // 1: odpf.optimus.cluster.v1beta1.CommandLog
-	(*CommandNoop)(nil),            // 2: odpf.optimus.cluster.v1beta1.CommandNoop
-	(*CommandScheduleJob)(nil),     // 3: odpf.optimus.cluster.v1beta1.CommandScheduleJob
-	(*CommandUpdateJob)(nil),       // 4: odpf.optimus.cluster.v1beta1.CommandUpdateJob
-	(*CommandUpdateJob_Patch)(nil), // 5: odpf.optimus.cluster.v1beta1.CommandUpdateJob.Patch
+	(CommandLogType)(0),             // 0: odpf.optimus.cluster.v1beta1.CommandLogType
+	(*CommandLog)(nil),              // 1: odpf.optimus.cluster.v1beta1.CommandLog
+	(*CommandNoop)(nil),             // 2: odpf.optimus.cluster.v1beta1.CommandNoop
+	(*CommandScheduleJob)(nil),      // 3: odpf.optimus.cluster.v1beta1.CommandScheduleJob
+	(*CommandUpdateJob)(nil),        // 4: odpf.optimus.cluster.v1beta1.CommandUpdateJob
+	(*CommandReleasePeer)(nil),      // 5: odpf.optimus.cluster.v1beta1.CommandReleasePeer
+	(*CommandCancelJob)(nil),        // 6: odpf.optimus.cluster.v1beta1.CommandCancelJob
+	(*CommandDrainPeer)(nil),        // 7: odpf.optimus.cluster.v1beta1.CommandDrainPeer
+	(*CommandPauseAllocation)(nil),  // 8: odpf.optimus.cluster.v1beta1.CommandPauseAllocation
+	(*Snapshot)(nil),                // 9: odpf.optimus.cluster.v1beta1.Snapshot
+	(*CommandUpdateJob_Patch)(nil),  // 10: odpf.optimus.cluster.v1beta1.CommandUpdateJob.Patch
+	(*Snapshot_PeerAllocation)(nil), // 11: odpf.optimus.cluster.v1beta1.Snapshot.PeerAllocation
+	(*Snapshot_Job)(nil),            // 12: odpf.optimus.cluster.v1beta1.Snapshot.Job
+	(*timestamppb.Timestamp)(nil),   // 13: google.protobuf.Timestamp
 }
 var file_odpf_optimus_cluster_v1beta1_command_proto_depIdxs = []int32{
-	0, // 0: odpf.optimus.cluster.v1beta1.CommandLog.type:type_name -> odpf.optimus.cluster.v1beta1.CommandLogType
-	5, // 1: odpf.optimus.cluster.v1beta1.CommandUpdateJob.patches:type_name -> odpf.optimus.cluster.v1beta1.CommandUpdateJob.Patch
-	2, // [2:2] is the sub-list for method output_type
-	2, // [2:2] is the sub-list for method input_type
-	2, // [2:2] is the sub-list for extension type_name
-	2, // [2:2] is the sub-list for extension extendee
-	0, // [0:2] is the sub-list for field type_name
+	0,  // 0: odpf.optimus.cluster.v1beta1.CommandLog.type:type_name -> odpf.optimus.cluster.v1beta1.CommandLogType
+	10, // 1: odpf.optimus.cluster.v1beta1.CommandUpdateJob.patches:type_name -> odpf.optimus.cluster.v1beta1.CommandUpdateJob.Patch
+	11, // 2: odpf.optimus.cluster.v1beta1.Snapshot.allocations:type_name -> odpf.optimus.cluster.v1beta1.Snapshot.PeerAllocation
+	12, // 3: odpf.optimus.cluster.v1beta1.Snapshot.PeerAllocation.jobs:type_name -> odpf.optimus.cluster.v1beta1.Snapshot.Job
+	13, // 4: odpf.optimus.cluster.v1beta1.Snapshot.Job.allocated_at:type_name -> google.protobuf.Timestamp
+	5,  // [5:5] is the sub-list for method output_type
+	5,  // [5:5] is the sub-list for method input_type
+	5,  // [5:5] is the sub-list for extension type_name
+	5,  // [5:5] is the sub-list for extension extendee
+	0,  // [0:5] is the sub-list for field type_name
 }
 
 func init() { file_odpf_optimus_cluster_v1beta1_command_proto_init() }
@@ -477,6 +947,66 @@ func file_odpf_optimus_cluster_v1beta1_command_proto_init() {
 			}
 		}
 		file_odpf_optimus_cluster_v1beta1_command_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CommandReleasePeer); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_odpf_optimus_cluster_v1beta1_command_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CommandCancelJob); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_odpf_optimus_cluster_v1beta1_command_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CommandDrainPeer); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_odpf_optimus_cluster_v1beta1_command_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CommandPauseAllocation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_odpf_optimus_cluster_v1beta1_command_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Snapshot); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_odpf_optimus_cluster_v1beta1_command_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*CommandUpdateJob_Patch); i {
 			case 0:
 				return &v.state
@@ -488,6 +1018,30 @@ func file_odpf_optimus_cluster_v1beta1_command_proto_init() {
 				return nil
 			}
 		}
+		file_odpf_optimus_cluster_v1beta1_command_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Snapshot_PeerAllocation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_odpf_optimus_cluster_v1beta1_command_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Snapshot_Job); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -495,7 +1049,7 @@ func file_odpf_optimus_cluster_v1beta1_command_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_odpf_optimus_cluster_v1beta1_command_proto_rawDesc,
 			NumEnums:      1,
-			NumMessages:   5,
+			NumMessages:   12,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
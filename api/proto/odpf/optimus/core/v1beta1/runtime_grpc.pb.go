@@ -48,10 +48,26 @@ type RuntimeServiceClient interface {
 	RegisterSecret(ctx context.Context, in *RegisterSecretRequest, opts ...grpc.CallOption) (*RegisterSecretResponse, error)
 	// UpdateSecret updates secret at project level
 	UpdateSecret(ctx context.Context, in *UpdateSecretRequest, opts ...grpc.CallOption) (*UpdateSecretResponse, error)
+	// ListSecrets shows the secrets registered for a project
+	ListSecrets(ctx context.Context, in *ListSecretsRequest, opts ...grpc.CallOption) (*ListSecretsResponse, error)
+	// DeleteSecret removes a secret of project, system secrets can't be deleted
+	DeleteSecret(ctx context.Context, in *DeleteSecretRequest, opts ...grpc.CallOption) (*DeleteSecretResponse, error)
+	// DeleteProjectNamespace removes a namespace along with its jobs, resources,
+	// secrets, replays and backups
+	DeleteProjectNamespace(ctx context.Context, in *DeleteProjectNamespaceRequest, opts ...grpc.CallOption) (*DeleteProjectNamespaceResponse, error)
+	// DeleteProject removes a project along with all of its namespaces and secrets
+	DeleteProject(ctx context.Context, in *DeleteProjectRequest, opts ...grpc.CallOption) (*DeleteProjectResponse, error)
 	// ListProjects returns list of registered projects and configurations
 	ListProjects(ctx context.Context, in *ListProjectsRequest, opts ...grpc.CallOption) (*ListProjectsResponse, error)
 	// ListProjectNamespaces returns list of namespaces of a project
 	ListProjectNamespaces(ctx context.Context, in *ListProjectNamespacesRequest, opts ...grpc.CallOption) (*ListProjectNamespacesResponse, error)
+	// GetProject returns configurations of a project along with its namespaces
+	GetProject(ctx context.Context, in *GetProjectRequest, opts ...grpc.CallOption) (*GetProjectResponse, error)
+	// GetProjectNamespace returns configurations of a namespace merged with
+	// configurations of its project
+	GetProjectNamespace(ctx context.Context, in *GetProjectNamespaceRequest, opts ...grpc.CallOption) (*GetProjectNamespaceResponse, error)
+	// UpdateProjectNamespaceConfig sets or unsets configurations of a namespace
+	UpdateProjectNamespaceConfig(ctx context.Context, in *UpdateProjectNamespaceConfigRequest, opts ...grpc.CallOption) (*UpdateProjectNamespaceConfigResponse, error)
 	// RegisterInstance is an internal admin command used during task/hook execution
 	// to pull task/hook compiled configuration and assets.
 	RegisterInstance(ctx context.Context, in *RegisterInstanceRequest, opts ...grpc.CallOption) (*RegisterInstanceResponse, error)
@@ -59,6 +75,8 @@ type RuntimeServiceClient interface {
 	JobStatus(ctx context.Context, in *JobStatusRequest, opts ...grpc.CallOption) (*JobStatusResponse, error)
 	// RegisterJobEvent notifies optimus service about an event related to job
 	RegisterJobEvent(ctx context.Context, in *RegisterJobEventRequest, opts ...grpc.CallOption) (*RegisterJobEventResponse, error)
+	// ListJobEvents returns the events registered for a job
+	ListJobEvents(ctx context.Context, in *ListJobEventsRequest, opts ...grpc.CallOption) (*ListJobEventsResponse, error)
 	// GetWindow provides the start and end dates provided a scheduled date
 	// of the execution window
 	GetWindow(ctx context.Context, in *GetWindowRequest, opts ...grpc.CallOption) (*GetWindowResponse, error)
@@ -85,6 +103,18 @@ type RuntimeServiceClient interface {
 	// RunJob creates a job run and executes all included tasks/hooks instantly
 	// this doesn't necessarily deploy the job in db first
 	RunJob(ctx context.Context, in *RunJobRequest, opts ...grpc.CallOption) (*RunJobResponse, error)
+	// GetClusterState returns peers of the built-in scheduler cluster along
+	// with the job runs allocated to them
+	GetClusterState(ctx context.Context, in *GetClusterStateRequest, opts ...grpc.CallOption) (*GetClusterStateResponse, error)
+	// ApplyClusterCommand replicates an operator command across the built-in
+	// scheduler cluster, it should be sent to the cluster leader
+	ApplyClusterCommand(ctx context.Context, in *ApplyClusterCommandRequest, opts ...grpc.CallOption) (*ApplyClusterCommandResponse, error)
+	// ListAuditEntries returns the recorded calls which changed state of
+	// optimus, latest first
+	ListAuditEntries(ctx context.Context, in *ListAuditEntriesRequest, opts ...grpc.CallOption) (*ListAuditEntriesResponse, error)
+	// ReencryptSecrets re-encrypts all the stored secrets which are not
+	// encrypted with the current application key
+	ReencryptSecrets(ctx context.Context, in *ReencryptSecretsRequest, opts ...grpc.CallOption) (*ReencryptSecretsResponse, error)
 }
 
 type runtimeServiceClient struct {
@@ -258,6 +288,42 @@ func (c *runtimeServiceClient) UpdateSecret(ctx context.Context, in *UpdateSecre
 	return out, nil
 }
 
+func (c *runtimeServiceClient) ListSecrets(ctx context.Context, in *ListSecretsRequest, opts ...grpc.CallOption) (*ListSecretsResponse, error) {
+	out := new(ListSecretsResponse)
+	err := c.cc.Invoke(ctx, "/odpf.optimus.core.v1beta1.RuntimeService/ListSecrets", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *runtimeServiceClient) DeleteSecret(ctx context.Context, in *DeleteSecretRequest, opts ...grpc.CallOption) (*DeleteSecretResponse, error) {
+	out := new(DeleteSecretResponse)
+	err := c.cc.Invoke(ctx, "/odpf.optimus.core.v1beta1.RuntimeService/DeleteSecret", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *runtimeServiceClient) DeleteProjectNamespace(ctx context.Context, in *DeleteProjectNamespaceRequest, opts ...grpc.CallOption) (*DeleteProjectNamespaceResponse, error) {
+	out := new(DeleteProjectNamespaceResponse)
+	err := c.cc.Invoke(ctx, "/odpf.optimus.core.v1beta1.RuntimeService/DeleteProjectNamespace", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *runtimeServiceClient) DeleteProject(ctx context.Context, in *DeleteProjectRequest, opts ...grpc.CallOption) (*DeleteProjectResponse, error) {
+	out := new(DeleteProjectResponse)
+	err := c.cc.Invoke(ctx, "/odpf.optimus.core.v1beta1.RuntimeService/DeleteProject", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *runtimeServiceClient) ListProjects(ctx context.Context, in *ListProjectsRequest, opts ...grpc.CallOption) (*ListProjectsResponse, error) {
 	out := new(ListProjectsResponse)
 	err := c.cc.Invoke(ctx, "/odpf.optimus.core.v1beta1.RuntimeService/ListProjects", in, out, opts...)
@@ -276,6 +342,33 @@ func (c *runtimeServiceClient) ListProjectNamespaces(ctx context.Context, in *Li
 	return out, nil
 }
 
+func (c *runtimeServiceClient) GetProject(ctx context.Context, in *GetProjectRequest, opts ...grpc.CallOption) (*GetProjectResponse, error) {
+	out := new(GetProjectResponse)
+	err := c.cc.Invoke(ctx, "/odpf.optimus.core.v1beta1.RuntimeService/GetProject", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *runtimeServiceClient) GetProjectNamespace(ctx context.Context, in *GetProjectNamespaceRequest, opts ...grpc.CallOption) (*GetProjectNamespaceResponse, error) {
+	out := new(GetProjectNamespaceResponse)
+	err := c.cc.Invoke(ctx, "/odpf.optimus.core.v1beta1.RuntimeService/GetProjectNamespace", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *runtimeServiceClient) UpdateProjectNamespaceConfig(ctx context.Context, in *UpdateProjectNamespaceConfigRequest, opts ...grpc.CallOption) (*UpdateProjectNamespaceConfigResponse, error) {
+	out := new(UpdateProjectNamespaceConfigResponse)
+	err := c.cc.Invoke(ctx, "/odpf.optimus.core.v1beta1.RuntimeService/UpdateProjectNamespaceConfig", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *runtimeServiceClient) RegisterInstance(ctx context.Context, in *RegisterInstanceRequest, opts ...grpc.CallOption) (*RegisterInstanceResponse, error) {
 	out := new(RegisterInstanceResponse)
 	err := c.cc.Invoke(ctx, "/odpf.optimus.core.v1beta1.RuntimeService/RegisterInstance", in, out, opts...)
@@ -303,6 +396,15 @@ func (c *runtimeServiceClient) RegisterJobEvent(ctx context.Context, in *Registe
 	return out, nil
 }
 
+func (c *runtimeServiceClient) ListJobEvents(ctx context.Context, in *ListJobEventsRequest, opts ...grpc.CallOption) (*ListJobEventsResponse, error) {
+	out := new(ListJobEventsResponse)
+	err := c.cc.Invoke(ctx, "/odpf.optimus.core.v1beta1.RuntimeService/ListJobEvents", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *runtimeServiceClient) GetWindow(ctx context.Context, in *GetWindowRequest, opts ...grpc.CallOption) (*GetWindowResponse, error) {
 	out := new(GetWindowResponse)
 	err := c.cc.Invoke(ctx, "/odpf.optimus.core.v1beta1.RuntimeService/GetWindow", in, out, opts...)
@@ -461,6 +563,42 @@ func (c *runtimeServiceClient) RunJob(ctx context.Context, in *RunJobRequest, op
 	return out, nil
 }
 
+func (c *runtimeServiceClient) GetClusterState(ctx context.Context, in *GetClusterStateRequest, opts ...grpc.CallOption) (*GetClusterStateResponse, error) {
+	out := new(GetClusterStateResponse)
+	err := c.cc.Invoke(ctx, "/odpf.optimus.core.v1beta1.RuntimeService/GetClusterState", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *runtimeServiceClient) ApplyClusterCommand(ctx context.Context, in *ApplyClusterCommandRequest, opts ...grpc.CallOption) (*ApplyClusterCommandResponse, error) {
+	out := new(ApplyClusterCommandResponse)
+	err := c.cc.Invoke(ctx, "/odpf.optimus.core.v1beta1.RuntimeService/ApplyClusterCommand", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *runtimeServiceClient) ListAuditEntries(ctx context.Context, in *ListAuditEntriesRequest, opts ...grpc.CallOption) (*ListAuditEntriesResponse, error) {
+	out := new(ListAuditEntriesResponse)
+	err := c.cc.Invoke(ctx, "/odpf.optimus.core.v1beta1.RuntimeService/ListAuditEntries", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *runtimeServiceClient) ReencryptSecrets(ctx context.Context, in *ReencryptSecretsRequest, opts ...grpc.CallOption) (*ReencryptSecretsResponse, error) {
+	out := new(ReencryptSecretsResponse)
+	err := c.cc.Invoke(ctx, "/odpf.optimus.core.v1beta1.RuntimeService/ReencryptSecrets", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // RuntimeServiceServer is the server API for RuntimeService service.
 // All implementations must embed UnimplementedRuntimeServiceServer
 // for forward compatibility
@@ -495,10 +633,26 @@ type RuntimeServiceServer interface {
 	RegisterSecret(context.Context, *RegisterSecretRequest) (*RegisterSecretResponse, error)
 	// UpdateSecret updates secret at project level
 	UpdateSecret(context.Context, *UpdateSecretRequest) (*UpdateSecretResponse, error)
+	// ListSecrets shows the secrets registered for a project
+	ListSecrets(context.Context, *ListSecretsRequest) (*ListSecretsResponse, error)
+	// DeleteSecret removes a secret of project, system secrets can't be deleted
+	DeleteSecret(context.Context, *DeleteSecretRequest) (*DeleteSecretResponse, error)
+	// DeleteProjectNamespace removes a namespace along with its jobs, resources,
+	// secrets, replays and backups
+	DeleteProjectNamespace(context.Context, *DeleteProjectNamespaceRequest) (*DeleteProjectNamespaceResponse, error)
+	// DeleteProject removes a project along with all of its namespaces and secrets
+	DeleteProject(context.Context, *DeleteProjectRequest) (*DeleteProjectResponse, error)
 	// ListProjects returns list of registered projects and configurations
 	ListProjects(context.Context, *ListProjectsRequest) (*ListProjectsResponse, error)
 	// ListProjectNamespaces returns list of namespaces of a project
 	ListProjectNamespaces(context.Context, *ListProjectNamespacesRequest) (*ListProjectNamespacesResponse, error)
+	// GetProject returns configurations of a project along with its namespaces
+	GetProject(context.Context, *GetProjectRequest) (*GetProjectResponse, error)
+	// GetProjectNamespace returns configurations of a namespace merged with
+	// configurations of its project
+	GetProjectNamespace(context.Context, *GetProjectNamespaceRequest) (*GetProjectNamespaceResponse, error)
+	// UpdateProjectNamespaceConfig sets or unsets configurations of a namespace
+	UpdateProjectNamespaceConfig(context.Context, *UpdateProjectNamespaceConfigRequest) (*UpdateProjectNamespaceConfigResponse, error)
 	// RegisterInstance is an internal admin command used during task/hook execution
 	// to pull task/hook compiled configuration and assets.
 	RegisterInstance(context.Context, *RegisterInstanceRequest) (*RegisterInstanceResponse, error)
@@ -506,6 +660,8 @@ type RuntimeServiceServer interface {
 	JobStatus(context.Context, *JobStatusRequest) (*JobStatusResponse, error)
 	// RegisterJobEvent notifies optimus service about an event related to job
 	RegisterJobEvent(context.Context, *RegisterJobEventRequest) (*RegisterJobEventResponse, error)
+	// ListJobEvents returns the events registered for a job
+	ListJobEvents(context.Context, *ListJobEventsRequest) (*ListJobEventsResponse, error)
 	// GetWindow provides the start and end dates provided a scheduled date
 	// of the execution window
 	GetWindow(context.Context, *GetWindowRequest) (*GetWindowResponse, error)
@@ -532,6 +688,18 @@ type RuntimeServiceServer interface {
 	// RunJob creates a job run and executes all included tasks/hooks instantly
 	// this doesn't necessarily deploy the job in db first
 	RunJob(context.Context, *RunJobRequest) (*RunJobResponse, error)
+	// GetClusterState returns peers of the built-in scheduler cluster along
+	// with the job runs allocated to them
+	GetClusterState(context.Context, *GetClusterStateRequest) (*GetClusterStateResponse, error)
+	// ApplyClusterCommand replicates an operator command across the built-in
+	// scheduler cluster, it should be sent to the cluster leader
+	ApplyClusterCommand(context.Context, *ApplyClusterCommandRequest) (*ApplyClusterCommandResponse, error)
+	// ListAuditEntries returns the recorded calls which changed state of
+	// optimus, latest first
+	ListAuditEntries(context.Context, *ListAuditEntriesRequest) (*ListAuditEntriesResponse, error)
+	// ReencryptSecrets re-encrypts all the stored secrets which are not
+	// encrypted with the current application key
+	ReencryptSecrets(context.Context, *ReencryptSecretsRequest) (*ReencryptSecretsResponse, error)
 	mustEmbedUnimplementedRuntimeServiceServer()
 }
 
@@ -578,12 +746,33 @@ func (UnimplementedRuntimeServiceServer) RegisterSecret(context.Context, *Regist
 func (UnimplementedRuntimeServiceServer) UpdateSecret(context.Context, *UpdateSecretRequest) (*UpdateSecretResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateSecret not implemented")
 }
+func (UnimplementedRuntimeServiceServer) ListSecrets(context.Context, *ListSecretsRequest) (*ListSecretsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSecrets not implemented")
+}
+func (UnimplementedRuntimeServiceServer) DeleteSecret(context.Context, *DeleteSecretRequest) (*DeleteSecretResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteSecret not implemented")
+}
+func (UnimplementedRuntimeServiceServer) DeleteProjectNamespace(context.Context, *DeleteProjectNamespaceRequest) (*DeleteProjectNamespaceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteProjectNamespace not implemented")
+}
+func (UnimplementedRuntimeServiceServer) DeleteProject(context.Context, *DeleteProjectRequest) (*DeleteProjectResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteProject not implemented")
+}
 func (UnimplementedRuntimeServiceServer) ListProjects(context.Context, *ListProjectsRequest) (*ListProjectsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListProjects not implemented")
 }
 func (UnimplementedRuntimeServiceServer) ListProjectNamespaces(context.Context, *ListProjectNamespacesRequest) (*ListProjectNamespacesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListProjectNamespaces not implemented")
 }
+func (UnimplementedRuntimeServiceServer) GetProject(context.Context, *GetProjectRequest) (*GetProjectResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProject not implemented")
+}
+func (UnimplementedRuntimeServiceServer) GetProjectNamespace(context.Context, *GetProjectNamespaceRequest) (*GetProjectNamespaceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProjectNamespace not implemented")
+}
+func (UnimplementedRuntimeServiceServer) UpdateProjectNamespaceConfig(context.Context, *UpdateProjectNamespaceConfigRequest) (*UpdateProjectNamespaceConfigResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateProjectNamespaceConfig not implemented")
+}
 func (UnimplementedRuntimeServiceServer) RegisterInstance(context.Context, *RegisterInstanceRequest) (*RegisterInstanceResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RegisterInstance not implemented")
 }
@@ -593,6 +782,9 @@ func (UnimplementedRuntimeServiceServer) JobStatus(context.Context, *JobStatusRe
 func (UnimplementedRuntimeServiceServer) RegisterJobEvent(context.Context, *RegisterJobEventRequest) (*RegisterJobEventResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RegisterJobEvent not implemented")
 }
+func (UnimplementedRuntimeServiceServer) ListJobEvents(context.Context, *ListJobEventsRequest) (*ListJobEventsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListJobEvents not implemented")
+}
 func (UnimplementedRuntimeServiceServer) GetWindow(context.Context, *GetWindowRequest) (*GetWindowResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetWindow not implemented")
 }
@@ -638,6 +830,18 @@ func (UnimplementedRuntimeServiceServer) GetBackup(context.Context, *GetBackupRe
 func (UnimplementedRuntimeServiceServer) RunJob(context.Context, *RunJobRequest) (*RunJobResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RunJob not implemented")
 }
+func (UnimplementedRuntimeServiceServer) GetClusterState(context.Context, *GetClusterStateRequest) (*GetClusterStateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetClusterState not implemented")
+}
+func (UnimplementedRuntimeServiceServer) ApplyClusterCommand(context.Context, *ApplyClusterCommandRequest) (*ApplyClusterCommandResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApplyClusterCommand not implemented")
+}
+func (UnimplementedRuntimeServiceServer) ListAuditEntries(context.Context, *ListAuditEntriesRequest) (*ListAuditEntriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAuditEntries not implemented")
+}
+func (UnimplementedRuntimeServiceServer) ReencryptSecrets(context.Context, *ReencryptSecretsRequest) (*ReencryptSecretsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReencryptSecrets not implemented")
+}
 func (UnimplementedRuntimeServiceServer) mustEmbedUnimplementedRuntimeServiceServer() {}
 
 // UnsafeRuntimeServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -891,6 +1095,78 @@ func _RuntimeService_UpdateSecret_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _RuntimeService_ListSecrets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSecretsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RuntimeServiceServer).ListSecrets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/odpf.optimus.core.v1beta1.RuntimeService/ListSecrets",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RuntimeServiceServer).ListSecrets(ctx, req.(*ListSecretsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RuntimeService_DeleteSecret_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteSecretRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RuntimeServiceServer).DeleteSecret(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/odpf.optimus.core.v1beta1.RuntimeService/DeleteSecret",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RuntimeServiceServer).DeleteSecret(ctx, req.(*DeleteSecretRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RuntimeService_DeleteProjectNamespace_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteProjectNamespaceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RuntimeServiceServer).DeleteProjectNamespace(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/odpf.optimus.core.v1beta1.RuntimeService/DeleteProjectNamespace",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RuntimeServiceServer).DeleteProjectNamespace(ctx, req.(*DeleteProjectNamespaceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RuntimeService_DeleteProject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteProjectRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RuntimeServiceServer).DeleteProject(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/odpf.optimus.core.v1beta1.RuntimeService/DeleteProject",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RuntimeServiceServer).DeleteProject(ctx, req.(*DeleteProjectRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _RuntimeService_ListProjects_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ListProjectsRequest)
 	if err := dec(in); err != nil {
@@ -927,6 +1203,60 @@ func _RuntimeService_ListProjectNamespaces_Handler(srv interface{}, ctx context.
 	return interceptor(ctx, in, info, handler)
 }
 
+func _RuntimeService_GetProject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetProjectRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RuntimeServiceServer).GetProject(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/odpf.optimus.core.v1beta1.RuntimeService/GetProject",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RuntimeServiceServer).GetProject(ctx, req.(*GetProjectRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RuntimeService_GetProjectNamespace_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetProjectNamespaceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RuntimeServiceServer).GetProjectNamespace(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/odpf.optimus.core.v1beta1.RuntimeService/GetProjectNamespace",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RuntimeServiceServer).GetProjectNamespace(ctx, req.(*GetProjectNamespaceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RuntimeService_UpdateProjectNamespaceConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateProjectNamespaceConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RuntimeServiceServer).UpdateProjectNamespaceConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/odpf.optimus.core.v1beta1.RuntimeService/UpdateProjectNamespaceConfig",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RuntimeServiceServer).UpdateProjectNamespaceConfig(ctx, req.(*UpdateProjectNamespaceConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _RuntimeService_RegisterInstance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(RegisterInstanceRequest)
 	if err := dec(in); err != nil {
@@ -981,6 +1311,24 @@ func _RuntimeService_RegisterJobEvent_Handler(srv interface{}, ctx context.Conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _RuntimeService_ListJobEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListJobEventsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RuntimeServiceServer).ListJobEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/odpf.optimus.core.v1beta1.RuntimeService/ListJobEvents",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RuntimeServiceServer).ListJobEvents(ctx, req.(*ListJobEventsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _RuntimeService_GetWindow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetWindowRequest)
 	if err := dec(in); err != nil {
@@ -1254,6 +1602,78 @@ func _RuntimeService_RunJob_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _RuntimeService_GetClusterState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetClusterStateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RuntimeServiceServer).GetClusterState(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/odpf.optimus.core.v1beta1.RuntimeService/GetClusterState",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RuntimeServiceServer).GetClusterState(ctx, req.(*GetClusterStateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RuntimeService_ApplyClusterCommand_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApplyClusterCommandRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RuntimeServiceServer).ApplyClusterCommand(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/odpf.optimus.core.v1beta1.RuntimeService/ApplyClusterCommand",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RuntimeServiceServer).ApplyClusterCommand(ctx, req.(*ApplyClusterCommandRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RuntimeService_ListAuditEntries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAuditEntriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RuntimeServiceServer).ListAuditEntries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/odpf.optimus.core.v1beta1.RuntimeService/ListAuditEntries",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RuntimeServiceServer).ListAuditEntries(ctx, req.(*ListAuditEntriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RuntimeService_ReencryptSecrets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReencryptSecretsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RuntimeServiceServer).ReencryptSecrets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/odpf.optimus.core.v1beta1.RuntimeService/ReencryptSecrets",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RuntimeServiceServer).ReencryptSecrets(ctx, req.(*ReencryptSecretsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // RuntimeService_ServiceDesc is the grpc.ServiceDesc for RuntimeService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -1305,6 +1725,22 @@ var RuntimeService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateSecret",
 			Handler:    _RuntimeService_UpdateSecret_Handler,
 		},
+		{
+			MethodName: "ListSecrets",
+			Handler:    _RuntimeService_ListSecrets_Handler,
+		},
+		{
+			MethodName: "DeleteSecret",
+			Handler:    _RuntimeService_DeleteSecret_Handler,
+		},
+		{
+			MethodName: "DeleteProjectNamespace",
+			Handler:    _RuntimeService_DeleteProjectNamespace_Handler,
+		},
+		{
+			MethodName: "DeleteProject",
+			Handler:    _RuntimeService_DeleteProject_Handler,
+		},
 		{
 			MethodName: "ListProjects",
 			Handler:    _RuntimeService_ListProjects_Handler,
@@ -1313,6 +1749,18 @@ var RuntimeService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListProjectNamespaces",
 			Handler:    _RuntimeService_ListProjectNamespaces_Handler,
 		},
+		{
+			MethodName: "GetProject",
+			Handler:    _RuntimeService_GetProject_Handler,
+		},
+		{
+			MethodName: "GetProjectNamespace",
+			Handler:    _RuntimeService_GetProjectNamespace_Handler,
+		},
+		{
+			MethodName: "UpdateProjectNamespaceConfig",
+			Handler:    _RuntimeService_UpdateProjectNamespaceConfig_Handler,
+		},
 		{
 			MethodName: "RegisterInstance",
 			Handler:    _RuntimeService_RegisterInstance_Handler,
@@ -1325,6 +1773,10 @@ var RuntimeService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RegisterJobEvent",
 			Handler:    _RuntimeService_RegisterJobEvent_Handler,
 		},
+		{
+			MethodName: "ListJobEvents",
+			Handler:    _RuntimeService_ListJobEvents_Handler,
+		},
 		{
 			MethodName: "GetWindow",
 			Handler:    _RuntimeService_GetWindow_Handler,
@@ -1381,6 +1833,22 @@ var RuntimeService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RunJob",
 			Handler:    _RuntimeService_RunJob_Handler,
 		},
+		{
+			MethodName: "GetClusterState",
+			Handler:    _RuntimeService_GetClusterState_Handler,
+		},
+		{
+			MethodName: "ApplyClusterCommand",
+			Handler:    _RuntimeService_ApplyClusterCommand_Handler,
+		},
+		{
+			MethodName: "ListAuditEntries",
+			Handler:    _RuntimeService_ListAuditEntries_Handler,
+		},
+		{
+			MethodName: "ReencryptSecrets",
+			Handler:    _RuntimeService_ReencryptSecrets_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -14,6 +14,9 @@ func adminCommand(l log.Logger, conf config.Provider) *cli.Command {
 		Hidden: true,
 	}
 	cmd.AddCommand(adminBuildCommand(l, conf))
+	cmd.AddCommand(adminClusterCommand(l, conf))
+	cmd.AddCommand(adminSecretsCommand(l, conf))
+	cmd.AddCommand(adminAuditCommand(l, conf))
 	return cmd
 }
 
@@ -0,0 +1,139 @@
+package cmd
+
+import (
+	"context"
+	"time"
+
+	pb "github.com/odpf/optimus/api/proto/odpf/optimus/core/v1beta1"
+	"github.com/odpf/optimus/config"
+	"github.com/odpf/salt/log"
+	"github.com/olekukonko/tablewriter"
+	"github.com/pkg/errors"
+	cli "github.com/spf13/cobra"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var (
+	adminAuditListLimit   = 100
+	adminAuditListTimeout = time.Second * 30
+)
+
+// adminAuditCommand inspects calls made to optimus service which changed its state
+func adminAuditCommand(l log.Logger, conf config.Provider) *cli.Command {
+	cmd := &cli.Command{
+		Use:   "audit",
+		Short: "Inspect audit log of optimus service",
+	}
+	cmd.AddCommand(adminAuditListCommand(l, conf))
+	return cmd
+}
+
+func adminAuditListCommand(l log.Logger, conf config.Provider) *cli.Command {
+	var (
+		optimusHost = conf.GetHost()
+		projectName string
+		actor       string
+		since       string
+		until       string
+		limit       = adminAuditListLimit
+		cmd         = &cli.Command{
+			Use:   "list",
+			Short: "List calls which deployed, changed or deleted anything, latest first",
+			Long: `List audit entries recorded for calls which changed state of optimus, it needs
+admin role in the project, or in all projects if project is not provided. Time range
+accepts RFC3339 timestamps or durations relative to now.`,
+			Example: "optimus admin audit list --project a-data-project --since 24h",
+		}
+	)
+	cmd.Flags().StringVar(&optimusHost, "host", optimusHost, "Optimus service endpoint url")
+	cmd.Flags().StringVar(&projectName, "project", "", "Project name of calls")
+	cmd.Flags().StringVar(&actor, "actor", "", "Identity of caller")
+	cmd.Flags().StringVar(&since, "since", "", "List calls made at or after, e.g. 24h or 2021-10-01T00:00:00Z")
+	cmd.Flags().StringVar(&until, "until", "", "List calls made before, e.g. 1h or 2021-10-02T00:00:00Z")
+	cmd.Flags().IntVar(&limit, "limit", limit, "Maximum number of entries listed")
+
+	cmd.RunE = func(c *cli.Command, args []string) error {
+		now := time.Now().UTC()
+		req := &pb.ListAuditEntriesRequest{
+			ProjectName: projectName,
+			Actor:       actor,
+			Limit:       int32(limit),
+		}
+		sinceTime, err := parseAuditTime(since, now)
+		if err != nil {
+			return errors.Wrap(err, "invalid since")
+		}
+		if !sinceTime.IsZero() {
+			req.Since = timestamppb.New(sinceTime)
+		}
+		untilTime, err := parseAuditTime(until, now)
+		if err != nil {
+			return errors.Wrap(err, "invalid until")
+		}
+		if !untilTime.IsZero() {
+			req.Until = timestamppb.New(untilTime)
+		}
+		return listAuditEntries(l, optimusHost, req)
+	}
+	return cmd
+}
+
+func listAuditEntries(l log.Logger, host string, req *pb.ListAuditEntriesRequest) error {
+	dialTimeoutCtx, dialCancel := context.WithTimeout(context.Background(), OptimusDialTimeout)
+	defer dialCancel()
+
+	conn, err := createConnection(dialTimeoutCtx, host)
+	if err != nil {
+		if errors.Is(err, context.DeadlineExceeded) {
+			l.Error(ErrServerNotReachable(host).Error())
+		}
+		return err
+	}
+	defer conn.Close()
+
+	timeoutCtx, cancel := context.WithTimeout(context.Background(), adminAuditListTimeout)
+	defer cancel()
+
+	runtime := pb.NewRuntimeServiceClient(conn)
+	listResponse, err := runtime.ListAuditEntries(timeoutCtx, req)
+	if err != nil {
+		return errors.Wrap(err, "request failed to list audit entries")
+	}
+
+	table := tablewriter.NewWriter(l.Writer())
+	table.SetBorder(false)
+	table.SetHeader([]string{
+		"Time",
+		"Actor",
+		"Method",
+		"Project",
+		"Namespace",
+		"Resource",
+		"Status",
+	})
+	for _, entry := range listResponse.Entries {
+		table.Append([]string{
+			entry.CreatedAt.AsTime().Format(time.RFC3339),
+			entry.Actor,
+			entry.Method,
+			entry.ProjectName,
+			entry.NamespaceName,
+			entry.Resource,
+			entry.Status,
+		})
+	}
+	table.Render()
+	return nil
+}
+
+// parseAuditTime parses value as RFC3339 timestamp or as duration before now,
+// empty value is parsed as zero time
+func parseAuditTime(value string, now time.Time) (time.Time, error) {
+	if value == "" {
+		return time.Time{}, nil
+	}
+	if duration, err := time.ParseDuration(value); err == nil {
+		return now.Add(-duration), nil
+	}
+	return time.Parse(time.RFC3339, value)
+}
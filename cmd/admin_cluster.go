@@ -0,0 +1,181 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/olekukonko/tablewriter"
+
+	pb "github.com/odpf/optimus/api/proto/odpf/optimus/core/v1beta1"
+	"github.com/odpf/optimus/config"
+	"github.com/odpf/salt/log"
+	"github.com/pkg/errors"
+	cli "github.com/spf13/cobra"
+)
+
+var (
+	adminClusterTimeout = time.Second * 30
+)
+
+// adminClusterCommand manages peers of the built-in scheduler cluster
+func adminClusterCommand(l log.Logger, conf config.Provider) *cli.Command {
+	var (
+		optimusHost = conf.GetHost()
+		cmd         = &cli.Command{
+			Use:   "cluster",
+			Short: "Manage peers of the built-in scheduler cluster",
+		}
+	)
+	cmd.PersistentFlags().StringVar(&optimusHost, "host", optimusHost, "Optimus service endpoint url, commands should be sent to cluster leader")
+
+	cmd.AddCommand(&cli.Command{
+		Use:     "status",
+		Short:   "List members, leader and job runs allocated to each peer",
+		Example: "optimus admin cluster status",
+		RunE: func(c *cli.Command, args []string) error {
+			return getClusterState(l, optimusHost)
+		},
+	})
+	cmd.AddCommand(&cli.Command{
+		Use:     "drain",
+		Short:   "Stop allocating new job runs to a peer",
+		Example: "optimus admin cluster drain <node-2>",
+		Args:    cli.ExactArgs(1),
+		RunE: func(c *cli.Command, args []string) error {
+			return applyClusterCommand(l, optimusHost, &pb.ApplyClusterCommandRequest{
+				Type:   pb.ApplyClusterCommandRequest_TYPE_DRAIN_PEER,
+				PeerId: args[0],
+			})
+		},
+	})
+	cmd.AddCommand(&cli.Command{
+		Use:     "undrain",
+		Short:   "Resume allocating new job runs to a drained peer",
+		Example: "optimus admin cluster undrain <node-2>",
+		Args:    cli.ExactArgs(1),
+		RunE: func(c *cli.Command, args []string) error {
+			return applyClusterCommand(l, optimusHost, &pb.ApplyClusterCommandRequest{
+				Type:   pb.ApplyClusterCommandRequest_TYPE_UNDRAIN_PEER,
+				PeerId: args[0],
+			})
+		},
+	})
+	cmd.AddCommand(&cli.Command{
+		Use:     "cancel",
+		Short:   "Cancel a job run and stop its execution",
+		Example: "optimus admin cluster cancel <run-id>",
+		Args:    cli.ExactArgs(1),
+		RunE: func(c *cli.Command, args []string) error {
+			return applyClusterCommand(l, optimusHost, &pb.ApplyClusterCommandRequest{
+				Type:  pb.ApplyClusterCommandRequest_TYPE_CANCEL_RUN,
+				RunId: args[0],
+			})
+		},
+	})
+	cmd.AddCommand(&cli.Command{
+		Use:     "pause",
+		Short:   "Stop allocating new job runs to all peers",
+		Example: "optimus admin cluster pause",
+		RunE: func(c *cli.Command, args []string) error {
+			return applyClusterCommand(l, optimusHost, &pb.ApplyClusterCommandRequest{
+				Type: pb.ApplyClusterCommandRequest_TYPE_PAUSE_ALLOCATION,
+			})
+		},
+	})
+	cmd.AddCommand(&cli.Command{
+		Use:     "resume",
+		Short:   "Resume allocating new job runs to all peers",
+		Example: "optimus admin cluster resume",
+		RunE: func(c *cli.Command, args []string) error {
+			return applyClusterCommand(l, optimusHost, &pb.ApplyClusterCommandRequest{
+				Type: pb.ApplyClusterCommandRequest_TYPE_RESUME_ALLOCATION,
+			})
+		},
+	})
+	return cmd
+}
+
+func getClusterState(l log.Logger, host string) error {
+	dialTimeoutCtx, dialCancel := context.WithTimeout(context.Background(), OptimusDialTimeout)
+	defer dialCancel()
+
+	conn, err := createConnection(dialTimeoutCtx, host)
+	if err != nil {
+		if errors.Is(err, context.DeadlineExceeded) {
+			l.Error(ErrServerNotReachable(host).Error())
+		}
+		return err
+	}
+	defer conn.Close()
+
+	timeoutCtx, cancel := context.WithTimeout(context.Background(), adminClusterTimeout)
+	defer cancel()
+
+	runtime := pb.NewRuntimeServiceClient(conn)
+	stateResponse, err := runtime.GetClusterState(timeoutCtx, &pb.GetClusterStateRequest{})
+	if err != nil {
+		return errors.Wrap(err, "request failed to get cluster state")
+	}
+
+	l.Info(fmt.Sprintf("Leader: %s", stateResponse.Leader))
+	if stateResponse.AllocationPaused {
+		l.Info(coloredNotice("Allocation of new job runs is paused"))
+	}
+	table := tablewriter.NewWriter(l.Writer())
+	table.SetBorder(false)
+	table.SetHeader([]string{
+		"Peer",
+		"Address",
+		"Status",
+		"Drained",
+		"Run ID",
+		"Run Status",
+		"Allocated at",
+	})
+	for _, member := range stateResponse.Members {
+		drained := strconv.FormatBool(member.Drained)
+		if len(member.Allocations) == 0 {
+			table.Append([]string{member.Name, member.Address, member.Status, drained, "", "", ""})
+			continue
+		}
+		for _, allocation := range member.Allocations {
+			table.Append([]string{
+				member.Name,
+				member.Address,
+				member.Status,
+				drained,
+				allocation.RunId,
+				allocation.Status,
+				allocation.AllocatedAt.AsTime().Format(time.RFC3339),
+			})
+		}
+	}
+	table.Render()
+	return nil
+}
+
+func applyClusterCommand(l log.Logger, host string, req *pb.ApplyClusterCommandRequest) error {
+	dialTimeoutCtx, dialCancel := context.WithTimeout(context.Background(), OptimusDialTimeout)
+	defer dialCancel()
+
+	conn, err := createConnection(dialTimeoutCtx, host)
+	if err != nil {
+		if errors.Is(err, context.DeadlineExceeded) {
+			l.Error(ErrServerNotReachable(host).Error())
+		}
+		return err
+	}
+	defer conn.Close()
+
+	timeoutCtx, cancel := context.WithTimeout(context.Background(), adminClusterTimeout)
+	defer cancel()
+
+	runtime := pb.NewRuntimeServiceClient(conn)
+	if _, err := runtime.ApplyClusterCommand(timeoutCtx, req); err != nil {
+		return errors.Wrapf(err, "request failed to apply cluster command %s", req.Type)
+	}
+	l.Info(coloredSuccess("Cluster command applied successfully"))
+	return nil
+}
@@ -0,0 +1,74 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	pb "github.com/odpf/optimus/api/proto/odpf/optimus/core/v1beta1"
+	"github.com/odpf/optimus/config"
+	"github.com/odpf/salt/log"
+	"github.com/pkg/errors"
+	cli "github.com/spf13/cobra"
+)
+
+var (
+	adminSecretsReencryptBatchSize = 100
+	adminSecretsReencryptTimeout   = time.Minute * 10
+)
+
+// adminSecretsCommand manages encryption of secrets stored by optimus service
+func adminSecretsCommand(l log.Logger, conf config.Provider) *cli.Command {
+	cmd := &cli.Command{
+		Use:   "secrets",
+		Short: "Manage encryption of secrets stored by optimus service",
+	}
+	cmd.AddCommand(adminSecretsReencryptCommand(l, conf))
+	return cmd
+}
+
+func adminSecretsReencryptCommand(l log.Logger, conf config.Provider) *cli.Command {
+	var (
+		optimusHost = conf.GetHost()
+		batchSize   = adminSecretsReencryptBatchSize
+		cmd         = &cli.Command{
+			Use:   "reencrypt",
+			Short: "Re-encrypt all secrets with the current app key",
+			Long: `Re-encrypt all secrets which are not encrypted with serve.app_key of optimus service,
+secrets are decrypted by the service using serve.old_app_keys. It needs admin role
+in all projects.`,
+			Example: "optimus admin secrets reencrypt --batch-size 100",
+		}
+	)
+	cmd.Flags().StringVar(&optimusHost, "host", optimusHost, "Optimus service endpoint url")
+	cmd.Flags().IntVar(&batchSize, "batch-size", batchSize, "Number of secrets re-encrypted in a single transaction")
+
+	cmd.RunE = func(c *cli.Command, args []string) error {
+		dialTimeoutCtx, dialCancel := context.WithTimeout(context.Background(), OptimusDialTimeout)
+		defer dialCancel()
+
+		conn, err := createConnection(dialTimeoutCtx, optimusHost)
+		if err != nil {
+			if errors.Is(err, context.DeadlineExceeded) {
+				l.Error(ErrServerNotReachable(optimusHost).Error())
+			}
+			return err
+		}
+		defer conn.Close()
+
+		timeoutCtx, cancel := context.WithTimeout(context.Background(), adminSecretsReencryptTimeout)
+		defer cancel()
+
+		runtime := pb.NewRuntimeServiceClient(conn)
+		reencryptResponse, err := runtime.ReencryptSecrets(timeoutCtx, &pb.ReencryptSecretsRequest{
+			BatchSize: int32(batchSize),
+		})
+		if err != nil {
+			return errors.Wrap(err, "request failed to re-encrypt secrets")
+		}
+		l.Info(coloredSuccess(fmt.Sprintf("Re-encrypted %d secrets with app key %s successfully",
+			reencryptResponse.Reencrypted, reencryptResponse.KeyId)))
+		return nil
+	}
+	return cmd
+}
@@ -2,9 +2,13 @@ package cmd
 
 import (
 	"context"
+	"crypto/tls"
+	"crypto/x509"
 	"encoding/base64"
 	"fmt"
+	"io/ioutil"
 	"os"
+	"strconv"
 	"time"
 
 	"github.com/MakeNowJust/heredoc"
@@ -18,10 +22,12 @@ import (
 	"github.com/odpf/salt/cmdx"
 	"github.com/odpf/salt/log"
 	"github.com/odpf/salt/term"
+	"github.com/pkg/errors"
 	"github.com/spf13/afero"
 	cli "github.com/spf13/cobra"
 	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
 )
 
 var (
@@ -72,7 +78,11 @@ func New(plainLog log.Logger, jsonLog log.Logger, conf config.Provider, pluginRe
 			For passing authentication header, set one of the following environment
 			variables:
 			1. OPTIMUS_AUTH_BASIC_TOKEN
-			2. OPTIMUS_AUTH_BEARER_TOKEN`),
+			2. OPTIMUS_AUTH_BEARER_TOKEN
+
+			For connecting over TLS, set OPTIMUS_TLS_ENABLED to verify the server
+			with system roots or OPTIMUS_TLS_CA_FILE to verify it with a CA, client
+			certificate can be set with OPTIMUS_TLS_CERT_FILE & OPTIMUS_TLS_KEY_FILE`),
 		SilenceUsage: true,
 		Example: heredoc.Doc(`
 				$ optimus job create
@@ -134,6 +144,8 @@ func New(plainLog log.Logger, jsonLog log.Logger, conf config.Provider, pluginRe
 	cmd.AddCommand(backupCommand(plainLog, dsRepo, conf))
 	cmd.AddCommand(adminCommand(plainLog, conf))
 	cmd.AddCommand(secretCommand(plainLog, conf))
+	cmd.AddCommand(projectCommand(plainLog, conf))
+	cmd.AddCommand(namespaceCommand(plainLog, conf))
 
 	addExtensionCommand(cmd, plainLog)
 	return cmd
@@ -144,9 +156,13 @@ func createConnection(ctx context.Context, host string) (*grpc.ClientConn, error
 		grpc_retry.WithBackoff(grpc_retry.BackoffExponential(100 * time.Millisecond)),
 		grpc_retry.WithMax(GRPCMaxRetry),
 	}
+	transportCreds, secure, err := clientTransportCredentials()
+	if err != nil {
+		return nil, err
+	}
 	var opts []grpc.DialOption
 	opts = append(opts,
-		grpc.WithInsecure(),
+		transportCreds,
 		grpc.WithBlock(),
 		grpc.WithDefaultCallOptions(
 			grpc.MaxCallSendMsgSize(GRPCMaxClientSendSize),
@@ -167,18 +183,59 @@ func createConnection(ctx context.Context, host string) (*grpc.ClientConn, error
 	if token := os.Getenv("OPTIMUS_AUTH_BASIC_TOKEN"); token != "" {
 		base64Token := base64.StdEncoding.EncodeToString([]byte(token))
 		opts = append(opts, grpc.WithPerRPCCredentials(&BasicAuthentication{
-			Token: base64Token,
+			Token:  base64Token,
+			Secure: secure,
 		}))
 	} else if token := os.Getenv("OPTIMUS_AUTH_BEARER_TOKEN"); token != "" {
 		opts = append(opts, grpc.WithPerRPCCredentials(&BearerAuthentication{
-			Token: token,
+			Token:  token,
+			Secure: secure,
 		}))
 	}
 	return grpc.DialContext(ctx, host, opts...)
 }
 
+// clientTransportCredentials uses TLS if any of the following environment
+// variables are set, server certificate is verified with system roots
+// unless a CA is provided:
+// 1. OPTIMUS_TLS_ENABLED
+// 2. OPTIMUS_TLS_CA_FILE
+// 3. OPTIMUS_TLS_CERT_FILE & OPTIMUS_TLS_KEY_FILE for client certificate
+func clientTransportCredentials() (grpc.DialOption, bool, error) {
+	enabled, _ := strconv.ParseBool(os.Getenv("OPTIMUS_TLS_ENABLED"))
+	caFile := os.Getenv("OPTIMUS_TLS_CA_FILE")
+	certFile, keyFile := os.Getenv("OPTIMUS_TLS_CERT_FILE"), os.Getenv("OPTIMUS_TLS_KEY_FILE")
+	if !enabled && caFile == "" && certFile == "" && keyFile == "" {
+		return grpc.WithInsecure(), false, nil
+	}
+
+	tlsConfig := &tls.Config{
+		MinVersion: tls.VersionTLS12,
+	}
+	if caFile != "" {
+		caPEM, err := ioutil.ReadFile(caFile)
+		if err != nil {
+			return nil, false, errors.Wrap(err, "failed to read CA file")
+		}
+		tlsConfig.RootCAs = x509.NewCertPool()
+		if !tlsConfig.RootCAs.AppendCertsFromPEM(caPEM) {
+			return nil, false, errors.Errorf("no certificates found in %s", caFile)
+		}
+	}
+	if certFile != "" || keyFile != "" {
+		cert, err := tls.LoadX509KeyPair(certFile, keyFile)
+		if err != nil {
+			return nil, false, errors.Wrap(err, "failed to load client certificate")
+		}
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+	return grpc.WithTransportCredentials(credentials.NewTLS(tlsConfig)), true, nil
+}
+
 type BearerAuthentication struct {
 	Token string
+	// Secure refuses to send token over connections without TLS
+	Secure bool
 }
 
 func (a *BearerAuthentication) GetRequestMetadata(context.Context, ...string) (map[string]string, error) {
@@ -188,11 +245,13 @@ func (a *BearerAuthentication) GetRequestMetadata(context.Context, ...string) (m
 }
 
 func (a *BearerAuthentication) RequireTransportSecurity() bool {
-	return false
+	return a.Secure
 }
 
 type BasicAuthentication struct {
 	Token string
+	// Secure refuses to send token over connections without TLS
+	Secure bool
 }
 
 func (a *BasicAuthentication) GetRequestMetadata(context.Context, ...string) (map[string]string, error) {
@@ -202,7 +261,7 @@ func (a *BasicAuthentication) GetRequestMetadata(context.Context, ...string) (ma
 }
 
 func (a *BasicAuthentication) RequireTransportSecurity() bool {
-	return false
+	return a.Secure
 }
 
 func isTerminal(f *os.File) bool {
@@ -26,5 +26,6 @@ func jobCommand(l log.Logger, jobSpecFs afero.Fs, jobSpecRepo JobSpecRepository,
 		cmd.AddCommand(jobRunCommand(l, jobSpecRepo, pluginRepo, conf))
 	}
 	cmd.AddCommand(jobStatusCommand(l, conf))
+	cmd.AddCommand(jobEventsCommand(l, conf))
 	return cmd
 }
@@ -0,0 +1,162 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/odpf/optimus/config"
+	"github.com/odpf/optimus/utils"
+	"github.com/olekukonko/tablewriter"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	pb "github.com/odpf/optimus/api/proto/odpf/optimus/core/v1beta1"
+	"github.com/odpf/salt/log"
+	"github.com/pkg/errors"
+	cli "github.com/spf13/cobra"
+	"google.golang.org/grpc"
+)
+
+const (
+	jobEventsTimeout = time.Second * 30
+)
+
+var (
+	jobEventsListLimit = 100
+)
+
+func jobEventsCommand(l log.Logger, conf config.Provider) *cli.Command {
+	var (
+		optimusHost = conf.GetHost()
+		projectName = conf.GetProject().Name
+		namespace   = conf.GetNamespace().Name
+		eventTypes  []string
+		since       string
+		until       string
+		limit       = jobEventsListLimit
+	)
+	cmd := &cli.Command{
+		Use:   "events",
+		Short: "List events registered for a job, latest first",
+		Long: `List events like failures, sla misses and retries registered for a job by scheduler.
+Time range accepts RFC3339 timestamps or durations relative to now.`,
+		Example: "optimus job events <sample_job_goes_here> --type failure --since 720h",
+		Args:    cli.ExactArgs(1),
+	}
+	cmd.Flags().StringVarP(&projectName, "project", "p", projectName, "Project name of optimus managed repository")
+	cmd.Flags().StringVarP(&namespace, "namespace", "n", namespace, "Namespace of the job")
+	cmd.Flags().StringVar(&optimusHost, "host", optimusHost, "Optimus service endpoint url")
+	cmd.Flags().StringSliceVar(&eventTypes, "type", nil, "Types of events, e.g. failure,sla_miss,job_start,job_success,retry")
+	cmd.Flags().StringVar(&since, "since", "", "List events registered at or after, e.g. 720h or 2021-10-01T00:00:00Z")
+	cmd.Flags().StringVar(&until, "until", "", "List events registered before, e.g. 24h or 2021-11-01T00:00:00Z")
+	cmd.Flags().IntVar(&limit, "limit", limit, "Maximum number of events listed")
+
+	cmd.RunE = func(c *cli.Command, args []string) error {
+		req := &pb.ListJobEventsRequest{
+			ProjectName:   projectName,
+			NamespaceName: namespace,
+			JobName:       args[0],
+			Limit:         int32(limit),
+		}
+		for _, eventType := range eventTypes {
+			typeProto, ok := pb.JobEvent_Type_value[utils.ToEnumProto(eventType, "type")]
+			if !ok || typeProto == int32(pb.JobEvent_TYPE_UNSPECIFIED) {
+				return errors.Errorf("invalid type of event %s", eventType)
+			}
+			req.Types = append(req.Types, pb.JobEvent_Type(typeProto))
+		}
+
+		now := time.Now().UTC()
+		if sinceTime, err := parseAuditTime(since, now); err != nil {
+			return errors.Wrap(err, "invalid since")
+		} else if !sinceTime.IsZero() {
+			req.Since = timestamppb.New(sinceTime)
+		}
+		if untilTime, err := parseAuditTime(until, now); err != nil {
+			return errors.Wrap(err, "invalid until")
+		} else if !untilTime.IsZero() {
+			req.Until = timestamppb.New(untilTime)
+		}
+
+		l.Info(fmt.Sprintf("Requesting events of job %s in namespace %s of project %s from %s",
+			req.JobName, namespace, projectName, optimusHost))
+		return listJobEventsRequest(l, optimusHost, req)
+	}
+	return cmd
+}
+
+func listJobEventsRequest(l log.Logger, host string, req *pb.ListJobEventsRequest) error {
+	var err error
+	dialTimeoutCtx, dialCancel := context.WithTimeout(context.Background(), OptimusDialTimeout)
+	defer dialCancel()
+
+	var conn *grpc.ClientConn
+	if conn, err = createConnection(dialTimeoutCtx, host); err != nil {
+		if errors.Is(err, context.DeadlineExceeded) {
+			l.Info("can't reach optimus service, timing out")
+		}
+		return err
+	}
+	defer conn.Close()
+
+	timeoutCtx, cancel := context.WithTimeout(context.Background(), jobEventsTimeout)
+	defer cancel()
+
+	runtime := pb.NewRuntimeServiceClient(conn)
+	listEventsResponse, err := runtime.ListJobEvents(timeoutCtx, req)
+	if err != nil {
+		return errors.Wrapf(err, "request failed for job %s", req.JobName)
+	}
+
+	table := tablewriter.NewWriter(l.Writer())
+	table.SetBorder(false)
+	table.SetHeader([]string{
+		"Time",
+		"Type",
+		"Scheduled At",
+		"Details",
+	})
+	countByType := map[string]int{}
+	for _, registered := range listEventsResponse.GetEvents() {
+		eventType := utils.FromEnumProto(registered.GetEvent().GetType().String(), "type")
+		countByType[eventType]++
+
+		var scheduledAt string
+		if registered.GetScheduledAt() != nil {
+			scheduledAt = registered.GetScheduledAt().AsTime().Format(time.RFC3339)
+		}
+		table.Append([]string{
+			registered.GetCreatedAt().AsTime().Format(time.RFC3339),
+			eventType,
+			scheduledAt,
+			jobEventDetails(registered.GetEvent()),
+		})
+	}
+	table.Render()
+
+	var counts []string
+	for eventType, count := range countByType {
+		counts = append(counts, fmt.Sprintf("%s: %d", eventType, count))
+	}
+	sort.Strings(counts)
+	l.Info(coloredSuccess("\nFound %d events. %s", len(listEventsResponse.GetEvents()), strings.Join(counts, ", ")))
+	return nil
+}
+
+// jobEventDetails summarizes values of event which help in identifying it
+func jobEventDetails(event *pb.JobEvent) string {
+	fields := event.GetValue().GetFields()
+	var details []string
+	if taskID := fields["task_id"].GetStringValue(); taskID != "" {
+		details = append(details, "task: "+taskID)
+	}
+	if attempt := fields["attempt"].GetNumberValue(); attempt > 0 {
+		details = append(details, fmt.Sprintf("attempt: %d", int64(attempt)))
+	}
+	if slas := fields["slas"].GetListValue().GetValues(); len(slas) > 0 {
+		details = append(details, fmt.Sprintf("breached items: %d", len(slas)))
+	}
+	return strings.Join(details, ", ")
+}
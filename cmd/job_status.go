@@ -79,6 +79,16 @@ func getJobStatusRequest(l log.Logger, jobName, host, projectName string) error
 
 	for _, status := range jobStatuses {
 		l.Info(fmt.Sprintf("%s - %s", status.GetScheduledAt().AsTime(), status.GetState()))
+		for _, instance := range status.GetInstances() {
+			if instance.GetPreviousReason() != "" {
+				l.Info(fmt.Sprintf("\t%s %s (attempt %d): %s", instance.GetType(), instance.GetName(),
+					instance.GetAttempt()-1, instance.GetPreviousReason()))
+			}
+			if instance.GetReason() != "" {
+				l.Info(fmt.Sprintf("\t%s %s (attempt %d): %s", instance.GetType(), instance.GetName(),
+					instance.GetAttempt(), instance.GetReason()))
+			}
+		}
 	}
 	l.Info(coloredSuccess("\nFound %d run instances.", len(jobStatuses)))
 	return err
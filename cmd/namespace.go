@@ -0,0 +1,272 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/AlecAivazis/survey/v2"
+	"github.com/odpf/salt/log"
+	"github.com/pkg/errors"
+	cli "github.com/spf13/cobra"
+	"google.golang.org/grpc"
+
+	pb "github.com/odpf/optimus/api/proto/odpf/optimus/core/v1beta1"
+	"github.com/odpf/optimus/config"
+)
+
+var (
+	namespaceTimeout = time.Minute * 5
+)
+
+func namespaceCommand(l log.Logger, conf config.Provider) *cli.Command {
+	cmd := &cli.Command{
+		Use:   "namespace",
+		Short: "Manage namespaces of project registered with optimus",
+	}
+	cmd.AddCommand(namespaceDescribeSubCommand(l, conf))
+	cmd.AddCommand(namespaceSetConfigSubCommand(l, conf))
+	cmd.AddCommand(namespaceUnsetConfigSubCommand(l, conf))
+	cmd.AddCommand(namespaceDeleteSubCommand(l, conf))
+	return cmd
+}
+
+func namespaceDescribeSubCommand(l log.Logger, conf config.Provider) *cli.Command {
+	var projectName string
+
+	namespaceDescribeCmd := &cli.Command{
+		Use:     "describe",
+		Short:   "Show configurations of namespace merged with configurations of project",
+		Example: "optimus namespace describe <namespace_name>",
+		Long:    `This operation takes namespace name as its first argument, and shows the config available to jobs of namespace.`,
+	}
+	namespaceDescribeCmd.Flags().StringVarP(&projectName, "project", "p", conf.GetProject().Name, "Project name of optimus managed repository")
+
+	namespaceDescribeCmd.RunE = func(cmd *cli.Command, args []string) error {
+		if len(args) < 1 {
+			return errors.New("namespace name is required")
+		}
+		return describeNamespace(l, conf, &pb.GetProjectNamespaceRequest{
+			ProjectName:   projectName,
+			NamespaceName: args[0],
+		})
+	}
+	return namespaceDescribeCmd
+}
+
+func namespaceSetConfigSubCommand(l log.Logger, conf config.Provider) *cli.Command {
+	var projectName string
+
+	namespaceSetConfigCmd := &cli.Command{
+		Use:     "set-config",
+		Short:   "Set configurations of namespace",
+		Example: "optimus namespace set-config <namespace_name> <key>=<value> [<key>=<value>...]",
+		Long: `
+This operation takes namespace name as its first argument followed by configs to set. 
+Configs which are not provided are kept as they are.
+		`,
+	}
+	namespaceSetConfigCmd.Flags().StringVarP(&projectName, "project", "p", conf.GetProject().Name, "Project name of optimus managed repository")
+
+	namespaceSetConfigCmd.RunE = func(cmd *cli.Command, args []string) error {
+		if len(args) < 2 {
+			return errors.New("namespace name and at least one config are required")
+		}
+		setConfig := map[string]string{}
+		for _, arg := range args[1:] {
+			parts := strings.SplitN(arg, "=", 2)
+			if len(parts) != 2 || parts[0] == "" {
+				return errors.Errorf("invalid config %s, should be in form of <key>=<value>", arg)
+			}
+			setConfig[parts[0]] = parts[1]
+		}
+		return updateNamespaceConfig(l, conf, &pb.UpdateProjectNamespaceConfigRequest{
+			ProjectName:   projectName,
+			NamespaceName: args[0],
+			SetConfig:     setConfig,
+		})
+	}
+	return namespaceSetConfigCmd
+}
+
+func namespaceUnsetConfigSubCommand(l log.Logger, conf config.Provider) *cli.Command {
+	var projectName string
+
+	namespaceUnsetConfigCmd := &cli.Command{
+		Use:     "unset-config",
+		Short:   "Remove configurations of namespace",
+		Example: "optimus namespace unset-config <namespace_name> <key> [<key>...]",
+		Long: `
+This operation takes namespace name as its first argument followed by keys of configs to remove. 
+Config of project with the same key is used by jobs of namespace once removed.
+		`,
+	}
+	namespaceUnsetConfigCmd.Flags().StringVarP(&projectName, "project", "p", conf.GetProject().Name, "Project name of optimus managed repository")
+
+	namespaceUnsetConfigCmd.RunE = func(cmd *cli.Command, args []string) error {
+		if len(args) < 2 {
+			return errors.New("namespace name and at least one config key are required")
+		}
+		return updateNamespaceConfig(l, conf, &pb.UpdateProjectNamespaceConfigRequest{
+			ProjectName:   projectName,
+			NamespaceName: args[0],
+			UnsetConfig:   args[1:],
+		})
+	}
+	return namespaceUnsetConfigCmd
+}
+
+func namespaceDeleteSubCommand(l log.Logger, conf config.Provider) *cli.Command {
+	var (
+		projectName string
+		dryRun      bool
+		skipConfirm bool
+	)
+
+	namespaceDeleteCmd := &cli.Command{
+		Use:     "delete",
+		Short:   "Delete a namespace along with its jobs, resources and secrets",
+		Example: "optimus namespace delete <namespace_name>",
+		Long: `
+This operation takes namespace name as its first argument. It removes jobs of namespace from 
+scheduler, and deletes the resource specs, secrets, replays and backups of the namespace. 
+Namespace can't be deleted if jobs outside of it depend on its jobs. Use dry-run flag to 
+list what would be removed.
+		`,
+	}
+	namespaceDeleteCmd.Flags().StringVarP(&projectName, "project", "p", conf.GetProject().Name, "Project name of optimus managed repository")
+	namespaceDeleteCmd.Flags().BoolVar(&dryRun, "dry-run", false, "Only list what would be deleted")
+	namespaceDeleteCmd.Flags().BoolVar(&skipConfirm, "confirm", false, "Skip asking for confirmation")
+
+	namespaceDeleteCmd.RunE = func(cmd *cli.Command, args []string) error {
+		if len(args) < 1 {
+			return errors.New("namespace name is required")
+		}
+		namespaceName := args[0]
+
+		if !dryRun && !skipConfirm {
+			proceedWithDelete := "No"
+			if err := survey.AskOne(&survey.Select{
+				Message: fmt.Sprintf("All the jobs, resources and secrets of namespace %s will be deleted, proceed?", namespaceName),
+				Options: []string{"Yes", "No"},
+				Default: "No",
+			}, &proceedWithDelete); err != nil {
+				return err
+			}
+			if proceedWithDelete != "Yes" {
+				l.Info(coloredNotice("Aborting..."))
+				return nil
+			}
+		}
+		return deleteNamespace(l, conf, &pb.DeleteProjectNamespaceRequest{
+			ProjectName:   projectName,
+			NamespaceName: namespaceName,
+			DryRun:        dryRun,
+		})
+	}
+	return namespaceDeleteCmd
+}
+
+func describeNamespace(l log.Logger, conf config.Provider, req *pb.GetProjectNamespaceRequest) (err error) {
+	dialTimeoutCtx, dialCancel := context.WithTimeout(context.Background(), OptimusDialTimeout)
+	defer dialCancel()
+
+	var conn *grpc.ClientConn
+	if conn, err = createConnection(dialTimeoutCtx, conf.GetHost()); err != nil {
+		if errors.Is(err, context.DeadlineExceeded) {
+			l.Error(ErrServerNotReachable(conf.GetHost()).Error())
+		}
+		return err
+	}
+	defer conn.Close()
+
+	namespaceRequestTimeout, namespaceRequestCancel := context.WithTimeout(context.Background(), namespaceTimeout)
+	defer namespaceRequestCancel()
+
+	runtime := pb.NewRuntimeServiceClient(conn)
+	getNamespaceResponse, err := runtime.GetProjectNamespace(namespaceRequestTimeout, req)
+	if err != nil {
+		if errors.Is(err, context.DeadlineExceeded) {
+			l.Error(coloredError("Getting namespace took too long, timing out"))
+		}
+		return errors.Wrapf(err, "request failed for getting namespace %s", req.NamespaceName)
+	}
+
+	l.Info(coloredNotice("Namespace %s of project %s", getNamespaceResponse.GetNamespace().GetName(), req.ProjectName))
+	printConfig(l, getNamespaceResponse.GetEffectiveConfig(), namespaceConfigOrEmpty(getNamespaceResponse.GetNamespace()))
+	return nil
+}
+
+func updateNamespaceConfig(l log.Logger, conf config.Provider, req *pb.UpdateProjectNamespaceConfigRequest) (err error) {
+	dialTimeoutCtx, dialCancel := context.WithTimeout(context.Background(), OptimusDialTimeout)
+	defer dialCancel()
+
+	var conn *grpc.ClientConn
+	if conn, err = createConnection(dialTimeoutCtx, conf.GetHost()); err != nil {
+		if errors.Is(err, context.DeadlineExceeded) {
+			l.Error(ErrServerNotReachable(conf.GetHost()).Error())
+		}
+		return err
+	}
+	defer conn.Close()
+
+	namespaceRequestTimeout, namespaceRequestCancel := context.WithTimeout(context.Background(), namespaceTimeout)
+	defer namespaceRequestCancel()
+
+	runtime := pb.NewRuntimeServiceClient(conn)
+	updateConfigResponse, err := runtime.UpdateProjectNamespaceConfig(namespaceRequestTimeout, req)
+	if err != nil {
+		if errors.Is(err, context.DeadlineExceeded) {
+			l.Error(coloredError("Namespace config update took too long, timing out"))
+		}
+		return errors.Wrapf(err, "request failed for updating config of namespace %s", req.NamespaceName)
+	}
+
+	printConfig(l, updateConfigResponse.GetEffectiveConfig(), namespaceConfigOrEmpty(updateConfigResponse.GetNamespace()))
+	l.Info(coloredSuccess(updateConfigResponse.GetMessage()))
+	return nil
+}
+
+// namespaceConfigOrEmpty never returns nil so that source of configs is
+// always shown for a namespace
+func namespaceConfigOrEmpty(namespace *pb.NamespaceSpecification) map[string]string {
+	if namespace.GetConfig() == nil {
+		return map[string]string{}
+	}
+	return namespace.GetConfig()
+}
+
+func deleteNamespace(l log.Logger, conf config.Provider, req *pb.DeleteProjectNamespaceRequest) (err error) {
+	dialTimeoutCtx, dialCancel := context.WithTimeout(context.Background(), OptimusDialTimeout)
+	defer dialCancel()
+
+	var conn *grpc.ClientConn
+	if conn, err = createConnection(dialTimeoutCtx, conf.GetHost()); err != nil {
+		if errors.Is(err, context.DeadlineExceeded) {
+			l.Error(ErrServerNotReachable(conf.GetHost()).Error())
+		}
+		return err
+	}
+	defer conn.Close()
+
+	namespaceRequestTimeout, namespaceRequestCancel := context.WithTimeout(context.Background(), namespaceTimeout)
+	defer namespaceRequestCancel()
+
+	spinner := NewProgressBar()
+	spinner.Start("please wait...")
+	runtime := pb.NewRuntimeServiceClient(conn)
+
+	deleteNamespaceResponse, err := runtime.DeleteProjectNamespace(namespaceRequestTimeout, req)
+	spinner.Stop()
+	if err != nil {
+		if errors.Is(err, context.DeadlineExceeded) {
+			l.Error(coloredError("Namespace deletion took too long, timing out"))
+		}
+		return errors.Wrapf(err, "request failed for deleting namespace %s", req.NamespaceName)
+	}
+
+	printDeletionPlan(l, deleteNamespaceResponse.GetPlan())
+	l.Info(coloredSuccess(deleteNamespaceResponse.GetMessage()))
+	return nil
+}
@@ -0,0 +1,225 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/AlecAivazis/survey/v2"
+	"github.com/odpf/salt/log"
+	"github.com/olekukonko/tablewriter"
+	"github.com/pkg/errors"
+	cli "github.com/spf13/cobra"
+	"google.golang.org/grpc"
+
+	pb "github.com/odpf/optimus/api/proto/odpf/optimus/core/v1beta1"
+	"github.com/odpf/optimus/config"
+)
+
+var (
+	projectTimeout = time.Minute * 5
+)
+
+func projectCommand(l log.Logger, conf config.Provider) *cli.Command {
+	cmd := &cli.Command{
+		Use:   "project",
+		Short: "Manage project registered with optimus",
+	}
+	cmd.AddCommand(projectDescribeSubCommand(l, conf))
+	cmd.AddCommand(projectDeleteSubCommand(l, conf))
+	return cmd
+}
+
+func projectDescribeSubCommand(l log.Logger, conf config.Provider) *cli.Command {
+	var projectName string
+
+	projectDescribeCmd := &cli.Command{
+		Use:     "describe",
+		Short:   "Show configurations of project and its namespaces",
+		Example: "optimus project describe --project <project_name>",
+	}
+	projectDescribeCmd.Flags().StringVarP(&projectName, "project", "p", conf.GetProject().Name, "Project name of optimus managed repository")
+
+	projectDescribeCmd.RunE = func(cmd *cli.Command, args []string) error {
+		if projectName == "" {
+			return errors.New("project name is required")
+		}
+		return describeProject(l, conf, &pb.GetProjectRequest{
+			ProjectName: projectName,
+		})
+	}
+	return projectDescribeCmd
+}
+
+func projectDeleteSubCommand(l log.Logger, conf config.Provider) *cli.Command {
+	var (
+		projectName string
+		dryRun      bool
+		skipConfirm bool
+	)
+
+	projectDeleteCmd := &cli.Command{
+		Use:     "delete",
+		Short:   "Delete a project along with its namespaces, jobs, resources and secrets",
+		Example: "optimus project delete --project <project_name>",
+		Long: `
+This operation removes jobs of all the namespaces of project from scheduler, and deletes 
+the resource specs, secrets, replays and backups of the project. Project can't be deleted 
+if jobs of other projects depend on its jobs. Use dry-run flag to list what would be removed.
+		`,
+	}
+	projectDeleteCmd.Flags().StringVarP(&projectName, "project", "p", conf.GetProject().Name, "Project name of optimus managed repository")
+	projectDeleteCmd.Flags().BoolVar(&dryRun, "dry-run", false, "Only list what would be deleted")
+	projectDeleteCmd.Flags().BoolVar(&skipConfirm, "confirm", false, "Skip asking for confirmation")
+
+	projectDeleteCmd.RunE = func(cmd *cli.Command, args []string) error {
+		if projectName == "" {
+			return errors.New("project name is required")
+		}
+		if !dryRun && !skipConfirm {
+			proceedWithDelete := "No"
+			if err := survey.AskOne(&survey.Select{
+				Message: fmt.Sprintf("All the jobs, resources and secrets of project %s will be deleted, proceed?", projectName),
+				Options: []string{"Yes", "No"},
+				Default: "No",
+			}, &proceedWithDelete); err != nil {
+				return err
+			}
+			if proceedWithDelete != "Yes" {
+				l.Info(coloredNotice("Aborting..."))
+				return nil
+			}
+		}
+		return deleteProject(l, conf, &pb.DeleteProjectRequest{
+			ProjectName: projectName,
+			DryRun:      dryRun,
+		})
+	}
+	return projectDeleteCmd
+}
+
+func describeProject(l log.Logger, conf config.Provider, req *pb.GetProjectRequest) (err error) {
+	dialTimeoutCtx, dialCancel := context.WithTimeout(context.Background(), OptimusDialTimeout)
+	defer dialCancel()
+
+	var conn *grpc.ClientConn
+	if conn, err = createConnection(dialTimeoutCtx, conf.GetHost()); err != nil {
+		if errors.Is(err, context.DeadlineExceeded) {
+			l.Error(ErrServerNotReachable(conf.GetHost()).Error())
+		}
+		return err
+	}
+	defer conn.Close()
+
+	projectRequestTimeout, projectRequestCancel := context.WithTimeout(context.Background(), projectTimeout)
+	defer projectRequestCancel()
+
+	runtime := pb.NewRuntimeServiceClient(conn)
+	getProjectResponse, err := runtime.GetProject(projectRequestTimeout, req)
+	if err != nil {
+		if errors.Is(err, context.DeadlineExceeded) {
+			l.Error(coloredError("Getting project took too long, timing out"))
+		}
+		return errors.Wrapf(err, "request failed for getting project %s", req.ProjectName)
+	}
+
+	l.Info(coloredNotice("Project %s", getProjectResponse.GetProject().GetName()))
+	printConfig(l, getProjectResponse.GetProject().GetConfig(), nil)
+	for _, namespace := range getProjectResponse.GetNamespaces() {
+		l.Info(coloredNotice("\nNamespace %s", namespace.GetName()))
+		printConfig(l, namespace.GetConfig(), nil)
+	}
+	return nil
+}
+
+// printConfig renders config sorted by keys, along with where the value
+// comes from when namespaceConfig is provided
+func printConfig(l log.Logger, config map[string]string, namespaceConfig map[string]string) {
+	keys := make([]string, 0, len(config))
+	for key := range config {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	header := []string{"Key", "Value"}
+	if namespaceConfig != nil {
+		header = append(header, "Source")
+	}
+	table := tablewriter.NewWriter(l.Writer())
+	table.SetBorder(false)
+	table.SetAutoWrapText(false)
+	table.SetHeader(header)
+	for _, key := range keys {
+		row := []string{key, config[key]}
+		if namespaceConfig != nil {
+			source := "project"
+			if _, ok := namespaceConfig[key]; ok {
+				source = "namespace"
+			}
+			row = append(row, source)
+		}
+		table.Append(row)
+	}
+	table.Render()
+}
+
+func deleteProject(l log.Logger, conf config.Provider, req *pb.DeleteProjectRequest) (err error) {
+	dialTimeoutCtx, dialCancel := context.WithTimeout(context.Background(), OptimusDialTimeout)
+	defer dialCancel()
+
+	var conn *grpc.ClientConn
+	if conn, err = createConnection(dialTimeoutCtx, conf.GetHost()); err != nil {
+		if errors.Is(err, context.DeadlineExceeded) {
+			l.Error(ErrServerNotReachable(conf.GetHost()).Error())
+		}
+		return err
+	}
+	defer conn.Close()
+
+	projectRequestTimeout, projectRequestCancel := context.WithTimeout(context.Background(), projectTimeout)
+	defer projectRequestCancel()
+
+	spinner := NewProgressBar()
+	spinner.Start("please wait...")
+	runtime := pb.NewRuntimeServiceClient(conn)
+
+	deleteProjectResponse, err := runtime.DeleteProject(projectRequestTimeout, req)
+	spinner.Stop()
+	if err != nil {
+		if errors.Is(err, context.DeadlineExceeded) {
+			l.Error(coloredError("Project deletion took too long, timing out"))
+		}
+		return errors.Wrapf(err, "request failed for deleting project %s", req.ProjectName)
+	}
+
+	printDeletionPlan(l, deleteProjectResponse.GetPlan())
+	l.Info(coloredSuccess(deleteProjectResponse.GetMessage()))
+	return nil
+}
+
+// printDeletionPlan lists everything removed, or to be removed, along with
+// a project or namespace
+func printDeletionPlan(l log.Logger, plan *pb.DeletionPlan) {
+	table := tablewriter.NewWriter(l.Writer())
+	table.SetBorder(false)
+	table.SetAutoWrapText(false)
+	table.SetHeader([]string{
+		"Namespace",
+		"Jobs",
+		"Resources",
+	})
+	for _, namespace := range plan.GetNamespaces() {
+		table.Append([]string{
+			namespace.GetName(),
+			strings.Join(namespace.GetJobs(), "\n"),
+			strings.Join(namespace.GetResources(), "\n"),
+		})
+	}
+	table.Render()
+
+	l.Info(fmt.Sprintf("Secrets: %s", strings.Join(plan.GetSecrets(), ", ")))
+	l.Info(fmt.Sprintf("Replays: %d", plan.GetReplays()))
+	l.Info(fmt.Sprintf("Backups: %d", plan.GetBackups()))
+}
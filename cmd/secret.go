@@ -10,6 +10,7 @@ import (
 
 	"github.com/AlecAivazis/survey/v2"
 	"github.com/odpf/salt/log"
+	"github.com/olekukonko/tablewriter"
 	"github.com/pkg/errors"
 	cli "github.com/spf13/cobra"
 	"google.golang.org/grpc"
@@ -29,6 +30,8 @@ func secretCommand(l log.Logger, conf config.Provider) *cli.Command {
 		Short: "Manage secrets to be used in jobs",
 	}
 	cmd.AddCommand(secretSetSubCommand(l, conf))
+	cmd.AddCommand(secretListSubCommand(l, conf))
+	cmd.AddCommand(secretDeleteSubCommand(l, conf))
 	return cmd
 }
 
@@ -117,6 +120,68 @@ Use base64 flag if the value has been encoded.
 	return secretCmd
 }
 
+func secretListSubCommand(l log.Logger, conf config.Provider) *cli.Command {
+	var projectName string
+
+	secretListCmd := &cli.Command{
+		Use:     "list",
+		Short:   "Show all the secrets registered with optimus",
+		Example: "optimus secret list",
+		Long:    `This operation shows the secrets for project, values of secrets are never shown but their digest.`,
+	}
+	secretListCmd.Flags().StringVarP(&projectName, "project", "p", conf.GetProject().Name, "Project name of optimus managed repository")
+
+	secretListCmd.RunE = func(cmd *cli.Command, args []string) error {
+		return listSecrets(l, conf, &pb.ListSecretsRequest{
+			ProjectName: projectName,
+		})
+	}
+	return secretListCmd
+}
+
+func secretDeleteSubCommand(l log.Logger, conf config.Provider) *cli.Command {
+	var (
+		projectName string
+		skipConfirm bool
+	)
+
+	secretDeleteCmd := &cli.Command{
+		Use:     "delete",
+		Short:   "Delete a secret registered with optimus",
+		Example: "optimus secret delete <secret_name>",
+		Long:    `This operation takes secret name as its first argument, secrets managed by system can't be deleted.`,
+	}
+	secretDeleteCmd.Flags().StringVarP(&projectName, "project", "p", conf.GetProject().Name, "Project name of optimus managed repository")
+	secretDeleteCmd.Flags().BoolVar(&skipConfirm, "confirm", false, "Skip asking for confirmation")
+
+	secretDeleteCmd.RunE = func(cmd *cli.Command, args []string) error {
+		secretName, err := getSecretName(args)
+		if err != nil {
+			return err
+		}
+
+		if !skipConfirm {
+			proceedWithDelete := "No"
+			if err := survey.AskOne(&survey.Select{
+				Message: fmt.Sprintf("Jobs using secret %s will fail, proceed with delete?", secretName),
+				Options: []string{"Yes", "No"},
+				Default: "No",
+			}, &proceedWithDelete); err != nil {
+				return err
+			}
+			if proceedWithDelete != "Yes" {
+				l.Info(coloredNotice("Aborting..."))
+				return nil
+			}
+		}
+		return deleteSecret(l, conf, &pb.DeleteSecretRequest{
+			ProjectName: projectName,
+			SecretName:  secretName,
+		})
+	}
+	return secretDeleteCmd
+}
+
 func getSecretName(args []string) (string, error) {
 	if len(args) < 1 {
 		return "", errors.New("secret name is required")
@@ -226,3 +291,100 @@ func updateSecret(l log.Logger, conf config.Provider, req *pb.UpdateSecretReques
 
 	return nil
 }
+
+func listSecrets(l log.Logger, conf config.Provider, req *pb.ListSecretsRequest) (err error) {
+	dialTimeoutCtx, dialCancel := context.WithTimeout(context.Background(), OptimusDialTimeout)
+	defer dialCancel()
+
+	var conn *grpc.ClientConn
+	if conn, err = createConnection(dialTimeoutCtx, conf.GetHost()); err != nil {
+		if errors.Is(err, context.DeadlineExceeded) {
+			l.Error(ErrServerNotReachable(conf.GetHost()).Error())
+		}
+		return err
+	}
+	defer conn.Close()
+
+	secretRequestTimeout, secretRequestCancel := context.WithTimeout(context.Background(), secretTimeout)
+	defer secretRequestCancel()
+
+	spinner := NewProgressBar()
+	spinner.Start("please wait...")
+	runtime := pb.NewRuntimeServiceClient(conn)
+
+	listSecretsResponse, err := runtime.ListSecrets(secretRequestTimeout, req)
+	spinner.Stop()
+	if err != nil {
+		if errors.Is(err, context.DeadlineExceeded) {
+			l.Error(coloredError("Secret listing took too long, timing out"))
+		}
+		return errors.Wrapf(err, "request failed for listing secrets of project %s", req.ProjectName)
+	}
+
+	if len(listSecretsResponse.Secrets) == 0 {
+		l.Info(coloredNotice("No secrets were found in %s project.", req.ProjectName))
+		return nil
+	}
+	table := tablewriter.NewWriter(l.Writer())
+	table.SetBorder(false)
+	table.SetHeader([]string{
+		"Name",
+		"Digest",
+		"Namespace",
+		"Type",
+		"Updated at",
+	})
+	for _, secret := range listSecretsResponse.Secrets {
+		namespace := secret.Namespace
+		if namespace == "" {
+			namespace = "*"
+		}
+		secretType := models.SecretTypeUserDefined
+		if secret.Type == pb.ListSecretsResponse_SECRET_TYPE_SYSTEM_DEFINED {
+			secretType = models.SecretTypeSystemDefined
+		}
+		table.Append([]string{
+			secret.Name,
+			secret.Digest,
+			namespace,
+			secretType.String(),
+			secret.UpdatedAt.AsTime().Format(time.RFC3339),
+		})
+	}
+	table.Render()
+	return nil
+}
+
+func deleteSecret(l log.Logger, conf config.Provider, req *pb.DeleteSecretRequest) (err error) {
+	dialTimeoutCtx, dialCancel := context.WithTimeout(context.Background(), OptimusDialTimeout)
+	defer dialCancel()
+
+	var conn *grpc.ClientConn
+	if conn, err = createConnection(dialTimeoutCtx, conf.GetHost()); err != nil {
+		if errors.Is(err, context.DeadlineExceeded) {
+			l.Error(ErrServerNotReachable(conf.GetHost()).Error())
+		}
+		return err
+	}
+	defer conn.Close()
+
+	secretRequestTimeout, secretRequestCancel := context.WithTimeout(context.Background(), secretTimeout)
+	defer secretRequestCancel()
+
+	spinner := NewProgressBar()
+	spinner.Start("please wait...")
+	runtime := pb.NewRuntimeServiceClient(conn)
+
+	_, err = runtime.DeleteSecret(secretRequestTimeout, req)
+	spinner.Stop()
+	if err != nil {
+		if errors.Is(err, context.DeadlineExceeded) {
+			l.Error(coloredError("Secret deletion took too long, timing out"))
+		}
+		return errors.Wrapf(err, "request failed for deleting secret %s", req.SecretName)
+	}
+
+	l.Info(coloredSuccess("Secret deleted"))
+
+	return nil
+}
@@ -2,11 +2,13 @@ package server
 
 import (
 	"context"
+	"crypto/tls"
 	"fmt"
 	"net/http"
 	"net/url"
 	"os"
 	"os/signal"
+	"path/filepath"
 	"strings"
 	"syscall"
 	"time"
@@ -19,6 +21,8 @@ import (
 	grpc_prometheus "github.com/grpc-ecosystem/go-grpc-prometheus"
 	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
 	"github.com/hashicorp/go-multierror"
+	"github.com/odpf/optimus/api/audit"
+	"github.com/odpf/optimus/api/auth"
 	v1 "github.com/odpf/optimus/api/handler/v1beta1"
 	v1handler "github.com/odpf/optimus/api/handler/v1beta1"
 	pb "github.com/odpf/optimus/api/proto/odpf/optimus/core/v1beta1"
@@ -28,7 +32,11 @@ import (
 	"github.com/odpf/optimus/datastore"
 	_ "github.com/odpf/optimus/ext/datastore"
 	"github.com/odpf/optimus/ext/executor/noop"
+	"github.com/odpf/optimus/ext/executor/process"
+	"github.com/odpf/optimus/ext/notify/email"
+	"github.com/odpf/optimus/ext/notify/pagerduty"
 	"github.com/odpf/optimus/ext/notify/slack"
+	"github.com/odpf/optimus/ext/notify/webhook"
 	"github.com/odpf/optimus/ext/scheduler/airflow"
 	"github.com/odpf/optimus/ext/scheduler/airflow2"
 	"github.com/odpf/optimus/ext/scheduler/airflow2/compiler"
@@ -39,6 +47,7 @@ import (
 	"github.com/odpf/optimus/run"
 	"github.com/odpf/optimus/store"
 	"github.com/odpf/optimus/store/postgres"
+	"github.com/odpf/optimus/store/vault"
 	"github.com/odpf/optimus/utils"
 	"github.com/odpf/salt/log"
 	"github.com/pkg/errors"
@@ -56,6 +65,7 @@ import (
 	"golang.org/x/net/http2/h2c"
 	"golang.org/x/oauth2/google"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/reflection"
 	"gorm.io/gorm"
 )
@@ -66,6 +76,8 @@ var (
 	shutdownWait       = 30 * time.Second
 	GRPCMaxRecvMsgSize = 64 << 20 // 64MB
 	GRPCMaxSendMsgSize = 64 << 20 // 64MB
+	// secretBackendTimeout is the timeout of each request to external secret backend
+	secretBackendTimeout = 10 * time.Second
 )
 
 // projectJobSpecRepoFactory stores raw specifications
@@ -112,12 +124,13 @@ func (fac *jobSpecRepoFactory) New(namespace models.NamespaceSpec) job.SpecRepos
 }
 
 type projectRepoFactory struct {
-	db   *gorm.DB
-	hash models.ApplicationKey
+	db         *gorm.DB
+	hash       models.ApplicationKey
+	httpClient vault.HTTPClient
 }
 
 func (fac *projectRepoFactory) New() store.ProjectRepository {
-	return postgres.NewProjectRepository(fac.db, fac.hash)
+	return vault.NewProjectRepository(postgres.NewProjectRepository(fac.db, fac.hash), fac.httpClient, fac.hash)
 }
 
 type namespaceRepoFactory struct {
@@ -126,16 +139,21 @@ type namespaceRepoFactory struct {
 }
 
 func (fac *namespaceRepoFactory) New(projectSpec models.ProjectSpec) store.NamespaceRepository {
-	return postgres.NewNamespaceRepository(fac.db, projectSpec, fac.hash)
+	return vault.NewNamespaceRepository(postgres.NewNamespaceRepository(fac.db, projectSpec, fac.hash), projectSpec)
 }
 
 type projectSecretRepoFactory struct {
-	db   *gorm.DB
-	hash models.ApplicationKey
+	db         *gorm.DB
+	hash       models.ApplicationKey
+	httpClient vault.HTTPClient
 }
 
 func (fac *projectSecretRepoFactory) New(projectSpec models.ProjectSpec, namespaceSpec models.NamespaceSpec) store.ProjectSecretRepository {
-	return postgres.NewSecretRepository(fac.db, projectSpec, namespaceSpec, fac.hash)
+	secretRepo := postgres.NewSecretRepository(fac.db, projectSpec, namespaceSpec, fac.hash)
+	if projectSpec.Config[models.ProjectSecretBackend] == models.SecretBackendVault {
+		return vault.NewSecretRouter(secretRepo, vault.NewSecretRepository(fac.httpClient, projectSpec, namespaceSpec, fac.hash))
+	}
+	return secretRepo
 }
 
 type jobRunRepoFactory struct {
@@ -146,6 +164,14 @@ func (fac *jobRunRepoFactory) New() store.JobRunRepository {
 	return postgres.NewJobRunRepository(fac.db, postgres.NewAdapter(models.PluginRegistry))
 }
 
+type jobDeploymentRepoFactory struct {
+	db *gorm.DB
+}
+
+func (fac *jobDeploymentRepoFactory) New() store.JobDeploymentRepository {
+	return postgres.NewJobDeploymentRepository(fac.db, postgres.NewAdapter(models.PluginRegistry))
+}
+
 type instanceRepoFactory struct {
 	db *gorm.DB
 }
@@ -182,6 +208,21 @@ func (fac *backupRepoFactory) New(projectSpec models.ProjectSpec, storer models.
 	return postgres.NewBackupRepository(fac.db, projectSpec, storer)
 }
 
+// adminService serves audit log and encryption of secrets from database
+type adminService struct {
+	db   *gorm.DB
+	hash models.ApplicationKey
+}
+
+func (svc *adminService) ListAuditEntries(ctx context.Context, filter models.AuditFilter) ([]models.AuditEntry, error) {
+	return postgres.NewAuditRepository(svc.db).List(ctx, filter)
+}
+
+func (svc *adminService) ReencryptSecrets(ctx context.Context, batchSize int) (int, string, error) {
+	reencrypted, err := postgres.ReencryptSecrets(ctx, svc.db, svc.hash, batchSize)
+	return reencrypted, svc.hash.GetKeyID(), err
+}
+
 type airflowBucketFactory struct{}
 
 func (o *airflowBucketFactory) New(ctx context.Context, projectSpec models.ProjectSpec) (airflow2.Bucket, error) {
@@ -291,6 +332,13 @@ func Initialize(l log.Logger, conf config.Provider) error {
 		return errors.Wrap(err, "postgres.Connect")
 	}
 
+	jobrunRepoFac := &jobRunRepoFactory{
+		db: dbConn,
+	}
+	jobDeploymentRepoFac := &jobDeploymentRepoFactory{
+		db: dbConn,
+	}
+
 	jobCompiler := compiler.NewCompiler(conf.GetServe().IngressHost)
 	// init default scheduler
 	switch conf.GetScheduler().Name {
@@ -306,12 +354,17 @@ func Initialize(l log.Logger, conf config.Provider) error {
 			&http.Client{},
 			jobCompiler,
 		)
+	case prime.Name:
+		models.BatchScheduler = prime.NewBatchScheduler(
+			jobrunRepoFac,
+			jobDeploymentRepoFac,
+			func() time.Time {
+				return time.Now().UTC()
+			},
+		)
 	default:
 		return errors.Errorf("unsupported scheduler: %s", conf.GetScheduler().Name)
 	}
-	jobrunRepoFac := &jobRunRepoFactory{
-		db: dbConn,
-	}
 	models.ManualScheduler = prime.NewScheduler(
 		jobrunRepoFac,
 		func() time.Time {
@@ -320,16 +373,21 @@ func Initialize(l log.Logger, conf config.Provider) error {
 	)
 
 	// used to encrypt secrets
-	appHash, err := models.NewApplicationSecret(conf.GetServe().AppKey)
+	appHash, err := models.NewApplicationKeyring(conf.GetServe().AppKeyID, conf.GetServe().AppKey,
+		conf.GetServe().OldAppKeys)
 	if err != nil {
-		return errors.Wrap(err, "NewApplicationSecret")
+		return errors.Wrap(err, "NewApplicationKeyring")
 	}
 
 	// registered project store repository factory, it's a wrapper over a storage
 	// interface
+	// used to reach external secret backends of projects
+	secretBackendClient := &http.Client{Timeout: secretBackendTimeout}
+
 	projectRepoFac := &projectRepoFactory{
-		db:   dbConn,
-		hash: appHash,
+		db:         dbConn,
+		hash:       appHash,
+		httpClient: secretBackendClient,
 	}
 	if !conf.GetScheduler().SkipInit {
 		registeredProjects, err := projectRepoFac.New().GetAll(context.Background())
@@ -340,7 +398,14 @@ func Initialize(l log.Logger, conf config.Provider) error {
 		for _, proj := range registeredProjects {
 			bootstrapCtx, cancel := context.WithTimeout(context.Background(), time.Second*10)
 			l.Info("bootstrapping project", "project name", proj.Name)
-			if err := models.BatchScheduler.Bootstrap(bootstrapCtx, proj); err != nil {
+			// listed projects don't carry secrets of external secret backends
+			resolvedProj, err := projectRepoFac.New().GetByName(bootstrapCtx, proj.Name)
+			if err != nil {
+				l.Error("no bootstrapping project", "error", err)
+				cancel()
+				continue
+			}
+			if err := models.BatchScheduler.Bootstrap(bootstrapCtx, resolvedProj); err != nil {
 				// Major ERROR, but we can't make this fatal
 				// other projects might be working fine
 				l.Error("no bootstrapping project", "error", err)
@@ -351,8 +416,9 @@ func Initialize(l log.Logger, conf config.Provider) error {
 	}
 
 	projectSecretRepoFac := &projectSecretRepoFactory{
-		db:   dbConn,
-		hash: appHash,
+		db:         dbConn,
+		hash:       appHash,
+		httpClient: secretBackendClient,
 	}
 	namespaceSpecRepoFac := &namespaceRepoFactory{
 		db:   dbConn,
@@ -386,17 +452,50 @@ func Initialize(l log.Logger, conf config.Provider) error {
 	grpc_logrus.ReplaceGrpcLogger(grpcLogrusEntry)
 
 	grpcAddr := fmt.Sprintf("%s:%d", conf.GetServe().Host, conf.GetServe().Port)
+	var tlsConfig *tls.Config
+	if conf.GetServe().TLS.CertFile != "" || conf.GetServe().TLS.KeyFile != "" {
+		if tlsConfig, err = newServerTLSConfig(conf.GetServe().TLS); err != nil {
+			return errors.Wrap(err, "newServerTLSConfig")
+		}
+	}
+	unaryInterceptors := []grpc.UnaryServerInterceptor{
+		grpctags.UnaryServerInterceptor(grpctags.WithFieldExtractor(grpctags.CodeGenRequestFieldExtractor)),
+		grpc_logrus.UnaryServerInterceptor(grpcLogrusEntry, opts...),
+		otelgrpc.UnaryServerInterceptor(),
+		grpc_prometheus.UnaryServerInterceptor,
+	}
+	streamInterceptors := []grpc.StreamServerInterceptor{
+		otelgrpc.StreamServerInterceptor(),
+		grpc_prometheus.StreamServerInterceptor,
+	}
+	// callers are anonymous unless authentication is enabled
+	identify := func(ctx context.Context) string { return "" }
+	var authenticator *auth.Authenticator
+	if conf.GetServe().Auth.Enabled {
+		if authenticator, err = auth.NewAuthenticator(conf.GetServe().Auth); err != nil {
+			return errors.Wrap(err, "auth.NewAuthenticator")
+		}
+		identify = func(ctx context.Context) string {
+			identity, err := authenticator.Authenticate(ctx)
+			if err != nil {
+				return ""
+			}
+			return identity.Subject
+		}
+	}
+	// auditor runs before authorization to record denied calls as well
+	auditor := audit.NewAuditor(postgres.NewAuditRepository(dbConn), identify, audit.RuntimeServiceMethods, l)
+	unaryInterceptors = append(unaryInterceptors, auditor.UnaryServerInterceptor())
+	streamInterceptors = append(streamInterceptors, auditor.StreamServerInterceptor())
+	if authenticator != nil {
+		// requests proxied by grpc-gateway carry the authorization header as
+		// metadata and get authenticated here as well
+		unaryInterceptors = append(unaryInterceptors, auth.UnaryServerInterceptor(authenticator, auth.RuntimeServicePolicy))
+		streamInterceptors = append(streamInterceptors, auth.StreamServerInterceptor(authenticator, auth.RuntimeServicePolicy))
+	}
 	grpcOpts := []grpc.ServerOption{
-		grpc_middleware.WithUnaryServerChain(
-			grpctags.UnaryServerInterceptor(grpctags.WithFieldExtractor(grpctags.CodeGenRequestFieldExtractor)),
-			grpc_logrus.UnaryServerInterceptor(grpcLogrusEntry, opts...),
-			otelgrpc.UnaryServerInterceptor(),
-			grpc_prometheus.UnaryServerInterceptor,
-		),
-		grpc_middleware.WithStreamServerChain(
-			otelgrpc.StreamServerInterceptor(),
-			grpc_prometheus.StreamServerInterceptor,
-		),
+		grpc_middleware.WithUnaryServerChain(unaryInterceptors...),
+		grpc_middleware.WithStreamServerChain(streamInterceptors...),
 		grpc.MaxRecvMsgSize(GRPCMaxRecvMsgSize),
 		grpc.MaxSendMsgSize(GRPCMaxSendMsgSize),
 	}
@@ -441,6 +540,7 @@ func Initialize(l log.Logger, conf config.Provider) error {
 
 	notificationContext, cancelNotifiers := context.WithCancel(context.Background())
 	defer cancelNotifiers()
+	jobEventRepo := postgres.NewJobEventRepository(dbConn)
 	eventService := job.NewEventService(l, map[string]models.Notifier{
 		"slack": slack.NewNotifier(notificationContext, slackapi.APIURL,
 			slack.DefaultEventBatchInterval,
@@ -448,7 +548,80 @@ func Initialize(l log.Logger, conf config.Provider) error {
 				l.Error("slack error accumulator", "error", err)
 			},
 		),
-	})
+		"webhook": webhook.NewNotifier(notificationContext, &http.Client{Timeout: webhook.DefaultRequestTimeout},
+			webhook.DefaultEventBatchInterval,
+			webhook.DefaultRetryBackoff,
+			func(err error) {
+				l.Error("webhook error accumulator", "error", err)
+			},
+		),
+		"pagerduty": pagerduty.NewNotifier(notificationContext, &http.Client{Timeout: pagerduty.DefaultRequestTimeout},
+			pagerduty.DefaultEventsURL,
+			jobEventRepo,
+			pagerduty.DefaultEventBatchInterval,
+			pagerduty.DefaultRetryBackoff,
+			func(err error) {
+				l.Error("pagerduty error accumulator", "error", err)
+			},
+		),
+		"email": email.NewNotifier(notificationContext,
+			email.DefaultEventBatchInterval,
+			email.DefaultSendTimeout,
+			func(err error) {
+				l.Error("email error accumulator", "error", err)
+			},
+		),
+	}, jobEventRepo)
+	var eventPurger *job.EventPurger
+	if retention := conf.GetServe().JobEventRetention; retention > 0 {
+		if eventPurger, err = job.NewEventPurger(l, jobEventRepo, retention); err != nil {
+			return errors.Wrap(err, "job.NewEventPurger")
+		}
+	}
+
+	runService := run.NewService(
+		jobrunRepoFac,
+		func() time.Time {
+			return time.Now().UTC()
+		},
+		run.NewGoEngine(),
+	)
+
+	var executor models.ExecutorUnit
+	switch conf.GetScheduler().Executor {
+	case "", "noop":
+		executor = noop.NewExecutor()
+	case "process":
+		executorDir := conf.GetScheduler().ExecutorDir
+		if executorDir == "" {
+			executorDir = filepath.Join(os.TempDir(), "optimus")
+		}
+		var fallback process.CommandFn
+		if conf.GetScheduler().ExecutorDocker {
+			fallback = process.DockerCommand
+		}
+		executor = process.NewExecutor(runService,
+			process.EntrypointCommand(conf.GetScheduler().ExecutorEntrypoints, fallback), executorDir)
+	default:
+		return errors.Errorf("unsupported executor: %s", conf.GetScheduler().Executor)
+	}
+
+	clusterServer := gossip.NewServer(l)
+	clusterPlanner := prime.NewPlanner(
+		l,
+		clusterServer, jobrunRepoFac, &instanceRepoFactory{
+			db: dbConn,
+		}, jobDeploymentRepoFac, projectRepoFac,
+		runService, executor, conf.GetScheduler(), func() time.Time {
+			return time.Now().UTC()
+		},
+	)
+
+	// cluster commands are only served when server is a peer of the cluster
+	var clusterSvc models.ClusterService
+	if conf.GetScheduler().NodeID != "" {
+		clusterSvc = clusterPlanner
+	}
 
 	// runtime service instance over grpc
 	pb.RegisterRuntimeServiceServer(grpcServer, v1handler.NewRuntimeServiceServer(
@@ -471,14 +644,13 @@ func Initialize(l log.Logger, conf config.Provider) error {
 		projectSecretRepoFac,
 		v1.NewAdapter(models.PluginRegistry, models.DatastoreRegistry),
 		progressObs,
-		run.NewService(
-			jobrunRepoFac,
-			func() time.Time {
-				return time.Now().UTC()
-			},
-			run.NewGoEngine(),
-		),
+		runService,
 		models.BatchScheduler,
+		clusterSvc,
+		&adminService{
+			db:   dbConn,
+			hash: appHash,
+		},
 	))
 	grpc_prometheus.Register(grpcServer)
 	grpc_prometheus.EnableHandlingTimeHistogram(grpc_prometheus.WithHistogramBuckets(prometheus.DefBuckets))
@@ -491,8 +663,12 @@ func Initialize(l log.Logger, conf config.Provider) error {
 		runtime.WithErrorHandler(runtime.DefaultHTTPErrorHandler),
 	)
 	// gRPC dialup options to proxy http connections
+	gatewayTransportCreds := grpc.WithInsecure()
+	if tlsConfig != nil {
+		gatewayTransportCreds = grpc.WithTransportCredentials(credentials.NewTLS(newLoopbackTLSConfig(tlsConfig)))
+	}
 	grpcConn, err := grpc.DialContext(timeoutGrpcDialCtx, grpcAddr, []grpc.DialOption{
-		grpc.WithInsecure(),
+		gatewayTransportCreds,
 		grpc.WithDefaultCallOptions(
 			grpc.MaxCallRecvMsgSize(GRPCMaxRecvMsgSize),
 			grpc.MaxCallSendMsgSize(GRPCMaxSendMsgSize),
@@ -520,12 +696,18 @@ func Initialize(l log.Logger, conf config.Provider) error {
 		ReadTimeout:  5 * time.Second,
 		WriteTimeout: 60 * time.Second,
 		IdleTimeout:  120 * time.Second,
+		TLSConfig:    tlsConfig,
 	}
 
 	// run our server in a goroutine so that it doesn't block to wait for termination requests
 	go func() {
-		l.Info("starting listening at", "address", grpcAddr)
-		if err := srv.ListenAndServe(); err != nil {
+		l.Info("starting listening at", "address", grpcAddr, "tls", tlsConfig != nil)
+		serve := srv.ListenAndServe
+		if tlsConfig != nil {
+			// certificates are already loaded in tls config
+			serve = func() error { return srv.ListenAndServeTLS("", "") }
+		}
+		if err := serve(); err != nil {
 			if err != http.ErrServerClosed {
 				l.Fatal("server error", "error", err)
 			}
@@ -533,16 +715,6 @@ func Initialize(l log.Logger, conf config.Provider) error {
 	}()
 
 	clusterCtx, clusterCancel := context.WithCancel(context.Background())
-	clusterServer := gossip.NewServer(l)
-	clusterPlanner := prime.NewPlanner(
-		l,
-		clusterServer, jobrunRepoFac, &instanceRepoFactory{
-			db: dbConn,
-		},
-		utils.NewUUIDProvider(), noop.NewExecutor(), func() time.Time {
-			return time.Now().UTC()
-		},
-	)
 	if conf.GetScheduler().NodeID != "" {
 		// start optimus cluster
 		if err := clusterServer.Init(clusterCtx, conf.GetScheduler()); err != nil {
@@ -567,6 +739,11 @@ func Initialize(l log.Logger, conf config.Provider) error {
 	if err = replayManager.Close(); err != nil {
 		terminalError = multierror.Append(terminalError, errors.Wrap(err, "replayManager.Close"))
 	}
+	if eventPurger != nil {
+		if err = eventPurger.Close(); err != nil {
+			terminalError = multierror.Append(terminalError, errors.Wrap(err, "eventPurger.Close"))
+		}
+	}
 
 	// Create a deadline to wait for server
 	ctxProxy, cancelProxy := context.WithTimeout(context.Background(), shutdownWait)
@@ -0,0 +1,93 @@
+package server
+
+import (
+	"bytes"
+	"crypto/tls"
+	"crypto/x509"
+	"io/ioutil"
+
+	"github.com/odpf/optimus/config"
+	"github.com/pkg/errors"
+)
+
+// newServerTLSConfig loads the certificate of server and CAs of clients if
+// configured. Client certificates are verified against client CAs, apart from
+// the certificate of server itself which grpc-gateway presents when proxying
+// http requests to grpc server.
+func newServerTLSConfig(conf config.TLSConfig) (*tls.Config, error) {
+	cert, err := tls.LoadX509KeyPair(conf.CertFile, conf.KeyFile)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to load server certificate")
+	}
+	if cert.Leaf, err = x509.ParseCertificate(cert.Certificate[0]); err != nil {
+		return nil, errors.Wrap(err, "failed to parse server certificate")
+	}
+
+	tlsConfig := &tls.Config{
+		Certificates: []tls.Certificate{cert},
+		MinVersion:   tls.VersionTLS12,
+		NextProtos:   []string{"h2", "http/1.1"},
+	}
+	if conf.ClientCAFile == "" {
+		return tlsConfig, nil
+	}
+
+	clientCAs, err := loadCertPool(conf.ClientCAFile)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to load client CAs")
+	}
+	tlsConfig.ClientAuth = tls.RequireAnyClientCert
+	tlsConfig.VerifyPeerCertificate = func(rawCerts [][]byte, _ [][]*x509.Certificate) error {
+		if bytes.Equal(rawCerts[0], cert.Leaf.Raw) {
+			return nil
+		}
+		certs := make([]*x509.Certificate, len(rawCerts))
+		for i, raw := range rawCerts {
+			if certs[i], err = x509.ParseCertificate(raw); err != nil {
+				return errors.Wrap(err, "failed to parse client certificate")
+			}
+		}
+		intermediates := x509.NewCertPool()
+		for _, c := range certs[1:] {
+			intermediates.AddCert(c)
+		}
+		_, err := certs[0].Verify(x509.VerifyOptions{
+			Roots:         clientCAs,
+			Intermediates: intermediates,
+			KeyUsages:     []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+		})
+		return err
+	}
+	return tlsConfig, nil
+}
+
+// newLoopbackTLSConfig is used by grpc-gateway to connect to grpc server
+// of the same process, only the certificate of server itself is trusted
+func newLoopbackTLSConfig(serverConfig *tls.Config) *tls.Config {
+	cert := serverConfig.Certificates[0]
+	return &tls.Config{
+		Certificates: []tls.Certificate{cert},
+		MinVersion:   tls.VersionTLS12,
+		// server address rarely matches names in its certificate, the
+		// certificate is instead pinned in VerifyPeerCertificate
+		InsecureSkipVerify: true,
+		VerifyPeerCertificate: func(rawCerts [][]byte, _ [][]*x509.Certificate) error {
+			if len(rawCerts) == 0 || !bytes.Equal(rawCerts[0], cert.Leaf.Raw) {
+				return errors.New("certificate of server does not match")
+			}
+			return nil
+		},
+	}
+}
+
+func loadCertPool(path string) (*x509.CertPool, error) {
+	pem, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(pem) {
+		return nil, errors.Errorf("no certificates found in %s", path)
+	}
+	return pool, nil
+}
@@ -0,0 +1,184 @@
+package server
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"io"
+	"io/ioutil"
+	"math/big"
+	"net"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/odpf/optimus/config"
+	"github.com/stretchr/testify/assert"
+)
+
+type testCert struct {
+	cert *x509.Certificate
+	key  *ecdsa.PrivateKey
+}
+
+func (c testCert) tlsCertificate() tls.Certificate {
+	return tls.Certificate{
+		Certificate: [][]byte{c.cert.Raw},
+		PrivateKey:  c.key,
+		Leaf:        c.cert,
+	}
+}
+
+// newTestCert creates a certificate signed by parent, self signed if parent is nil
+func newTestCert(t *testing.T, name string, isCA bool, usage x509.ExtKeyUsage, parent *testCert) testCert {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	assert.Nil(t, err)
+
+	serial, err := rand.Int(rand.Reader, big.NewInt(1<<62))
+	assert.Nil(t, err)
+	tmpl := &x509.Certificate{
+		SerialNumber:          serial,
+		Subject:               pkix.Name{CommonName: name},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		KeyUsage:              x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:           []x509.ExtKeyUsage{usage},
+		BasicConstraintsValid: true,
+		IsCA:                  isCA,
+	}
+	if isCA {
+		tmpl.KeyUsage |= x509.KeyUsageCertSign
+		tmpl.ExtKeyUsage = nil
+	}
+
+	signer, signerKey := tmpl, key
+	if parent != nil {
+		signer, signerKey = parent.cert, parent.key
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, signer, &key.PublicKey, signerKey)
+	assert.Nil(t, err)
+	cert, err := x509.ParseCertificate(der)
+	assert.Nil(t, err)
+	return testCert{cert: cert, key: key}
+}
+
+func writePEM(t *testing.T, path, blockType string, der []byte) {
+	t.Helper()
+	err := ioutil.WriteFile(path, pem.EncodeToMemory(&pem.Block{Type: blockType, Bytes: der}), 0600)
+	assert.Nil(t, err)
+}
+
+// newTestServerTLSConfig writes server certificate and client CA to files
+// and loads them the way server does
+func newTestServerTLSConfig(t *testing.T, server testCert, clientCA *testCert) *tls.Config {
+	t.Helper()
+	dir := t.TempDir()
+	conf := config.TLSConfig{
+		CertFile: filepath.Join(dir, "server.crt"),
+		KeyFile:  filepath.Join(dir, "server.key"),
+	}
+	writePEM(t, conf.CertFile, "CERTIFICATE", server.cert.Raw)
+	keyDER, err := x509.MarshalECPrivateKey(server.key)
+	assert.Nil(t, err)
+	writePEM(t, conf.KeyFile, "EC PRIVATE KEY", keyDER)
+	if clientCA != nil {
+		conf.ClientCAFile = filepath.Join(dir, "client-ca.crt")
+		writePEM(t, conf.ClientCAFile, "CERTIFICATE", clientCA.cert.Raw)
+	}
+
+	tlsConfig, err := newServerTLSConfig(conf)
+	assert.Nil(t, err)
+	return tlsConfig
+}
+
+// handshake connects client to server over an in memory connection and
+// returns the errors of handshake on both sides
+func handshake(t *testing.T, serverConfig, clientConfig *tls.Config) (clientErr, serverErr error) {
+	t.Helper()
+	serverConn, clientConn := net.Pipe()
+	defer serverConn.Close()
+	defer clientConn.Close()
+	deadline := time.Now().Add(10 * time.Second)
+	assert.Nil(t, serverConn.SetDeadline(deadline))
+	assert.Nil(t, clientConn.SetDeadline(deadline))
+
+	clientDone := make(chan error, 1)
+	go func() {
+		err := tls.Client(clientConn, clientConfig).Handshake()
+		clientDone <- err
+		// keep draining records written by server until connection closes
+		_, _ = io.Copy(ioutil.Discard, clientConn)
+	}()
+	serverErr = tls.Server(serverConn, serverConfig).Handshake()
+	if serverErr != nil {
+		serverConn.Close()
+	}
+	clientErr = <-clientDone
+	return clientErr, serverErr
+}
+
+func TestServerTLSConfig(t *testing.T) {
+	serverCA := newTestCert(t, "server-ca", true, 0, nil)
+	serverCert := newTestCert(t, "optimus", false, x509.ExtKeyUsageServerAuth, &serverCA)
+	clientCA := newTestCert(t, "client-ca", true, 0, nil)
+
+	t.Run("should accept client certificate signed by client CA", func(t *testing.T) {
+		serverConfig := newTestServerTLSConfig(t, serverCert, &clientCA)
+		clientCert := newTestCert(t, "ci", false, x509.ExtKeyUsageClientAuth, &clientCA)
+
+		clientErr, serverErr := handshake(t, serverConfig, &tls.Config{
+			Certificates:       []tls.Certificate{clientCert.tlsCertificate()},
+			InsecureSkipVerify: true,
+		})
+		assert.Nil(t, clientErr)
+		assert.Nil(t, serverErr)
+	})
+	t.Run("should reject client certificate signed by unknown CA", func(t *testing.T) {
+		serverConfig := newTestServerTLSConfig(t, serverCert, &clientCA)
+		unknownCA := newTestCert(t, "unknown-ca", true, 0, nil)
+		clientCert := newTestCert(t, "ci", false, x509.ExtKeyUsageClientAuth, &unknownCA)
+
+		_, serverErr := handshake(t, serverConfig, &tls.Config{
+			Certificates:       []tls.Certificate{clientCert.tlsCertificate()},
+			InsecureSkipVerify: true,
+		})
+		assert.NotNil(t, serverErr)
+	})
+	t.Run("should reject connection without client certificate", func(t *testing.T) {
+		serverConfig := newTestServerTLSConfig(t, serverCert, &clientCA)
+
+		_, serverErr := handshake(t, serverConfig, &tls.Config{
+			InsecureSkipVerify: true,
+		})
+		assert.NotNil(t, serverErr)
+	})
+	t.Run("should accept connection without client certificate if client CA is not set", func(t *testing.T) {
+		serverConfig := newTestServerTLSConfig(t, serverCert, nil)
+
+		clientErr, serverErr := handshake(t, serverConfig, &tls.Config{
+			InsecureSkipVerify: true,
+		})
+		assert.Nil(t, clientErr)
+		assert.Nil(t, serverErr)
+	})
+	t.Run("should accept loopback connection of gateway", func(t *testing.T) {
+		serverConfig := newTestServerTLSConfig(t, serverCert, &clientCA)
+
+		clientErr, serverErr := handshake(t, serverConfig, newLoopbackTLSConfig(serverConfig))
+		assert.Nil(t, clientErr)
+		assert.Nil(t, serverErr)
+	})
+	t.Run("should reject loopback connection to a different server", func(t *testing.T) {
+		serverConfig := newTestServerTLSConfig(t, serverCert, &clientCA)
+		otherCert := newTestCert(t, "optimus", false, x509.ExtKeyUsageServerAuth, &serverCA)
+		otherConfig := newTestServerTLSConfig(t, otherCert, &clientCA)
+
+		clientErr, _ := handshake(t, otherConfig, newLoopbackTLSConfig(serverConfig))
+		assert.NotNil(t, clientErr)
+	})
+}
@@ -72,11 +72,75 @@ type ServerConfig struct {
 
 	// random 32 character hash used for encrypting secrets
 	AppKey string `mapstructure:"app_key"`
-
+	// identifier of app_key stored with every secret it encrypts, should be
+	// changed whenever app_key is rotated
+	AppKeyID string `mapstructure:"app_key_id" default:"default"`
+	// previously used app keys by their identifier, only used for decrypting
+	// secrets till they are re-encrypted with app_key
+	OldAppKeys map[string]string `mapstructure:"old_app_keys"`
+
+	Auth                AuthConfig    `mapstructure:"auth"`
+	TLS                 TLSConfig     `mapstructure:"tls"`
 	DB                  DBConfig      `mapstructure:"db"`
 	ReplayNumWorkers    int           `mapstructure:"replay_num_workers" default:"1"`
 	ReplayWorkerTimeout time.Duration `mapstructure:"replay_worker_timeout" default:"120s"`
 	ReplayRunTimeout    time.Duration `mapstructure:"replay_run_timeout"`
+
+	// JobEventRetention is how long events of jobs are kept, older events
+	// are purged every hour, zero keeps them forever
+	JobEventRetention time.Duration `mapstructure:"job_event_retention"`
+}
+
+type TLSConfig struct {
+	// CertFile and KeyFile are paths of PEM encoded certificate and its
+	// private key, connections are served over TLS when both are set
+	CertFile string `mapstructure:"cert_file"`
+	KeyFile  string `mapstructure:"key_file"`
+
+	// ClientCAFile is path of PEM encoded certificates of CAs, clients
+	// need a certificate issued by one of them when set
+	ClientCAFile string `mapstructure:"client_ca_file"`
+}
+
+type AuthConfig struct {
+	// Enabled authenticates every request to the service and authorizes it
+	// using roles of the caller in the requested project
+	Enabled bool `mapstructure:"enabled"`
+
+	// Tokens are static tokens accepted as basic or bearer credentials
+	Tokens []AuthTokenConfig `mapstructure:"tokens"`
+
+	JWT AuthJWTConfig `mapstructure:"jwt"`
+}
+
+type AuthTokenConfig struct {
+	// Name identifies the caller using this token
+	Name  string `mapstructure:"name"`
+	Token string `mapstructure:"token"`
+
+	Roles []AuthRoleConfig `mapstructure:"roles"`
+}
+
+type AuthRoleConfig struct {
+	// Project name, * grants the role in all projects
+	Project string `mapstructure:"project"`
+	// Role is one of viewer, deployer or admin
+	Role string `mapstructure:"role"`
+}
+
+type AuthJWTConfig struct {
+	// HMACSecret verifies tokens signed with HS256
+	HMACSecret string `mapstructure:"hmac_secret"`
+	// PublicKey is a PEM encoded RSA public key verifying tokens signed with RS256
+	PublicKey string `mapstructure:"public_key"`
+
+	// Issuer and Audience are validated when set
+	Issuer   string `mapstructure:"issuer"`
+	Audience string `mapstructure:"audience"`
+
+	// RolesClaim is the claim containing role of the caller by project name,
+	// e.g. {"optimus_roles": {"my-project": "deployer"}}
+	RolesClaim string `mapstructure:"roles_claim" default:"optimus_roles"`
 }
 
 type DBConfig struct {
@@ -100,6 +164,24 @@ type SchedulerConfig struct {
 	NodeID     string `mapstructure:"node_id"`
 	DataDir    string `mapstructure:"data_dir"`
 	Peers      string `mapstructure:"peers"`
+
+	// Capacity is the number of job runs this node can execute at a time
+	Capacity int `mapstructure:"capacity" default:"20"`
+	// PlannerInterval is the wait between each cycle of cluster planner
+	PlannerInterval time.Duration `mapstructure:"planner_interval" default:"10s"`
+	// InstanceRunTimeout marks an instance zombie if it keeps running longer than this
+	InstanceRunTimeout time.Duration `mapstructure:"instance_run_timeout" default:"4h"`
+	// NodeJobRunTimeout moves a job run back to pending if its node doesn't start it in time
+	NodeJobRunTimeout time.Duration `mapstructure:"node_job_run_timeout" default:"1h"`
+
+	// Executor runs job instances allocated to this node, could be noop/process
+	Executor string `mapstructure:"executor" default:"noop"`
+	// ExecutorDir is used by process executor to keep compiled assets of instances
+	ExecutorDir string `mapstructure:"executor_dir"`
+	// ExecutorEntrypoints are executables run by process executor for each plugin, keyed by plugin name
+	ExecutorEntrypoints map[string]string `mapstructure:"executor_entrypoints"`
+	// ExecutorDocker runs images of plugins without an entrypoint in local docker containers
+	ExecutorDocker bool `mapstructure:"executor_docker"`
 }
 
 type AdminConfig struct {
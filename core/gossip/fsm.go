@@ -1,11 +1,12 @@
 package gossip
 
 import (
-	"bufio"
-	"bytes"
-	"encoding/gob"
+	"fmt"
 	"io"
+	"io/ioutil"
+	"sort"
 	"sync"
+	"time"
 
 	"github.com/hashicorp/raft"
 	pb "github.com/odpf/optimus/api/proto/odpf/optimus/cluster/v1beta1"
@@ -13,15 +14,29 @@ import (
 	"github.com/odpf/optimus/models"
 	"github.com/odpf/salt/log"
 	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
+// snapshotVersion is the version of snapshot format written by fsm, it
+// should be bumped for every change in State which is not backward compatible
+const snapshotVersion = 1
+
 type State struct {
 	Allocation map[string]set.Set // peerID -> StateJob
+
+	// Drained peers don't get any new job runs allocated
+	Drained map[string]bool
+
+	// AllocationPaused stops allocation of new job runs to all peers
+	AllocationPaused bool
 }
 
 type StateJob struct {
 	UUID   string
 	Status string
+
+	// AllocatedAt is the time when job was scheduled to the peer
+	AllocatedAt time.Time
 }
 
 type fsm struct {
@@ -58,8 +73,9 @@ func (f *fsm) Apply(rlog *raft.Log) interface{} {
 			}
 
 			f.state.Allocation[cmdLog.PeerId].Add(StateJob{
-				UUID:   id,
-				Status: models.RunStateAccepted.String(),
+				UUID:        id,
+				Status:      models.RunStateAccepted.String(),
+				AllocatedAt: rlog.AppendedAt,
 			})
 		}
 		f.l.Debug("updated state", "alloc state", f.state.Allocation[cmdLog.PeerId])
@@ -68,6 +84,10 @@ func (f *fsm) Apply(rlog *raft.Log) interface{} {
 		if err := proto.Unmarshal(cmd.Payload, cmdLog); err != nil {
 			return nil
 		}
+		if _, ok := f.state.Allocation[cmdLog.PeerId]; !ok {
+			// peer might have been released already
+			return nil
+		}
 		// update local state
 		for _, patch := range cmdLog.Patches {
 			for _, rawJobState := range f.state.Allocation[cmdLog.PeerId].Values() {
@@ -76,36 +96,77 @@ func (f *fsm) Apply(rlog *raft.Log) interface{} {
 					f.state.Allocation[cmdLog.PeerId].Remove(jobState)
 					jobState.Status = patch.Status
 
-					// if run at terminating states, deallocate else update
+					// if run at terminating states or moved back to pending
+					// for rescheduling, deallocate else update
 					if patch.Status != models.RunStateFailed.String() &&
-						patch.Status != models.RunStateSuccess.String() {
+						patch.Status != models.RunStateSuccess.String() &&
+						patch.Status != models.RunStatePending.String() {
 						f.state.Allocation[cmdLog.PeerId].Add(jobState)
 					}
 				}
 			}
 		}
 		f.l.Debug("updated state", "alloc state", f.state.Allocation[cmdLog.PeerId])
+	case pb.CommandLogType_COMMAND_LOG_TYPE_CANCEL_JOB:
+		cmdLog := &pb.CommandCancelJob{}
+		if err := proto.Unmarshal(cmd.Payload, cmdLog); err != nil {
+			return nil
+		}
+		// update local state
+		for peerID, jobs := range f.state.Allocation {
+			for _, rawJobState := range jobs.Values() {
+				if jobState := rawJobState.(StateJob); jobState.UUID == cmdLog.RunId {
+					jobs.Remove(jobState)
+					f.l.Debug("cancelled job run", "run id", cmdLog.RunId, "peer id", peerID)
+				}
+			}
+		}
+	case pb.CommandLogType_COMMAND_LOG_TYPE_DRAIN_PEER:
+		cmdLog := &pb.CommandDrainPeer{}
+		if err := proto.Unmarshal(cmd.Payload, cmdLog); err != nil {
+			return nil
+		}
+		// update local state
+		if cmdLog.Drain {
+			f.state.Drained[cmdLog.PeerId] = true
+		} else {
+			delete(f.state.Drained, cmdLog.PeerId)
+		}
+		f.l.Debug("updated peer drain", "peer id", cmdLog.PeerId, "drain", cmdLog.Drain)
+	case pb.CommandLogType_COMMAND_LOG_TYPE_PAUSE_ALLOCATION:
+		cmdLog := &pb.CommandPauseAllocation{}
+		if err := proto.Unmarshal(cmd.Payload, cmdLog); err != nil {
+			return nil
+		}
+		// update local state
+		f.state.AllocationPaused = cmdLog.Pause
+		f.l.Debug("updated allocation pause", "pause", cmdLog.Pause)
+	case pb.CommandLogType_COMMAND_LOG_TYPE_RELEASE_PEER:
+		cmdLog := &pb.CommandReleasePeer{}
+		if err := proto.Unmarshal(cmd.Payload, cmdLog); err != nil {
+			return nil
+		}
+		// update local state
+		delete(f.state.Allocation, cmdLog.PeerId)
+		f.l.Debug("released peer allocations", "peer id", cmdLog.PeerId)
 	default:
 		// ignore
 	}
 	return nil
 }
 
-// fsmSnapshot returns a snapshot of the local fsm store
-// Note(kushsharma): we use golang gob for encoding the struct to bytes, this
-// is slow compare to protobuf or msgpack but I think its good enough for
-// now, can be optimised further if needed
+// Snapshot returns a snapshot of the local fsm store encoded as a versioned
+// protobuf message so that it can be restored by newer releases
 func (f *fsm) Snapshot() (raft.FSMSnapshot, error) {
 	f.mu.Lock()
 	defer f.mu.Unlock()
 
-	var b bytes.Buffer
-	encoder := gob.NewEncoder(bufio.NewWriter(&b))
-	if err := encoder.Encode(f.state); err != nil {
+	data, err := proto.Marshal(toSnapshotProto(f.state))
+	if err != nil {
 		return nil, err
 	}
 	return &fsmSnapshot{
-		data: b.Bytes(),
+		data: data,
 	}, nil
 }
 
@@ -116,22 +177,89 @@ func (f *fsm) Restore(closer io.ReadCloser) error {
 	defer f.mu.Unlock()
 
 	defer closer.Close()
-	decoder := gob.NewDecoder(closer)
-	var state State
-	if err := decoder.Decode(&state); err != nil {
+	data, err := ioutil.ReadAll(closer)
+	if err != nil {
+		return err
+	}
+	state, err := fromSnapshotBytes(data)
+	if err != nil {
 		return err
 	}
 	f.state = state
 	return nil
 }
 
+func newState() State {
+	return State{
+		Allocation: map[string]set.Set{},
+		Drained:    map[string]bool{},
+	}
+}
+
+func toSnapshotProto(state State) *pb.Snapshot {
+	snapshot := &pb.Snapshot{
+		Version:          snapshotVersion,
+		AllocationPaused: state.AllocationPaused,
+	}
+	for peerID, jobs := range state.Allocation {
+		peerAllocation := &pb.Snapshot_PeerAllocation{
+			PeerId: peerID,
+		}
+		for _, rawJobState := range jobs.Values() {
+			jobState := rawJobState.(StateJob)
+			peerAllocation.Jobs = append(peerAllocation.Jobs, &pb.Snapshot_Job{
+				RunId:       jobState.UUID,
+				Status:      jobState.Status,
+				AllocatedAt: timestamppb.New(jobState.AllocatedAt),
+			})
+		}
+		snapshot.Allocations = append(snapshot.Allocations, peerAllocation)
+	}
+	for peerID := range state.Drained {
+		snapshot.DrainedPeers = append(snapshot.DrainedPeers, peerID)
+	}
+	sort.Strings(snapshot.DrainedPeers)
+	return snapshot
+}
+
+// fromSnapshotBytes decodes the persisted snapshot, snapshots written by
+// older releases were never flushed to the sink and are always empty
+func fromSnapshotBytes(data []byte) (State, error) {
+	state := newState()
+	if len(data) == 0 {
+		return state, nil
+	}
+
+	snapshot := &pb.Snapshot{}
+	if err := proto.Unmarshal(data, snapshot); err != nil {
+		return state, fmt.Errorf("failed to decode snapshot: %w", err)
+	}
+	if snapshot.Version != snapshotVersion {
+		return state, fmt.Errorf("unsupported snapshot version %d", snapshot.Version)
+	}
+	for _, peerAllocation := range snapshot.Allocations {
+		jobs := set.NewHashSet()
+		for _, job := range peerAllocation.Jobs {
+			jobs.Add(StateJob{
+				UUID:        job.RunId,
+				Status:      job.Status,
+				AllocatedAt: job.AllocatedAt.AsTime(),
+			})
+		}
+		state.Allocation[peerAllocation.PeerId] = jobs
+	}
+	for _, peerID := range snapshot.DrainedPeers {
+		state.Drained[peerID] = true
+	}
+	state.AllocationPaused = snapshot.AllocationPaused
+	return state, nil
+}
+
 func NewFSM(l log.Logger) *fsm {
 	return &fsm{
-		l: l,
-		state: State{
-			Allocation: map[string]set.Set{},
-		},
-		mu: new(sync.Mutex),
+		l:     l,
+		state: newState(),
+		mu:    new(sync.Mutex),
 	}
 }
 
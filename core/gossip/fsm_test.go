@@ -0,0 +1,178 @@
+package gossip
+
+import (
+	"bytes"
+	"io/ioutil"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/raft"
+	pb "github.com/odpf/optimus/api/proto/odpf/optimus/cluster/v1beta1"
+	"github.com/odpf/optimus/models"
+	"github.com/odpf/salt/log"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/proto"
+)
+
+func newTestLog(t *testing.T, cmdType pb.CommandLogType, payload proto.Message, appendedAt time.Time) *raft.Log {
+	payloadBytes, err := proto.Marshal(payload)
+	assert.Nil(t, err)
+	cmdBytes, err := proto.Marshal(&pb.CommandLog{
+		Type:    cmdType,
+		Payload: payloadBytes,
+	})
+	assert.Nil(t, err)
+	return &raft.Log{
+		Type:       raft.LogCommand,
+		Data:       cmdBytes,
+		AppendedAt: appendedAt,
+	}
+}
+
+func TestFSM(t *testing.T) {
+	appendedAt := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	t.Run("should allocate scheduled jobs to peer with allocation time", func(t *testing.T) {
+		f := NewFSM(log.NewNoop())
+		f.Apply(newTestLog(t, pb.CommandLogType_COMMAND_LOG_TYPE_SCHEDULE_JOB, &pb.CommandScheduleJob{
+			PeerId: "node-1",
+			RunIds: []string{"a"},
+		}, appendedAt))
+
+		assert.Equal(t, []interface{}{StateJob{
+			UUID:        "a",
+			Status:      models.RunStateAccepted.String(),
+			AllocatedAt: appendedAt,
+		}}, f.state.Allocation["node-1"].Values())
+	})
+	t.Run("should deallocate jobs moved back to pending", func(t *testing.T) {
+		f := NewFSM(log.NewNoop())
+		f.Apply(newTestLog(t, pb.CommandLogType_COMMAND_LOG_TYPE_SCHEDULE_JOB, &pb.CommandScheduleJob{
+			PeerId: "node-1",
+			RunIds: []string{"a", "b"},
+		}, appendedAt))
+		f.Apply(newTestLog(t, pb.CommandLogType_COMMAND_LOG_TYPE_UPDATE_JOB, &pb.CommandUpdateJob{
+			PeerId: "node-1",
+			Patches: []*pb.CommandUpdateJob_Patch{
+				{RunId: "a", Status: models.RunStatePending.String()},
+			},
+		}, appendedAt))
+
+		values := f.state.Allocation["node-1"].Values()
+		assert.Len(t, values, 1)
+		assert.Equal(t, "b", values[0].(StateJob).UUID)
+	})
+	t.Run("should release all allocations of a peer", func(t *testing.T) {
+		f := NewFSM(log.NewNoop())
+		f.Apply(newTestLog(t, pb.CommandLogType_COMMAND_LOG_TYPE_SCHEDULE_JOB, &pb.CommandScheduleJob{
+			PeerId: "node-1",
+			RunIds: []string{"a"},
+		}, appendedAt))
+		f.Apply(newTestLog(t, pb.CommandLogType_COMMAND_LOG_TYPE_SCHEDULE_JOB, &pb.CommandScheduleJob{
+			PeerId: "node-2",
+			RunIds: []string{"b"},
+		}, appendedAt))
+		f.Apply(newTestLog(t, pb.CommandLogType_COMMAND_LOG_TYPE_RELEASE_PEER, &pb.CommandReleasePeer{
+			PeerId: "node-1",
+		}, appendedAt))
+
+		_, ok := f.state.Allocation["node-1"]
+		assert.False(t, ok)
+		assert.Len(t, f.state.Allocation["node-2"].Values(), 1)
+
+		// updates for released peers are ignored
+		f.Apply(newTestLog(t, pb.CommandLogType_COMMAND_LOG_TYPE_UPDATE_JOB, &pb.CommandUpdateJob{
+			PeerId: "node-1",
+			Patches: []*pb.CommandUpdateJob_Patch{
+				{RunId: "a", Status: models.RunStateRunning.String()},
+			},
+		}, appendedAt))
+		_, ok = f.state.Allocation["node-1"]
+		assert.False(t, ok)
+	})
+	t.Run("should remove cancelled job run from its peer", func(t *testing.T) {
+		f := NewFSM(log.NewNoop())
+		f.Apply(newTestLog(t, pb.CommandLogType_COMMAND_LOG_TYPE_SCHEDULE_JOB, &pb.CommandScheduleJob{
+			PeerId: "node-1",
+			RunIds: []string{"a", "b"},
+		}, appendedAt))
+		f.Apply(newTestLog(t, pb.CommandLogType_COMMAND_LOG_TYPE_CANCEL_JOB, &pb.CommandCancelJob{
+			RunId: "a",
+		}, appendedAt))
+
+		values := f.state.Allocation["node-1"].Values()
+		assert.Len(t, values, 1)
+		assert.Equal(t, "b", values[0].(StateJob).UUID)
+	})
+	t.Run("should drain and undrain a peer", func(t *testing.T) {
+		f := NewFSM(log.NewNoop())
+		f.Apply(newTestLog(t, pb.CommandLogType_COMMAND_LOG_TYPE_DRAIN_PEER, &pb.CommandDrainPeer{
+			PeerId: "node-1",
+			Drain:  true,
+		}, appendedAt))
+		assert.Equal(t, map[string]bool{"node-1": true}, f.state.Drained)
+
+		f.Apply(newTestLog(t, pb.CommandLogType_COMMAND_LOG_TYPE_DRAIN_PEER, &pb.CommandDrainPeer{
+			PeerId: "node-1",
+		}, appendedAt))
+		assert.Empty(t, f.state.Drained)
+	})
+	t.Run("should pause and resume allocation", func(t *testing.T) {
+		f := NewFSM(log.NewNoop())
+		f.Apply(newTestLog(t, pb.CommandLogType_COMMAND_LOG_TYPE_PAUSE_ALLOCATION, &pb.CommandPauseAllocation{
+			Pause: true,
+		}, appendedAt))
+		assert.True(t, f.state.AllocationPaused)
+
+		f.Apply(newTestLog(t, pb.CommandLogType_COMMAND_LOG_TYPE_PAUSE_ALLOCATION, &pb.CommandPauseAllocation{}, appendedAt))
+		assert.False(t, f.state.AllocationPaused)
+	})
+}
+
+func TestFSMSnapshot(t *testing.T) {
+	appendedAt := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	t.Run("should restore state from its snapshot", func(t *testing.T) {
+		f := NewFSM(log.NewNoop())
+		f.Apply(newTestLog(t, pb.CommandLogType_COMMAND_LOG_TYPE_SCHEDULE_JOB, &pb.CommandScheduleJob{
+			PeerId: "node-1",
+			RunIds: []string{"a"},
+		}, appendedAt))
+		f.Apply(newTestLog(t, pb.CommandLogType_COMMAND_LOG_TYPE_DRAIN_PEER, &pb.CommandDrainPeer{
+			PeerId: "node-2",
+			Drain:  true,
+		}, appendedAt))
+		f.Apply(newTestLog(t, pb.CommandLogType_COMMAND_LOG_TYPE_PAUSE_ALLOCATION, &pb.CommandPauseAllocation{
+			Pause: true,
+		}, appendedAt))
+
+		snapshot, err := f.Snapshot()
+		assert.Nil(t, err)
+
+		restored := NewFSM(log.NewNoop())
+		err = restored.Restore(ioutil.NopCloser(bytes.NewReader(snapshot.(*fsmSnapshot).data)))
+		assert.Nil(t, err)
+		assert.Equal(t, []interface{}{StateJob{
+			UUID:        "a",
+			Status:      models.RunStateAccepted.String(),
+			AllocatedAt: appendedAt,
+		}}, restored.state.Allocation["node-1"].Values())
+		assert.Equal(t, map[string]bool{"node-2": true}, restored.state.Drained)
+		assert.True(t, restored.state.AllocationPaused)
+	})
+	t.Run("should restore empty state from an empty snapshot", func(t *testing.T) {
+		f := NewFSM(log.NewNoop())
+		err := f.Restore(ioutil.NopCloser(bytes.NewReader(nil)))
+		assert.Nil(t, err)
+		assert.Empty(t, f.state.Allocation)
+		assert.Empty(t, f.state.Drained)
+	})
+	t.Run("should fail to restore snapshot of unsupported version", func(t *testing.T) {
+		data, err := proto.Marshal(&pb.Snapshot{Version: snapshotVersion + 1})
+		assert.Nil(t, err)
+
+		f := NewFSM(log.NewNoop())
+		err = f.Restore(ioutil.NopCloser(bytes.NewReader(data)))
+		assert.Equal(t, "unsupported snapshot version 2", err.Error())
+	})
+}
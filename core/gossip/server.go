@@ -27,6 +27,10 @@ const (
 	connectionTimeout   = 10 * time.Second
 
 	applyTimeout = 10 * time.Second
+
+	// TagCapacity is used by peers to advertise how many job runs
+	// they can execute at a time
+	TagCapacity = "capacity"
 	//leaderWaitDelay  = 100 * time.Millisecond
 	//appliedWaitDelay = 100 * time.Millisecond
 	//raftLogCacheSize = 512
@@ -123,6 +127,24 @@ func (s *Server) GetLocalMember() serf.Member {
 	return s.serf.LocalMember()
 }
 
+// GetLeader returns name of the peer currently leading the cluster, it is
+// empty while leader election is in progress
+func (s *Server) GetLeader() string {
+	if s.raft == nil {
+		return ""
+	}
+	leaderAddr := string(s.raft.Leader())
+	if leaderAddr == "" {
+		return ""
+	}
+	for _, member := range s.serf.Members() {
+		if member.Tags["raftAddr"] == leaderAddr {
+			return member.Name
+		}
+	}
+	return ""
+}
+
 // IsLeader checks if current node is the cluster leader at the moment
 func (s *Server) IsLeader() bool {
 	if s.raft == nil {
@@ -136,7 +158,8 @@ func (s *Server) IsLeader() bool {
 // if a node leaves membership gossip, it is removed from the raft cluster
 func (s *Server) initSerf(ctx context.Context, schedulerConf config.SchedulerConfig) error {
 	s.serfEvents = make(chan serf.Event)
-	serfConfig, err := newSerfConfig(schedulerConf.GossipAddr, schedulerConf.RaftAddr, schedulerConf.NodeID, s.serfEvents)
+	serfConfig, err := newSerfConfig(schedulerConf.GossipAddr, schedulerConf.RaftAddr, schedulerConf.NodeID,
+		schedulerConf.Capacity, s.serfEvents)
 	if err != nil {
 		return err
 	}
@@ -154,7 +177,7 @@ func (s *Server) initSerf(ctx context.Context, schedulerConf config.SchedulerCon
 	return nil
 }
 
-func newSerfConfig(serfAddr, raftAddress, nodeID string, eventCh chan serf.Event) (*serf.Config, error) {
+func newSerfConfig(serfAddr, raftAddress, nodeID string, capacity int, eventCh chan serf.Event) (*serf.Config, error) {
 	serfHost, serfPort, err := net.SplitHostPort(serfAddr)
 	if err != nil {
 		return nil, err
@@ -172,6 +195,9 @@ func newSerfConfig(serfAddr, raftAddress, nodeID string, eventCh chan serf.Event
 	config.Tags = map[string]string{}
 	config.Tags["raftAddr"] = raftAddress
 	config.Tags["nodeID"] = nodeID
+	if capacity > 0 {
+		config.Tags[TagCapacity] = strconv.Itoa(capacity)
+	}
 	config.EventCh = eventCh
 	config.EnableNameConflictResolution = false
 	return config, nil
@@ -329,9 +355,14 @@ func (s *Server) HandleAddPeer(member serf.Member) error {
 	return nil
 }
 
-// HandleLeavePeer removes a node to cluster
+// HandleLeavePeer removes a node to cluster and releases all the jobs
+// scheduled to it so that they can be picked by other peers
 // only cluster leader should call this
 func (s *Server) HandleLeavePeer(member serf.Member) error {
+	if err := s.releasePeer(member.Name); err != nil {
+		return err
+	}
+
 	configFuture := s.raft.GetConfiguration()
 	if err := configFuture.Error(); err != nil {
 		s.l.Info(fmt.Sprintf("failed to get raft configuration: %v", err))
@@ -358,6 +389,30 @@ func (s *Server) HandleLeavePeer(member serf.Member) error {
 	return nil
 }
 
+// releasePeer drops all allocations of a peer from cluster state, runs
+// still in progress will be moved back to pending state by the planner
+func (s *Server) releasePeer(peerID string) error {
+	if _, ok := s.GetState().Allocation[peerID]; !ok {
+		// nothing to release
+		return nil
+	}
+
+	payload, err := proto.Marshal(&pb.CommandReleasePeer{
+		PeerId: peerID,
+	})
+	if err != nil {
+		return err
+	}
+	if err := s.ApplyCommand(&pb.CommandLog{
+		Type:    pb.CommandLogType_COMMAND_LOG_TYPE_RELEASE_PEER,
+		Payload: payload,
+	}); err != nil {
+		return err
+	}
+	s.l.Info("released allocations of peer", "member name", peerID)
+	return nil
+}
+
 func NewServer(l log.Logger) *Server {
 	return &Server{
 		l:   l,
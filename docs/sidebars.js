@@ -39,7 +39,9 @@ module.exports = {
         "guides/task-bq2bq",
         "guides/backup",
         "guides/replay",
-        "guides/secret"
+        "guides/secret",
+        "guides/project",
+        "guides/notification"
       ],
     },
     {
@@ -0,0 +1,415 @@
+package process
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/odpf/optimus/models"
+)
+
+const (
+	// JobDirEnv points to the directory which contains compiled
+	// assets of the instance
+	JobDirEnv = "JOB_DIR"
+
+	// directory inside job dir where compiled assets are written
+	inputDirectory = "in"
+
+	// finished executions are kept till their stats are read, or at most
+	// for this long
+	finishedExecutionTTL = 10 * time.Minute
+)
+
+var (
+	ErrNoSuchExecution = errors.New("invalid id, no such execution")
+
+	// inheritedEnvs are passed from server environment to the instance
+	// process, rest of the server environment could contain credentials
+	// like the app key and is never exposed to plugins
+	inheritedEnvs = []string{"PATH", "HOME", "DOCKER_HOST", "DOCKER_CONFIG", "DOCKER_CERT_PATH", "DOCKER_TLS_VERIFY"}
+)
+
+// Compiler prepares instance execution context environment,
+// normally implemented by run.Service
+type Compiler interface {
+	Compile(ctx context.Context, namespaceSpec models.NamespaceSpec, jobRun models.JobRun,
+		instanceSpec models.InstanceSpec) (envMap map[string]string, fileMap map[string]string, err error)
+}
+
+// CommandFn builds the command which performs the actual work of the plugin,
+// envs are already set in the process environment and compiled assets are
+// available in jobDir
+type CommandFn func(req models.ExecutorStartRequest, envs map[string]string, jobDir string) (name string, args []string, err error)
+
+// EntrypointCommand runs the executable configured for plugin of the instance
+// directly as a local process, entrypoints are paths of executables by plugin
+// name. Plugins without an entrypoint are run with fallback if it is set.
+func EntrypointCommand(entrypoints map[string]string, fallback CommandFn) CommandFn {
+	return func(req models.ExecutorStartRequest, envs map[string]string, jobDir string) (string, []string, error) {
+		unit, err := instanceUnit(req)
+		if err != nil {
+			return "", nil, err
+		}
+		if entrypoint, ok := entrypoints[unit.Info().Name]; ok && entrypoint != "" {
+			return entrypoint, nil, nil
+		}
+		if fallback == nil {
+			return "", nil, errors.Errorf("no entrypoint configured for plugin %s", unit.Info().Name)
+		}
+		return fallback(req, envs, jobDir)
+	}
+}
+
+// DockerCommand runs plugin image of the instance in a local docker
+// container, job directory is mounted at /data like kubernetes executions.
+// It needs a docker daemon reachable from the server.
+func DockerCommand(req models.ExecutorStartRequest, envs map[string]string, jobDir string) (string, []string, error) {
+	unit, err := instanceUnit(req)
+	if err != nil {
+		return "", nil, err
+	}
+	if unit.Info().Image == "" {
+		return "", nil, errors.Errorf("plugin %s has no image to execute", unit.Info().Name)
+	}
+
+	args := []string{"run", "--rm", "-v", fmt.Sprintf("%s:/data", jobDir)}
+	var keys []string
+	for key := range envs {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		if key == JobDirEnv {
+			continue
+		}
+		// values are picked from process environment to avoid leaking
+		// them as command arguments
+		args = append(args, "-e", key)
+	}
+	args = append(args, "-e", fmt.Sprintf("%s=/data", JobDirEnv), unit.Info().Image)
+	return "docker", args, nil
+}
+
+// instanceUnit finds the plugin which needs to be executed for the instance
+func instanceUnit(req models.ExecutorStartRequest) (*models.Plugin, error) {
+	if req.Instance.Type == models.InstanceTypeHook {
+		hook, err := req.Job.GetHookByName(req.Instance.Name)
+		if err != nil {
+			return nil, err
+		}
+		return hook.Unit, nil
+	}
+	return req.Job.Task.Unit, nil
+}
+
+// Executor runs instances as local OS processes
+type Executor struct {
+	compiler  Compiler
+	commandFn CommandFn
+	baseDir   string
+
+	// executions are kept till their stats are read after they finish,
+	// nil entries are the ones still starting
+	executions map[string]*execution
+	mu         *sync.Mutex
+}
+
+type execution struct {
+	id     string
+	cmd    *exec.Cmd
+	jobDir string
+	logs   *safeBuffer
+
+	// secrets available to the instance are redacted from logs
+	secrets models.ProjectSecrets
+
+	done     chan struct{}
+	exitCode int
+	status   models.JobRunState
+}
+
+func (e *Executor) Start(ctx context.Context, req models.ExecutorStartRequest) (*models.ExecutorStartResponse, error) {
+	// reserve the id, compilation and process start happen without holding
+	// the lock
+	e.mu.Lock()
+	if _, ok := e.executions[req.ID]; ok {
+		e.mu.Unlock()
+		return nil, errors.Errorf("execution %s already exists", req.ID)
+	}
+	e.executions[req.ID] = nil
+	e.mu.Unlock()
+
+	exe, err := e.start(ctx, req)
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if err != nil {
+		delete(e.executions, req.ID)
+		return nil, err
+	}
+	e.executions[req.ID] = exe
+	go e.wait(exe)
+	return &models.ExecutorStartResponse{}, nil
+}
+
+// start compiles the instance and starts its process
+func (e *Executor) start(ctx context.Context, req models.ExecutorStartRequest) (*execution, error) {
+	envMap, fileMap, err := e.compiler.Compile(ctx, req.Namespace, req.JobRun, req.Instance)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to compile instance %s", req.Instance.Name)
+	}
+	envs := map[string]string{
+		"JOB_NAME":      req.Job.Name,
+		"JOB_LABELS":    req.Job.GetLabelsAsString(),
+		"PROJECT":       req.Namespace.ProjectSpec.Name,
+		"NAMESPACE":     req.Namespace.Name,
+		"INSTANCE_TYPE": req.Instance.Type.String(),
+		"INSTANCE_NAME": req.Instance.Name,
+		"SCHEDULED_AT":  req.JobRun.ScheduledAt.Format(models.InstanceScheduledAtTimeLayout),
+	}
+	for key, val := range envMap {
+		envs[key] = val
+	}
+
+	jobDir := filepath.Join(e.baseDir, req.ID)
+	envs[JobDirEnv] = jobDir
+	if err := writeAssets(filepath.Join(jobDir, inputDirectory), envMap, fileMap); err != nil {
+		os.RemoveAll(jobDir)
+		return nil, err
+	}
+
+	name, args, err := e.commandFn(req, envs, jobDir)
+	if err != nil {
+		os.RemoveAll(jobDir)
+		return nil, err
+	}
+	logs := new(safeBuffer)
+	cmd := exec.Command(name, args...)
+	cmd.Dir = jobDir
+	cmd.Stdout = logs
+	cmd.Stderr = logs
+	for _, key := range inheritedEnvs {
+		if val, ok := os.LookupEnv(key); ok {
+			cmd.Env = append(cmd.Env, fmt.Sprintf("%s=%s", key, val))
+		}
+	}
+	for key, val := range envs {
+		cmd.Env = append(cmd.Env, fmt.Sprintf("%s=%s", key, val))
+	}
+	if err := cmd.Start(); err != nil {
+		os.RemoveAll(jobDir)
+		return nil, errors.Wrapf(err, "failed to start instance %s", req.Instance.Name)
+	}
+
+	return &execution{
+		id:      req.ID,
+		cmd:     cmd,
+		jobDir:  jobDir,
+		logs:    logs,
+		secrets: req.Namespace.ProjectSpec.Secret,
+		done:    make(chan struct{}),
+		status:  models.RunStateRunning,
+	}, nil
+}
+
+// get returns the execution if it is started
+func (e *Executor) get(id string) (*execution, bool) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	exe, ok := e.executions[id]
+	return exe, ok && exe != nil
+}
+
+// wait blocks till the process exits and records its result
+func (e *Executor) wait(exe *execution) {
+	err := exe.cmd.Wait()
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	exe.exitCode = exe.cmd.ProcessState.ExitCode()
+	if err != nil && exe.exitCode == 0 {
+		// process didn't exit normally, e.g. io failures
+		exe.exitCode = -1
+	}
+	if exe.exitCode == 0 {
+		exe.status = models.RunStateSuccess
+	} else {
+		exe.status = models.RunStateFailed
+	}
+	// compiled assets could contain secrets, don't leave them around
+	os.RemoveAll(exe.jobDir)
+	close(exe.done)
+
+	// forget the execution if its stats are never read
+	time.AfterFunc(finishedExecutionTTL, func() {
+		e.mu.Lock()
+		defer e.mu.Unlock()
+		e.forget(exe)
+	})
+}
+
+// forget removes the execution unless the id is already reused
+func (e *Executor) forget(exe *execution) {
+	if e.executions[exe.id] == exe {
+		delete(e.executions, exe.id)
+	}
+}
+
+func (exe *execution) finished() bool {
+	select {
+	case <-exe.done:
+		return true
+	default:
+		return false
+	}
+}
+
+func (e *Executor) Stop(ctx context.Context, req models.ExecutorStopRequest) error {
+	exe, ok := e.get(req.ID)
+	if !ok {
+		return ErrNoSuchExecution
+	}
+
+	if exe.finished() {
+		return nil
+	}
+	if err := exe.cmd.Process.Signal(toSignal(req.Signal)); err != nil {
+		return errors.Wrapf(err, "failed to stop execution %s", req.ID)
+	}
+	select {
+	case <-exe.done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// WaitForFinish returns a channel which receives the exit code of the
+// execution once it exits
+func (e *Executor) WaitForFinish(ctx context.Context, id string) (chan int, error) {
+	exe, ok := e.get(id)
+	if !ok {
+		return nil, ErrNoSuchExecution
+	}
+
+	resultChan := make(chan int, 1)
+	go func() {
+		<-exe.done
+		e.mu.Lock()
+		exitCode := exe.exitCode
+		e.mu.Unlock()
+
+		resultChan <- exitCode
+		close(resultChan)
+	}()
+	return resultChan, nil
+}
+
+// Stats returns the status and output of execution, finished executions
+// are forgotten once their stats are read
+func (e *Executor) Stats(ctx context.Context, id string) (*models.ExecutorStats, error) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	exe, ok := e.executions[id]
+	if !ok || exe == nil {
+		return nil, ErrNoSuchExecution
+	}
+	if exe.finished() {
+		e.forget(exe)
+	}
+
+	return &models.ExecutorStats{
+		Logs:   []byte(exe.secrets.Redact(string(exe.logs.Bytes()))),
+		Status: exe.status.String(),
+	}, nil
+}
+
+// writeAssets writes compiled files and envs of the instance in provided
+// directory, in the layout `optimus admin build instance` uses for containers.
+// Values in env file are shell quoted so that it can be sourced safely.
+func writeAssets(dir string, envMap, fileMap map[string]string) error {
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return errors.Wrapf(err, "failed to create directory at %s", dir)
+	}
+	for fileName, fileContent := range fileMap {
+		filePath := filepath.Join(dir, fileName)
+		if err := os.MkdirAll(filepath.Dir(filePath), 0700); err != nil {
+			return errors.Wrapf(err, "failed to create directory at %s", filepath.Dir(filePath))
+		}
+		if err := os.WriteFile(filePath, []byte(fileContent), 0600); err != nil {
+			return errors.Wrapf(err, "failed to write asset file at %s", filePath)
+		}
+	}
+
+	var keys []string
+	for key := range envMap {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	var envFileBlob strings.Builder
+	for _, key := range keys {
+		envFileBlob.WriteString(fmt.Sprintf("%s=%s\n", key, shellQuote(envMap[key])))
+	}
+	filePath := filepath.Join(dir, models.InstanceDataTypeEnvFileName)
+	if err := os.WriteFile(filePath, []byte(envFileBlob.String()), 0600); err != nil {
+		return errors.Wrapf(err, "failed to write asset file at %s", filePath)
+	}
+	return nil
+}
+
+// shellQuote quotes value to be read back as is by a posix shell
+func shellQuote(value string) string {
+	return "'" + strings.ReplaceAll(value, "'", `'\''`) + "'"
+}
+
+func toSignal(name string) os.Signal {
+	switch strings.TrimPrefix(strings.ToUpper(name), "SIG") {
+	case "KILL":
+		return syscall.SIGKILL
+	case "INT":
+		return syscall.SIGINT
+	}
+	return syscall.SIGTERM
+}
+
+// safeBuffer is a bytes.Buffer that can be written by the process
+// and read by stats concurrently
+type safeBuffer struct {
+	buf bytes.Buffer
+	mu  sync.Mutex
+}
+
+func (b *safeBuffer) Write(p []byte) (int, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.buf.Write(p)
+}
+
+func (b *safeBuffer) Bytes() []byte {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return append([]byte(nil), b.buf.Bytes()...)
+}
+
+// NewExecutor creates a process executor, baseDir is used to keep compiled
+// assets of running instances
+func NewExecutor(compiler Compiler, commandFn CommandFn, baseDir string) *Executor {
+	return &Executor{
+		compiler:   compiler,
+		commandFn:  commandFn,
+		baseDir:    baseDir,
+		executions: map[string]*execution{},
+		mu:         new(sync.Mutex),
+	}
+}
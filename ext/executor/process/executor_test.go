@@ -0,0 +1,244 @@
+package process_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/odpf/optimus/ext/executor/process"
+	"github.com/odpf/optimus/mock"
+	"github.com/odpf/optimus/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func shellCommand(script string) process.CommandFn {
+	return func(req models.ExecutorStartRequest, envs map[string]string, jobDir string) (string, []string, error) {
+		return "sh", []string{"-c", script}, nil
+	}
+}
+
+// waitForExit waits till process of execution exits and returns its stats
+func waitForExit(t *testing.T, executor *process.Executor, id string) *models.ExecutorStats {
+	finishChan, err := executor.WaitForFinish(context.Background(), id)
+	assert.Nil(t, err)
+	select {
+	case <-finishChan:
+	case <-time.After(time.Second * 5):
+		t.Fatal("execution did not finish")
+	}
+	stats, err := executor.Stats(context.Background(), id)
+	assert.Nil(t, err)
+	return stats
+}
+
+func TestExecutor(t *testing.T) {
+	ctx := context.Background()
+	namespaceSpec := models.NamespaceSpec{
+		Name:        "namespace",
+		ProjectSpec: models.ProjectSpec{Name: "project"},
+	}
+	jobSpec := models.JobSpec{Name: "job"}
+	jobRun := models.JobRun{
+		ID:          uuid.Must(uuid.NewRandom()),
+		Spec:        jobSpec,
+		ScheduledAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+	}
+	instanceSpec := models.InstanceSpec{
+		ID:   uuid.Must(uuid.NewRandom()),
+		Name: "bq2bq",
+		Type: models.InstanceTypeTask,
+	}
+	startRequest := models.ExecutorStartRequest{
+		ID:        instanceSpec.ID.String(),
+		Job:       jobSpec,
+		Namespace: namespaceSpec,
+		JobRun:    jobRun,
+		Instance:  instanceSpec,
+	}
+
+	t.Run("should execute process with compiled envs and files", func(t *testing.T) {
+		compiler := new(mock.RunService)
+		compiler.On("Compile", ctx, namespaceSpec, jobRun, instanceSpec).Return(
+			map[string]string{"DSTART": "2021-01-01"},
+			map[string]string{"query.sql": "select 1"}, nil)
+		defer compiler.AssertExpectations(t)
+
+		executor := process.NewExecutor(compiler,
+			shellCommand(`echo "$DSTART $INSTANCE_NAME $JOB_NAME"; cat $JOB_DIR/in/query.sql`),
+			t.TempDir())
+		_, err := executor.Start(ctx, startRequest)
+		assert.Nil(t, err)
+
+		finishChan, err := executor.WaitForFinish(ctx, startRequest.ID)
+		assert.Nil(t, err)
+		assert.Equal(t, 0, <-finishChan)
+
+		// logs are kept after exit till stats are read
+		stats, err := executor.Stats(ctx, startRequest.ID)
+		assert.Nil(t, err)
+		assert.Equal(t, models.RunStateSuccess.String(), stats.Status)
+		assert.Equal(t, "2021-01-01 bq2bq job\nselect 1", string(stats.Logs))
+
+		_, err = executor.Stats(ctx, startRequest.ID)
+		assert.Equal(t, process.ErrNoSuchExecution, err)
+	})
+	t.Run("should write env file which can be sourced by shell", func(t *testing.T) {
+		value := "it's $HOME; echo injected\nnext line"
+		compiler := new(mock.RunService)
+		compiler.On("Compile", ctx, namespaceSpec, jobRun, instanceSpec).Return(
+			map[string]string{"VALUE": value}, map[string]string{}, nil)
+		defer compiler.AssertExpectations(t)
+
+		executor := process.NewExecutor(compiler,
+			shellCommand(`VALUE=; . $JOB_DIR/in/`+models.InstanceDataTypeEnvFileName+`; printf '%s' "$VALUE"`),
+			t.TempDir())
+		_, err := executor.Start(ctx, startRequest)
+		assert.Nil(t, err)
+
+		stats := waitForExit(t, executor, startRequest.ID)
+		assert.Equal(t, models.RunStateSuccess.String(), stats.Status)
+		assert.Equal(t, value, string(stats.Logs))
+	})
+	t.Run("should not pass server environment to process", func(t *testing.T) {
+		os.Setenv("OPTIMUS_APP_KEY", "server-secret")
+		defer os.Unsetenv("OPTIMUS_APP_KEY")
+
+		compiler := new(mock.RunService)
+		compiler.On("Compile", ctx, namespaceSpec, jobRun, instanceSpec).Return(
+			map[string]string{"DSTART": "2021-01-01"}, map[string]string{}, nil)
+		defer compiler.AssertExpectations(t)
+
+		executor := process.NewExecutor(compiler,
+			shellCommand(`echo "$DSTART:$OPTIMUS_APP_KEY"; test -n "$PATH"`), t.TempDir())
+		_, err := executor.Start(ctx, startRequest)
+		assert.Nil(t, err)
+
+		stats := waitForExit(t, executor, startRequest.ID)
+		assert.Equal(t, models.RunStateSuccess.String(), stats.Status)
+		assert.Equal(t, "2021-01-01:\n", string(stats.Logs))
+	})
+	t.Run("should redact secrets of project from logs", func(t *testing.T) {
+		secretNamespaceSpec := namespaceSpec
+		secretNamespaceSpec.ProjectSpec.Secret = models.ProjectSecrets{
+			{
+				Name:  "DB_PASSWORD",
+				Value: "super-secret",
+			},
+		}
+		compiler := new(mock.RunService)
+		compiler.On("Compile", ctx, secretNamespaceSpec, jobRun, instanceSpec).Return(
+			map[string]string{"PASSWORD": "super-secret"}, map[string]string{}, nil)
+		defer compiler.AssertExpectations(t)
+
+		secretStartRequest := startRequest
+		secretStartRequest.Namespace = secretNamespaceSpec
+		executor := process.NewExecutor(compiler, shellCommand(`echo "connecting with $PASSWORD"`), t.TempDir())
+		_, err := executor.Start(ctx, secretStartRequest)
+		assert.Nil(t, err)
+
+		stats := waitForExit(t, executor, secretStartRequest.ID)
+		assert.Equal(t, "connecting with *redacted*\n", string(stats.Logs))
+	})
+	t.Run("should return exit code of failed process and remove compiled assets", func(t *testing.T) {
+		compiler := new(mock.RunService)
+		compiler.On("Compile", ctx, namespaceSpec, jobRun, instanceSpec).Return(
+			map[string]string{}, map[string]string{"query.sql": "select 1"}, nil)
+		defer compiler.AssertExpectations(t)
+
+		baseDir := t.TempDir()
+		executor := process.NewExecutor(compiler, shellCommand("echo failed >&2; exit 3"), baseDir)
+		_, err := executor.Start(ctx, startRequest)
+		assert.Nil(t, err)
+
+		finishChan, err := executor.WaitForFinish(ctx, startRequest.ID)
+		assert.Nil(t, err)
+		assert.Equal(t, 3, <-finishChan)
+
+		stats, err := executor.Stats(ctx, startRequest.ID)
+		assert.Nil(t, err)
+		assert.Equal(t, models.RunStateFailed.String(), stats.Status)
+		assert.Equal(t, "failed\n", string(stats.Logs))
+
+		_, err = os.Stat(filepath.Join(baseDir, startRequest.ID))
+		assert.True(t, os.IsNotExist(err))
+	})
+	t.Run("should stop running process with provided signal", func(t *testing.T) {
+		compiler := new(mock.RunService)
+		compiler.On("Compile", ctx, namespaceSpec, jobRun, instanceSpec).Return(
+			map[string]string{}, map[string]string{}, nil)
+		defer compiler.AssertExpectations(t)
+
+		executor := process.NewExecutor(compiler, shellCommand("echo started; exec sleep 60"), t.TempDir())
+		_, err := executor.Start(ctx, startRequest)
+		assert.Nil(t, err)
+
+		finishChan, err := executor.WaitForFinish(ctx, startRequest.ID)
+		assert.Nil(t, err)
+
+		assert.Eventually(t, func() bool {
+			stats, err := executor.Stats(ctx, startRequest.ID)
+			return err == nil && string(stats.Logs) == "started\n"
+		}, time.Second*5, time.Millisecond*10)
+
+		err = executor.Stop(ctx, models.ExecutorStopRequest{ID: startRequest.ID, Signal: "SIGKILL"})
+		assert.Nil(t, err)
+		assert.NotEqual(t, 0, <-finishChan)
+
+		stats, err := executor.Stats(ctx, startRequest.ID)
+		assert.Nil(t, err)
+		assert.Equal(t, models.RunStateFailed.String(), stats.Status)
+		assert.Equal(t, "started\n", string(stats.Logs))
+	})
+	t.Run("should fail for unknown executions", func(t *testing.T) {
+		executor := process.NewExecutor(new(mock.RunService), shellCommand("true"), t.TempDir())
+		_, err := executor.WaitForFinish(ctx, "unknown")
+		assert.Equal(t, process.ErrNoSuchExecution, err)
+		err = executor.Stop(ctx, models.ExecutorStopRequest{ID: "unknown"})
+		assert.Equal(t, process.ErrNoSuchExecution, err)
+	})
+	t.Run("EntrypointCommand", func(t *testing.T) {
+		base := new(mock.BasePlugin)
+		base.On("PluginInfo").Return(&models.PluginInfoResponse{Name: "bq2bq", Image: "odpf/bq2bq:latest"}, nil)
+		req := startRequest
+		req.Job.Task.Unit = &models.Plugin{Base: base}
+
+		t.Run("should run entrypoint of plugin directly", func(t *testing.T) {
+			name, args, err := process.EntrypointCommand(map[string]string{"bq2bq": "/opt/bq2bq/run"}, nil)(
+				req, map[string]string{}, "/tmp/job")
+			assert.Nil(t, err)
+			assert.Equal(t, "/opt/bq2bq/run", name)
+			assert.Nil(t, args)
+		})
+		t.Run("should fail if plugin has no entrypoint and fallback is not set", func(t *testing.T) {
+			_, _, err := process.EntrypointCommand(map[string]string{}, nil)(req, map[string]string{}, "/tmp/job")
+			assert.NotNil(t, err)
+			assert.Contains(t, err.Error(), "no entrypoint configured for plugin bq2bq")
+		})
+		t.Run("should use fallback if plugin has no entrypoint", func(t *testing.T) {
+			name, _, err := process.EntrypointCommand(map[string]string{}, process.DockerCommand)(
+				req, map[string]string{}, "/tmp/job")
+			assert.Nil(t, err)
+			assert.Equal(t, "docker", name)
+		})
+	})
+	t.Run("DockerCommand", func(t *testing.T) {
+		t.Run("should run plugin image passing envs from process environment", func(t *testing.T) {
+			base := new(mock.BasePlugin)
+			base.On("PluginInfo").Return(&models.PluginInfoResponse{Name: "bq2bq", Image: "odpf/bq2bq:latest"}, nil)
+			req := startRequest
+			req.Job.Task.Unit = &models.Plugin{Base: base}
+
+			name, args, err := process.DockerCommand(req, map[string]string{
+				"DSTART": "2021", "DEND": "2022", process.JobDirEnv: "/tmp/job",
+			}, "/tmp/job")
+			assert.Nil(t, err)
+			assert.Equal(t, "docker", name)
+			assert.Equal(t, "run --rm -v /tmp/job:/data -e DEND -e DSTART -e JOB_DIR=/data odpf/bq2bq:latest",
+				strings.Join(args, " "))
+		})
+	})
+}
@@ -0,0 +1,391 @@
+package email
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"fmt"
+	htmltemplate "html/template"
+	"io"
+	"mime"
+	"mime/multipart"
+	"mime/quotedprintable"
+	"net"
+	"net/mail"
+	"net/smtp"
+	"net/textproto"
+	"strconv"
+	"sync"
+	texttemplate "text/template"
+	"time"
+
+	_ "embed"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+
+	"github.com/odpf/optimus/models"
+	"github.com/pkg/errors"
+)
+
+const (
+	// SMTPSecretName is the project secret holding the smtp server used for
+	// sending emails as json, e.g. {"host": "smtp.example.io", "port": 587,
+	// "username": "optimus", "password": "secret", "from": "optimus@example.io"}
+	SMTPSecretName            = "NOTIFY_EMAIL"
+	DefaultEventBatchInterval = time.Second * 30
+	DefaultSendTimeout        = time.Second * 30
+	MaxSLAEventsToProcess     = 6
+)
+
+var (
+	emailQueueCounter = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "notify_email_queue",
+		Help: "Items queued in email notification channel",
+	})
+	emailWorkerBatchCounter = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "notify_email_worker_batch",
+		Help: "Worker execution count in email notification channel",
+	})
+	emailWorkerSendErrCounter = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "notify_email_worker_send_err",
+		Help: "Failure of messages in email notification channel worker",
+	})
+)
+
+var (
+	//go:embed templates/message.html
+	htmlMessageTemplate string
+	//go:embed templates/message.txt
+	textMessageTemplate string
+
+	htmlMessage = htmltemplate.Must(htmltemplate.New("message.html").Parse(htmlMessageTemplate))
+	textMessage = texttemplate.Must(texttemplate.New("message.txt").Parse(textMessageTemplate))
+)
+
+var eventHeadings = map[models.JobEventType]string{
+	models.JobEventTypeStart:   "Started",
+	models.JobEventTypeSuccess: "Success",
+	models.JobEventTypeRetry:   "Retry",
+}
+
+type Notifier struct {
+	io.Closer
+
+	routeMsgBatch map[route][]event
+	wg            sync.WaitGroup
+	mu            sync.Mutex
+	workerErrChan chan error
+
+	eventBatchInterval time.Duration
+	sendTimeout        time.Duration
+}
+
+// smtpServer is the configuration of smtp server registered as project secret
+type smtpServer struct {
+	Host     string `json:"host"`
+	Port     int    `json:"port"`
+	Username string `json:"username"`
+	Password string `json:"password"`
+	From     string `json:"from"`
+}
+
+type route struct {
+	recipient string
+	server    smtpServer
+}
+
+// event is rendered in email templates
+type event struct {
+	Heading   string
+	Project   string
+	Namespace string
+	Job       string
+	Owner     string
+	Details   []detail
+	LogURL    string
+	JobURL    string
+}
+
+type detail struct {
+	Name  string
+	Value string
+}
+
+// Notify queues the event to be emailed to the address in route, events of
+// the same recipient are sent together in a single email
+func (s *Notifier) Notify(ctx context.Context, attr models.NotifyAttrs) error {
+	recipient, err := mail.ParseAddress(attr.Route)
+	if err != nil {
+		return errors.Wrapf(err, "invalid email route %s", attr.Route)
+	}
+	server, err := smtpServerOfProject(attr.Namespace.ProjectSpec.Secret)
+	if err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	rt := route{
+		recipient: recipient.Address,
+		server:    server,
+	}
+	s.routeMsgBatch[rt] = append(s.routeMsgBatch[rt], buildEvent(attr))
+	emailQueueCounter.Inc()
+	return nil
+}
+
+func smtpServerOfProject(secrets models.ProjectSecrets) (smtpServer, error) {
+	secret, ok := secrets.GetByName(SMTPSecretName)
+	if !ok {
+		return smtpServer{}, errors.Errorf("failed to find smtp server required for sending emails, please register %s secret", SMTPSecretName)
+	}
+	var server smtpServer
+	if err := json.Unmarshal([]byte(secret), &server); err != nil {
+		return smtpServer{}, errors.Wrapf(err, "failed to parse %s secret, it should be a json of smtp server", SMTPSecretName)
+	}
+	if server.Host == "" || server.Port == 0 || server.From == "" {
+		return smtpServer{}, errors.Errorf("host, port and from are required in %s secret", SMTPSecretName)
+	}
+	if _, err := mail.ParseAddress(server.From); err != nil {
+		return smtpServer{}, errors.Wrapf(err, "invalid from address in %s secret", SMTPSecretName)
+	}
+	return server, nil
+}
+
+func buildEvent(attr models.NotifyAttrs) event {
+	evt := event{
+		Heading:   string(attr.JobEvent.Type),
+		Project:   attr.Namespace.ProjectSpec.Name,
+		Namespace: attr.Namespace.Name,
+		Job:       attr.JobSpec.Name,
+		Owner:     attr.JobSpec.Owner,
+	}
+	stringValue := func(key string) string {
+		if val, ok := attr.JobEvent.Value[key]; ok {
+			return val.GetStringValue()
+		}
+		return ""
+	}
+	addDetail := func(name, value string) {
+		if value != "" {
+			evt.Details = append(evt.Details, detail{Name: name, Value: value})
+		}
+	}
+
+	switch attr.JobEvent.Type {
+	case models.JobEventTypeSLAMiss:
+		evt.Heading = "SLA Breached"
+		if slas, ok := attr.JobEvent.Value["slas"]; ok {
+			for slaIdx, sla := range slas.GetListValue().GetValues() {
+				if slaIdx >= MaxSLAEventsToProcess {
+					addDetail("Breached item", "Too many breaches. Truncating...")
+					break
+				}
+				slaFields := sla.GetStructValue().GetFields()
+				addDetail("Breached item", fmt.Sprintf("Task: %s, Scheduled at: %s",
+					slaFields["task_id"].GetStringValue(), slaFields["scheduled_at"].GetStringValue()))
+			}
+		}
+	case models.JobEventTypeFailure:
+		evt.Heading = "Failure"
+		addDetail("Scheduled At", stringValue("scheduled_at"))
+		addDetail("Duration", stringValue("duration"))
+		addDetail("Task ID", stringValue("task_id"))
+	default:
+		if heading, ok := eventHeadings[attr.JobEvent.Type]; ok {
+			evt.Heading = heading
+		}
+		addDetail("Scheduled At", stringValue("scheduled_at"))
+		addDetail("Task ID", stringValue("task_id"))
+		if attempt, ok := attr.JobEvent.Value["attempt"]; ok && attempt.GetNumberValue() > 0 {
+			addDetail("Attempt", strconv.FormatInt(int64(attempt.GetNumberValue()), 10))
+		}
+	}
+	addDetail("Exception", stringValue("exception"))
+	addDetail("Message", stringValue("message"))
+
+	evt.LogURL = stringValue("log_url")
+	evt.JobURL = stringValue("job_url")
+	return evt
+}
+
+func subjectOfEvents(events []event) string {
+	if len(events) == 1 {
+		evt := events[0]
+		return fmt.Sprintf("[Optimus] %s | %s/%s | %s", evt.Heading, evt.Project, evt.Namespace, evt.Job)
+	}
+	return fmt.Sprintf("[Optimus] %d job events", len(events))
+}
+
+// buildMessage renders events as a multipart email having both text and html
+// versions of the message
+func buildMessage(from, recipient string, events []event) ([]byte, error) {
+	var body bytes.Buffer
+	writer := multipart.NewWriter(&body)
+	for _, part := range []struct {
+		contentType string
+		render      func(io.Writer) error
+	}{
+		{
+			contentType: "text/plain; charset=utf-8",
+			render: func(w io.Writer) error {
+				return textMessage.Execute(w, events)
+			},
+		},
+		{
+			contentType: "text/html; charset=utf-8",
+			render: func(w io.Writer) error {
+				return htmlMessage.Execute(w, events)
+			},
+		},
+	} {
+		partWriter, err := writer.CreatePart(textproto.MIMEHeader{
+			"Content-Type":              {part.contentType},
+			"Content-Transfer-Encoding": {"quoted-printable"},
+		})
+		if err != nil {
+			return nil, err
+		}
+		encoder := quotedprintable.NewWriter(partWriter)
+		if err := part.render(encoder); err != nil {
+			return nil, errors.Wrap(err, "failed to render email")
+		}
+		if err := encoder.Close(); err != nil {
+			return nil, err
+		}
+	}
+	if err := writer.Close(); err != nil {
+		return nil, err
+	}
+
+	var msg bytes.Buffer
+	fmt.Fprintf(&msg, "From: %s\r\n", from)
+	fmt.Fprintf(&msg, "To: %s\r\n", recipient)
+	fmt.Fprintf(&msg, "Subject: %s\r\n", mime.QEncoding.Encode("utf-8", subjectOfEvents(events)))
+	fmt.Fprintf(&msg, "Date: %s\r\n", time.Now().UTC().Format(time.RFC1123Z))
+	fmt.Fprintf(&msg, "MIME-Version: 1.0\r\n")
+	fmt.Fprintf(&msg, "Content-Type: multipart/alternative; boundary=%s\r\n", writer.Boundary())
+	fmt.Fprintf(&msg, "\r\n")
+	msg.Write(body.Bytes())
+	return msg.Bytes(), nil
+}
+
+// send delivers the message through smtp server, connection is upgraded to
+// tls if the server supports it
+func (s *Notifier) send(rt route, msg []byte) error {
+	addr := net.JoinHostPort(rt.server.Host, strconv.Itoa(rt.server.Port))
+	conn, err := net.DialTimeout("tcp", addr, s.sendTimeout)
+	if err != nil {
+		return err
+	}
+	if err := conn.SetDeadline(time.Now().Add(s.sendTimeout)); err != nil {
+		conn.Close()
+		return err
+	}
+	client, err := smtp.NewClient(conn, rt.server.Host)
+	if err != nil {
+		conn.Close()
+		return err
+	}
+	defer client.Close()
+
+	if ok, _ := client.Extension("STARTTLS"); ok {
+		if err := client.StartTLS(&tls.Config{ServerName: rt.server.Host}); err != nil {
+			return err
+		}
+	}
+	if rt.server.Username != "" {
+		if err := client.Auth(smtp.PlainAuth("", rt.server.Username, rt.server.Password, rt.server.Host)); err != nil {
+			return err
+		}
+	}
+	from, err := mail.ParseAddress(rt.server.From)
+	if err != nil {
+		return err
+	}
+	if err := client.Mail(from.Address); err != nil {
+		return err
+	}
+	if err := client.Rcpt(rt.recipient); err != nil {
+		return err
+	}
+	writer, err := client.Data()
+	if err != nil {
+		return err
+	}
+	if _, err := writer.Write(msg); err != nil {
+		return err
+	}
+	if err := writer.Close(); err != nil {
+		return err
+	}
+	return client.Quit()
+}
+
+func (s *Notifier) Worker(ctx context.Context) {
+	defer s.wg.Done()
+	for {
+		// send events in batches, queued events are flushed once more
+		// before exiting when context is done
+		select {
+		case <-ctx.Done():
+		case <-time.After(s.eventBatchInterval):
+		}
+
+		// take out queued batches so that events can be queued while sending
+		s.mu.Lock()
+		batches := s.routeMsgBatch
+		s.routeMsgBatch = map[route][]event{}
+		s.mu.Unlock()
+
+		for rt, events := range batches {
+			if len(events) == 0 {
+				continue
+			}
+			msg, err := buildMessage(rt.server.From, rt.recipient, events)
+			if err == nil {
+				err = s.send(rt, msg)
+			}
+			if err != nil {
+				// smtp server is not reported as it has credentials
+				s.workerErrChan <- errors.Wrapf(err, "Worker_SendMessage: %s: %d events", rt.recipient, len(events))
+			}
+		}
+		emailWorkerBatchCounter.Inc()
+
+		if ctx.Err() != nil {
+			close(s.workerErrChan)
+			return
+		}
+	}
+}
+
+func (s *Notifier) Close() error {
+	// drain batches
+	s.wg.Wait()
+	return nil
+}
+
+func NewNotifier(ctx context.Context, eventBatchInterval, sendTimeout time.Duration, errHandler func(error)) *Notifier {
+	this := &Notifier{
+		routeMsgBatch:      map[route][]event{},
+		workerErrChan:      make(chan error),
+		eventBatchInterval: eventBatchInterval,
+		sendTimeout:        sendTimeout,
+	}
+
+	this.wg.Add(1)
+	go func() {
+		for err := range this.workerErrChan {
+			errHandler(err)
+			emailWorkerSendErrCounter.Inc()
+		}
+		this.wg.Done()
+	}()
+
+	this.wg.Add(1)
+	go this.Worker(ctx)
+	return this
+}
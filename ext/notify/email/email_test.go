@@ -0,0 +1,344 @@
+package email
+
+import (
+	"bufio"
+	"context"
+	"encoding/base64"
+	"fmt"
+	"io/ioutil"
+	"mime"
+	"mime/multipart"
+	"net"
+	"net/mail"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/odpf/optimus/models"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+// smtpStandIn accepts emails like an smtp server and records them
+type smtpStandIn struct {
+	listener net.Listener
+	password string
+
+	mu       sync.Mutex
+	auths    []string
+	mails    []delivery
+	shutdown sync.WaitGroup
+}
+
+type delivery struct {
+	from string
+	to   []string
+	data string
+}
+
+func newSMTPStandIn(t *testing.T, password string) *smtpStandIn {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	s := &smtpStandIn{listener: listener, password: password}
+	s.shutdown.Add(1)
+	go func() {
+		defer s.shutdown.Done()
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			s.serve(conn)
+		}
+	}()
+	return s
+}
+
+func (s *smtpStandIn) port() int {
+	return s.listener.Addr().(*net.TCPAddr).Port
+}
+
+func (s *smtpStandIn) Close() {
+	s.listener.Close()
+	s.shutdown.Wait()
+}
+
+func (s *smtpStandIn) serve(conn net.Conn) {
+	defer conn.Close()
+	reader := bufio.NewReader(conn)
+	reply := func(line string) {
+		fmt.Fprintf(conn, "%s\r\n", line)
+	}
+
+	var current delivery
+	reply("220 localhost ESMTP")
+	for {
+		line, err := reader.ReadString('\n')
+		if err != nil {
+			return
+		}
+		line = strings.TrimRight(line, "\r\n")
+		cmd := strings.ToUpper(strings.SplitN(line, " ", 2)[0])
+		switch cmd {
+		case "EHLO", "HELO":
+			reply("250-localhost")
+			reply("250 AUTH PLAIN")
+		case "AUTH":
+			fields := strings.Fields(line)
+			decoded, _ := base64.StdEncoding.DecodeString(fields[len(fields)-1])
+			credentials := strings.Split(string(decoded), "\x00")
+			s.mu.Lock()
+			s.auths = append(s.auths, credentials[1])
+			s.mu.Unlock()
+			if credentials[2] != s.password {
+				reply("535 5.7.8 Authentication credentials invalid")
+				continue
+			}
+			reply("235 2.7.0 Authentication successful")
+		case "MAIL":
+			current = delivery{from: strings.Trim(strings.TrimPrefix(line, "MAIL FROM:"), "<>")}
+			reply("250 OK")
+		case "RCPT":
+			current.to = append(current.to, strings.Trim(strings.TrimPrefix(line, "RCPT TO:"), "<>"))
+			reply("250 OK")
+		case "DATA":
+			reply("354 End data with <CR><LF>.<CR><LF>")
+			var data strings.Builder
+			for {
+				dataLine, err := reader.ReadString('\n')
+				if err != nil {
+					return
+				}
+				if dataLine == ".\r\n" {
+					break
+				}
+				data.WriteString(strings.TrimPrefix(dataLine, "."))
+			}
+			current.data = data.String()
+			s.mu.Lock()
+			s.mails = append(s.mails, current)
+			s.mu.Unlock()
+			reply("250 OK")
+		case "QUIT":
+			reply("221 Bye")
+			return
+		default:
+			reply("250 OK")
+		}
+	}
+}
+
+// readMessage parses the email and returns its subject with the body of
+// each of its parts by content type
+func readMessage(t *testing.T, data string) (string, map[string]string) {
+	msg, err := mail.ReadMessage(strings.NewReader(data))
+	if err != nil {
+		t.Fatal(err)
+	}
+	subject, err := new(mime.WordDecoder).DecodeHeader(msg.Header.Get("Subject"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, params, err := mime.ParseMediaType(msg.Header.Get("Content-Type"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	parts := map[string]string{}
+	reader := multipart.NewReader(msg.Body, params["boundary"])
+	for {
+		part, err := reader.NextPart()
+		if err != nil {
+			break
+		}
+		body, _ := ioutil.ReadAll(part)
+		mediaType, _, _ := mime.ParseMediaType(part.Header.Get("Content-Type"))
+		parts[mediaType] = string(body)
+	}
+	return subject, parts
+}
+
+func TestEmail(t *testing.T) {
+	namespaceOfServer := func(standIn *smtpStandIn) models.NamespaceSpec {
+		return models.NamespaceSpec{
+			Name: "test",
+			ProjectSpec: models.ProjectSpec{
+				Name: "foo",
+				Secret: models.ProjectSecrets{
+					{
+						Name: SMTPSecretName,
+						Value: fmt.Sprintf(`{"host": "127.0.0.1", "port": %d, "username": "optimus", "password": "secret", "from": "Optimus <optimus@example.io>"}`,
+							standIn.port()),
+					},
+				},
+			},
+		}
+	}
+	failureEvent := models.JobEvent{
+		Type: models.JobEventTypeFailure,
+		Value: map[string]*structpb.Value{
+			"task_id":      structpb.NewStringValue("bq2bq"),
+			"scheduled_at": structpb.NewStringValue("2021-10-01T00:00:00Z"),
+			"log_url":      structpb.NewStringValue("http://airflow.example.io/log?task_id=bq2bq&run=1"),
+			"exception":    structpb.NewStringValue("<table> not found"),
+		},
+	}
+
+	t.Run("should email failure with text and html versions of the message", func(t *testing.T) {
+		standIn := newSMTPStandIn(t, "secret")
+		defer standIn.Close()
+
+		ctx, cancel := context.WithCancel(context.Background())
+		var sendErrors []error
+		client := NewNotifier(ctx, time.Millisecond*500, time.Second*5, func(err error) {
+			sendErrors = append(sendErrors, err)
+		})
+		err := client.Notify(context.Background(), models.NotifyAttrs{
+			Namespace: namespaceOfServer(standIn),
+			JobSpec: models.JobSpec{
+				Name:  "foo-job",
+				Owner: "optimus@test.com",
+			},
+			JobEvent: failureEvent,
+			Route:    "data-team@example.io",
+		})
+		assert.Nil(t, err)
+		cancel()
+		assert.Nil(t, client.Close())
+		assert.Nil(t, sendErrors)
+
+		assert.Equal(t, []string{"optimus"}, standIn.auths)
+		assert.Equal(t, 1, len(standIn.mails))
+		delivered := standIn.mails[0]
+		assert.Equal(t, "optimus@example.io", delivered.from)
+		assert.Equal(t, []string{"data-team@example.io"}, delivered.to)
+
+		subject, parts := readMessage(t, delivered.data)
+		assert.Equal(t, "[Optimus] Failure | foo/test | foo-job", subject)
+		assert.Contains(t, parts["text/plain"], "[Job] Failure | foo/test")
+		assert.Contains(t, parts["text/plain"], "Owner: optimus@test.com")
+		assert.Contains(t, parts["text/plain"], "Task ID: bq2bq")
+		assert.Contains(t, parts["text/plain"], "Exception: <table> not found")
+		assert.Contains(t, parts["text/plain"], "View log: http://airflow.example.io/log?task_id=bq2bq&run=1")
+		assert.Contains(t, parts["text/html"], "<b>Scheduled At</b></td><td>2021-10-01T00:00:00Z</td>")
+		assert.Contains(t, parts["text/html"], "&lt;table&gt; not found")
+		assert.Contains(t, parts["text/html"], `<a href="http://airflow.example.io/log?task_id=bq2bq&amp;run=1">View log</a>`)
+	})
+	t.Run("should send events of a recipient together in a single email", func(t *testing.T) {
+		standIn := newSMTPStandIn(t, "secret")
+		defer standIn.Close()
+
+		ctx, cancel := context.WithCancel(context.Background())
+		var sendErrors []error
+		client := NewNotifier(ctx, time.Millisecond*500, time.Second*5, func(err error) {
+			sendErrors = append(sendErrors, err)
+		})
+		slaMissEvent := models.JobEvent{
+			Type: models.JobEventTypeSLAMiss,
+			Value: map[string]*structpb.Value{
+				"slas": structpb.NewListValue(&structpb.ListValue{Values: []*structpb.Value{
+					structpb.NewStructValue(&structpb.Struct{Fields: map[string]*structpb.Value{
+						"task_id":      structpb.NewStringValue("bq2bq"),
+						"scheduled_at": structpb.NewStringValue("2021-10-02T00:00:00Z"),
+					}}),
+				}}),
+			},
+		}
+		for _, attr := range []struct {
+			route string
+			event models.JobEvent
+		}{
+			{route: "data-team@example.io", event: failureEvent},
+			{route: "Data Team <data-team@example.io>", event: slaMissEvent},
+			{route: "oncall@example.io", event: failureEvent},
+		} {
+			err := client.Notify(context.Background(), models.NotifyAttrs{
+				Namespace: namespaceOfServer(standIn),
+				JobSpec:   models.JobSpec{Name: "foo-job"},
+				JobEvent:  attr.event,
+				Route:     attr.route,
+			})
+			assert.Nil(t, err)
+		}
+		cancel()
+		assert.Nil(t, client.Close())
+		assert.Nil(t, sendErrors)
+
+		assert.Equal(t, 2, len(standIn.mails))
+		for _, delivered := range standIn.mails {
+			subject, parts := readMessage(t, delivered.data)
+			switch delivered.to[0] {
+			case "data-team@example.io":
+				assert.Equal(t, "[Optimus] 2 job events", subject)
+				assert.Contains(t, parts["text/plain"], "[Job] Failure | foo/test")
+				assert.Contains(t, parts["text/plain"], "[Job] SLA Breached | foo/test")
+				assert.Contains(t, parts["text/plain"], "Breached item: Task: bq2bq, Scheduled at: 2021-10-02T00:00:00Z")
+			case "oncall@example.io":
+				assert.Equal(t, "[Optimus] Failure | foo/test | foo-job", subject)
+			default:
+				t.Errorf("unexpected recipient %s", delivered.to[0])
+			}
+		}
+	})
+	t.Run("should report error if smtp server rejects credentials", func(t *testing.T) {
+		standIn := newSMTPStandIn(t, "another-secret")
+		defer standIn.Close()
+
+		ctx, cancel := context.WithCancel(context.Background())
+		var sendErrors []error
+		client := NewNotifier(ctx, time.Millisecond*500, time.Second*5, func(err error) {
+			sendErrors = append(sendErrors, err)
+		})
+		err := client.Notify(context.Background(), models.NotifyAttrs{
+			Namespace: namespaceOfServer(standIn),
+			JobSpec:   models.JobSpec{Name: "foo-job"},
+			JobEvent:  failureEvent,
+			Route:     "data-team@example.io",
+		})
+		assert.Nil(t, err)
+		cancel()
+		assert.Nil(t, client.Close())
+
+		assert.Equal(t, 0, len(standIn.mails))
+		assert.Equal(t, 1, len(sendErrors))
+		assert.Contains(t, sendErrors[0].Error(), "data-team@example.io")
+		assert.Contains(t, sendErrors[0].Error(), "535")
+		assert.NotContains(t, sendErrors[0].Error(), "secret")
+	})
+	t.Run("should fail to queue event if smtp server is not registered", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		client := NewNotifier(ctx, time.Millisecond*500, time.Second*5, func(err error) {})
+		defer client.Close()
+		defer cancel()
+
+		err := client.Notify(context.Background(), models.NotifyAttrs{
+			Namespace: models.NamespaceSpec{
+				Name:        "test",
+				ProjectSpec: models.ProjectSpec{Name: "foo"},
+			},
+			JobSpec:  models.JobSpec{Name: "foo-job"},
+			JobEvent: failureEvent,
+			Route:    "data-team@example.io",
+		})
+		assert.NotNil(t, err)
+		assert.Contains(t, err.Error(), SMTPSecretName)
+	})
+	t.Run("should fail to queue event if route is not an email address", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		client := NewNotifier(ctx, time.Millisecond*500, time.Second*5, func(err error) {})
+		defer client.Close()
+		defer cancel()
+
+		err := client.Notify(context.Background(), models.NotifyAttrs{
+			Namespace: models.NamespaceSpec{Name: "test"},
+			JobSpec:   models.JobSpec{Name: "foo-job"},
+			JobEvent:  failureEvent,
+			Route:     "#data-team",
+		})
+		assert.NotNil(t, err)
+		assert.Contains(t, err.Error(), "#data-team")
+	})
+}
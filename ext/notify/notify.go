@@ -1,3 +1,8 @@
 package notify
 
-import _ "github.com/odpf/optimus/ext/notify/slack"
+import (
+	_ "github.com/odpf/optimus/ext/notify/email"
+	_ "github.com/odpf/optimus/ext/notify/pagerduty"
+	_ "github.com/odpf/optimus/ext/notify/slack"
+	_ "github.com/odpf/optimus/ext/notify/webhook"
+)
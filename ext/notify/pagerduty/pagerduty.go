@@ -0,0 +1,349 @@
+package pagerduty
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+
+	"github.com/odpf/optimus/models"
+	"github.com/odpf/optimus/store"
+	"github.com/pkg/errors"
+)
+
+const (
+	// RoutingKeySecretName is the project secret holding integration keys of
+	// services as json, e.g. {"data-platform": "integration-key"}
+	RoutingKeySecretName      = "NOTIFY_PAGERDUTY"
+	DefaultEventsURL          = "https://events.pagerduty.com/v2/enqueue"
+	DefaultEventBatchInterval = time.Second * 5
+	DefaultRequestTimeout     = time.Second * 30
+	DefaultRetryBackoff       = time.Second * 2
+	MaxSendAttempts           = 3
+
+	// events of job looked up to find its failed runs when it succeeds
+	maxResolveLookupEvents = 200
+
+	eventActionTrigger = "trigger"
+	eventActionResolve = "resolve"
+	eventSource        = "optimus"
+)
+
+var (
+	pagerdutyQueueCounter = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "notify_pagerduty_queue",
+		Help: "Items queued in pagerduty notification channel",
+	})
+	pagerdutyWorkerBatchCounter = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "notify_pagerduty_worker_batch",
+		Help: "Worker execution count in pagerduty notification channel",
+	})
+	pagerdutyWorkerSendErrCounter = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "notify_pagerduty_worker_send_err",
+		Help: "Failure of messages in pagerduty notification channel worker",
+	})
+)
+
+// Notifier triggers an incident in a pagerduty service for every failed run of
+// a job and resolves incidents of the failed runs once the job succeeds. Failed
+// runs are found in the events registered for the job, so incidents triggered
+// before a restart are resolved as well.
+type Notifier struct {
+	io.Closer
+
+	client        *http.Client
+	eventsURL     string
+	eventRepo     store.JobEventRepository
+	queue         []event
+	wg            sync.WaitGroup
+	mu            sync.Mutex
+	workerErrChan chan error
+
+	eventBatchInterval time.Duration
+	retryBackoff       time.Duration
+}
+
+// event follows pagerduty events api v2
+type event struct {
+	RoutingKey  string   `json:"routing_key"`
+	EventAction string   `json:"event_action"`
+	DedupKey    string   `json:"dedup_key"`
+	Payload     *payload `json:"payload,omitempty"`
+	Links       []link   `json:"links,omitempty"`
+}
+
+type payload struct {
+	Summary       string                 `json:"summary"`
+	Source        string                 `json:"source"`
+	Severity      string                 `json:"severity"`
+	Component     string                 `json:"component,omitempty"`
+	Group         string                 `json:"group,omitempty"`
+	Class         string                 `json:"class,omitempty"`
+	CustomDetails map[string]interface{} `json:"custom_details,omitempty"`
+}
+
+type link struct {
+	Href string `json:"href"`
+	Text string `json:"text"`
+}
+
+// Notify triggers an incident in pagerduty service named by route, pagerduty
+// groups repeated events of the same run of a job in one incident. Success of
+// job resolves its incidents.
+func (s *Notifier) Notify(ctx context.Context, attr models.NotifyAttrs) error {
+	if attr.JobEvent.Type == models.JobEventTypeSuccess {
+		return s.Resolve(ctx, attr)
+	}
+	routingKey, err := routingKeyOfService(attr.Namespace.ProjectSpec.Secret, attr.Route)
+	if err != nil {
+		return err
+	}
+	projectName := attr.Namespace.ProjectSpec.Name
+	s.enqueue(triggerEvent(routingKey, dedupKeyOfEvent(projectName, attr.JobSpec.Name, attr.JobEvent), attr))
+	return nil
+}
+
+// Resolve resolves incidents of the run of event and of the runs which failed
+// since the previous success of job in pagerduty service named by route,
+// pagerduty ignores runs without an open incident
+func (s *Notifier) Resolve(ctx context.Context, attr models.NotifyAttrs) error {
+	routingKey, err := routingKeyOfService(attr.Namespace.ProjectSpec.Secret, attr.Route)
+	if err != nil {
+		return err
+	}
+	records, err := s.eventRepo.List(ctx, attr.Namespace, attr.JobSpec.Name, models.JobEventFilter{
+		Limit: maxResolveLookupEvents,
+	})
+	if err != nil {
+		return errors.Wrapf(err, "failed to find failed runs of job %s", attr.JobSpec.Name)
+	}
+
+	projectName := attr.Namespace.ProjectSpec.Name
+	runKey := dedupKeyOfEvent(projectName, attr.JobSpec.Name, attr.JobEvent)
+	dedupKeys := []string{runKey}
+	seen := map[string]bool{runKey: true}
+	// events are latest first, incidents of runs before the previous
+	// success are already resolved by it
+	for _, record := range records {
+		dedupKey := dedupKeyOfEvent(projectName, attr.JobSpec.Name, record.Event)
+		if record.Event.Type == models.JobEventTypeSuccess {
+			if dedupKey == runKey {
+				continue
+			}
+			break
+		}
+		if !seen[dedupKey] {
+			seen[dedupKey] = true
+			dedupKeys = append(dedupKeys, dedupKey)
+		}
+	}
+	for _, dedupKey := range dedupKeys {
+		s.enqueue(event{
+			RoutingKey:  routingKey,
+			EventAction: eventActionResolve,
+			DedupKey:    dedupKey,
+		})
+	}
+	return nil
+}
+
+func (s *Notifier) enqueue(evt event) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.queue = append(s.queue, evt)
+	pagerdutyQueueCounter.Inc()
+}
+
+func routingKeyOfService(secrets models.ProjectSecrets, service string) (string, error) {
+	secret, ok := secrets.GetByName(RoutingKeySecretName)
+	if !ok {
+		return "", errors.Errorf("failed to find integration keys of pagerduty services, please register %s secret", RoutingKeySecretName)
+	}
+	var routingKeys map[string]string
+	if err := json.Unmarshal([]byte(secret), &routingKeys); err != nil {
+		return "", errors.Wrapf(err, "failed to parse %s secret, it should be a json of integration keys by service", RoutingKeySecretName)
+	}
+	routingKey, ok := routingKeys[service]
+	if !ok || routingKey == "" {
+		return "", errors.Errorf("failed to find integration key of pagerduty service %s in %s secret", service, RoutingKeySecretName)
+	}
+	return routingKey, nil
+}
+
+// dedupKeyOfEvent identifies the run of job event belongs to, so that all
+// the events of a run are grouped in a single incident
+func dedupKeyOfEvent(projectName, jobName string, evt models.JobEvent) string {
+	var scheduledAt string
+	if val, ok := evt.Value["scheduled_at"]; ok {
+		scheduledAt = val.GetStringValue()
+	}
+	if slas, ok := evt.Value["slas"]; ok && scheduledAt == "" {
+		for _, sla := range slas.GetListValue().GetValues() {
+			if val, ok := sla.GetStructValue().GetFields()["scheduled_at"]; ok {
+				scheduledAt = val.GetStringValue()
+				break
+			}
+		}
+	}
+	return fmt.Sprintf("%s/%s/%s/%s", eventSource, projectName, jobName, scheduledAt)
+}
+
+func triggerEvent(routingKey, dedupKey string, attr models.NotifyAttrs) event {
+	severity, heading := "info", string(attr.JobEvent.Type)
+	switch attr.JobEvent.Type {
+	case models.JobEventTypeFailure:
+		severity, heading = "error", "Failure"
+	case models.JobEventTypeSLAMiss:
+		severity, heading = "warning", "SLA Breached"
+	case models.JobEventTypeRetry:
+		severity, heading = "warning", "Retry"
+	case models.JobEventTypeStart:
+		heading = "Started"
+	}
+
+	details := map[string]interface{}{
+		"project":   attr.Namespace.ProjectSpec.Name,
+		"namespace": attr.Namespace.Name,
+		"job":       attr.JobSpec.Name,
+		"owner":     attr.JobSpec.Owner,
+	}
+	for key, val := range attr.JobEvent.Value {
+		if _, ok := details[key]; !ok {
+			details[key] = val.AsInterface()
+		}
+	}
+
+	var links []link
+	if logURL, ok := attr.JobEvent.Value["log_url"]; ok && logURL.GetStringValue() != "" {
+		links = append(links, link{Href: logURL.GetStringValue(), Text: "View log"})
+	}
+	if jobURL, ok := attr.JobEvent.Value["job_url"]; ok && jobURL.GetStringValue() != "" {
+		links = append(links, link{Href: jobURL.GetStringValue(), Text: "View job"})
+	}
+
+	return event{
+		RoutingKey:  routingKey,
+		EventAction: eventActionTrigger,
+		DedupKey:    dedupKey,
+		Payload: &payload{
+			Summary: fmt.Sprintf("[Job] %s | %s/%s | %s", heading, attr.Namespace.ProjectSpec.Name,
+				attr.Namespace.Name, attr.JobSpec.Name),
+			Source:        eventSource,
+			Severity:      severity,
+			Component:     attr.JobSpec.Name,
+			Group:         fmt.Sprintf("%s/%s", attr.Namespace.ProjectSpec.Name, attr.Namespace.Name),
+			Class:         string(attr.JobEvent.Type),
+			CustomDetails: details,
+		},
+		Links: links,
+	}
+}
+
+// send enqueues event in pagerduty, failed requests are retried with
+// exponential backoff unless pagerduty rejects the event
+func (s *Notifier) send(evt event) error {
+	body, err := json.Marshal(evt)
+	if err != nil {
+		return err
+	}
+
+	backoff := s.retryBackoff
+	for attempt := 1; ; attempt++ {
+		retryable, err := s.post(body)
+		if err == nil {
+			return nil
+		}
+		if !retryable || attempt == MaxSendAttempts {
+			return err
+		}
+		time.Sleep(backoff)
+		backoff *= 2
+	}
+}
+
+// post returns if the request can be retried when it fails
+func (s *Notifier) post(body []byte) (bool, error) {
+	resp, err := s.client.Post(s.eventsURL, "application/json", bytes.NewReader(body))
+	if err != nil {
+		return true, err
+	}
+	defer resp.Body.Close()
+	respBody, _ := ioutil.ReadAll(resp.Body)
+
+	if resp.StatusCode >= http.StatusOK && resp.StatusCode < http.StatusMultipleChoices {
+		return false, nil
+	}
+	retryable := resp.StatusCode >= http.StatusInternalServerError || resp.StatusCode == http.StatusTooManyRequests
+	return retryable, fmt.Errorf("pagerduty responded with status %s: %s", resp.Status, string(respBody))
+}
+
+func (s *Notifier) Worker(ctx context.Context) {
+	defer s.wg.Done()
+	for {
+		// send events in batches, queued events are flushed once more
+		// before exiting when context is done
+		select {
+		case <-ctx.Done():
+		case <-time.After(s.eventBatchInterval):
+		}
+
+		// events are sent in the order they were queued so that an incident
+		// is never resolved before it is triggered
+		s.mu.Lock()
+		events := s.queue
+		s.queue = nil
+		s.mu.Unlock()
+
+		for _, evt := range events {
+			if err := s.send(evt); err != nil {
+				// routing key is not reported as it is a credential
+				s.workerErrChan <- errors.Wrapf(err, "Worker_SendEvent: %s %s", evt.EventAction, evt.DedupKey)
+			}
+		}
+		pagerdutyWorkerBatchCounter.Inc()
+
+		if ctx.Err() != nil {
+			close(s.workerErrChan)
+			return
+		}
+	}
+}
+
+func (s *Notifier) Close() error {
+	// drain batches
+	s.wg.Wait()
+	return nil
+}
+
+func NewNotifier(ctx context.Context, client *http.Client, eventsURL string, eventRepo store.JobEventRepository,
+	eventBatchInterval, retryBackoff time.Duration, errHandler func(error)) *Notifier {
+	this := &Notifier{
+		client:             client,
+		eventsURL:          eventsURL,
+		eventRepo:          eventRepo,
+		workerErrChan:      make(chan error),
+		eventBatchInterval: eventBatchInterval,
+		retryBackoff:       retryBackoff,
+	}
+
+	this.wg.Add(1)
+	go func() {
+		for err := range this.workerErrChan {
+			errHandler(err)
+			pagerdutyWorkerSendErrCounter.Inc()
+		}
+		this.wg.Done()
+	}()
+
+	this.wg.Add(1)
+	go this.Worker(ctx)
+	return this
+}
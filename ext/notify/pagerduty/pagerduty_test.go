@@ -0,0 +1,313 @@
+package pagerduty
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/odpf/optimus/mock"
+	"github.com/odpf/optimus/models"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+// eventsStandIn accepts events like pagerduty events api and records them
+type eventsStandIn struct {
+	mu         sync.Mutex
+	events     []event
+	statusCode []int
+}
+
+func (e *eventsStandIn) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	body, _ := ioutil.ReadAll(r.Body)
+	var evt event
+	if err := json.Unmarshal(body, &evt); err != nil {
+		rw.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.events = append(e.events, evt)
+	code := http.StatusAccepted
+	if len(e.statusCode) > 0 {
+		code, e.statusCode = e.statusCode[0], e.statusCode[1:]
+	}
+	rw.WriteHeader(code)
+	rw.Write([]byte(`{"status":"success","message":"Event processed"}`))
+}
+
+func TestPagerDuty(t *testing.T) {
+	namespaceSpec := models.NamespaceSpec{
+		Name: "test",
+		ProjectSpec: models.ProjectSpec{
+			Name: "foo",
+			Secret: models.ProjectSecrets{
+				{
+					Name:  RoutingKeySecretName,
+					Value: `{"data-platform": "test-routing-key"}`,
+				},
+			},
+		},
+	}
+	jobSpec := models.JobSpec{
+		Name:  "foo-job",
+		Owner: "optimus@test.com",
+	}
+	failureOfRun := func(scheduledAt string) models.JobEvent {
+		return models.JobEvent{
+			Type: models.JobEventTypeFailure,
+			Value: map[string]*structpb.Value{
+				"task_id":      structpb.NewStringValue("bq2bq"),
+				"scheduled_at": structpb.NewStringValue(scheduledAt),
+				"log_url":      structpb.NewStringValue("http://airflow.example.io/log"),
+			},
+		}
+	}
+
+	successOfRun := func(scheduledAt string) models.JobEvent {
+		return models.JobEvent{
+			Type: models.JobEventTypeSuccess,
+			Value: map[string]*structpb.Value{
+				"scheduled_at": structpb.NewStringValue(scheduledAt),
+			},
+		}
+	}
+	recordOf := func(evt models.JobEvent) models.JobEventRecord {
+		return models.JobEventRecord{Namespace: namespaceSpec, JobName: jobSpec.Name, Event: evt}
+	}
+	resolveLookup := models.JobEventFilter{Limit: maxResolveLookupEvents}
+
+	t.Run("should trigger incident for failure of each run of job", func(t *testing.T) {
+		eventRepo := new(mock.JobEventRepository)
+		standIn := &eventsStandIn{}
+		server := httptest.NewServer(standIn)
+		defer server.Close()
+
+		ctx, cancel := context.WithCancel(context.Background())
+		var sendErrors []error
+		client := NewNotifier(ctx, server.Client(), server.URL, eventRepo, time.Millisecond*500, time.Millisecond, func(err error) {
+			sendErrors = append(sendErrors, err)
+		})
+		for _, scheduledAt := range []string{"2021-10-01T00:00:00Z", "2021-10-02T00:00:00Z"} {
+			err := client.Notify(context.Background(), models.NotifyAttrs{
+				Namespace: namespaceSpec,
+				JobSpec:   jobSpec,
+				JobEvent:  failureOfRun(scheduledAt),
+				Route:     "data-platform",
+			})
+			assert.Nil(t, err)
+		}
+		cancel()
+		assert.Nil(t, client.Close())
+		assert.Nil(t, sendErrors)
+
+		assert.Equal(t, 2, len(standIn.events))
+		for _, evt := range standIn.events {
+			assert.Equal(t, "trigger", evt.EventAction)
+		}
+		assert.Equal(t, "optimus/foo/foo-job/2021-10-01T00:00:00Z", standIn.events[0].DedupKey)
+		assert.Equal(t, "optimus/foo/foo-job/2021-10-02T00:00:00Z", standIn.events[1].DedupKey)
+		evt := standIn.events[0]
+		assert.Equal(t, "test-routing-key", evt.RoutingKey)
+		assert.Equal(t, "2021-10-01T00:00:00Z", evt.Payload.CustomDetails["scheduled_at"])
+		assert.Equal(t, "[Job] Failure | foo/test | foo-job", evt.Payload.Summary)
+		assert.Equal(t, "error", evt.Payload.Severity)
+		assert.Equal(t, "optimus@test.com", evt.Payload.CustomDetails["owner"])
+		assert.Equal(t, "bq2bq", evt.Payload.CustomDetails["task_id"])
+		assert.Equal(t, []link{{Href: "http://airflow.example.io/log", Text: "View log"}}, evt.Links)
+	})
+	t.Run("should resolve incidents of runs failed since previous success of job", func(t *testing.T) {
+		success := successOfRun("2021-10-03T00:00:00Z")
+		eventRepo := new(mock.JobEventRepository)
+		eventRepo.On("List", context.Background(), namespaceSpec, jobSpec.Name, resolveLookup).Return([]models.JobEventRecord{
+			recordOf(success),
+			recordOf(failureOfRun("2021-10-02T00:00:00Z")),
+			recordOf(failureOfRun("2021-10-01T00:00:00Z")),
+			recordOf(failureOfRun("2021-10-01T00:00:00Z")),
+			recordOf(successOfRun("2021-09-30T00:00:00Z")),
+			recordOf(failureOfRun("2021-09-29T00:00:00Z")),
+		}, nil)
+		defer eventRepo.AssertExpectations(t)
+		standIn := &eventsStandIn{}
+		server := httptest.NewServer(standIn)
+		defer server.Close()
+
+		ctx, cancel := context.WithCancel(context.Background())
+		var sendErrors []error
+		client := NewNotifier(ctx, server.Client(), server.URL, eventRepo, time.Millisecond*500, time.Millisecond, func(err error) {
+			sendErrors = append(sendErrors, err)
+		})
+		assert.Nil(t, client.Notify(context.Background(), models.NotifyAttrs{
+			Namespace: namespaceSpec,
+			JobSpec:   jobSpec,
+			JobEvent:  success,
+			Route:     "data-platform",
+		}))
+		cancel()
+		assert.Nil(t, client.Close())
+		assert.Nil(t, sendErrors)
+
+		var dedupKeys []string
+		for _, evt := range standIn.events {
+			assert.Equal(t, "resolve", evt.EventAction)
+			assert.Equal(t, "test-routing-key", evt.RoutingKey)
+			assert.Nil(t, evt.Payload)
+			dedupKeys = append(dedupKeys, evt.DedupKey)
+		}
+		assert.Equal(t, []string{
+			"optimus/foo/foo-job/2021-10-03T00:00:00Z",
+			"optimus/foo/foo-job/2021-10-02T00:00:00Z",
+			"optimus/foo/foo-job/2021-10-01T00:00:00Z",
+		}, dedupKeys)
+	})
+	t.Run("should resolve incident of run triggered before restart", func(t *testing.T) {
+		failure := failureOfRun("2021-10-01T00:00:00Z")
+		eventRepo := new(mock.JobEventRepository)
+		eventRepo.On("List", context.Background(), namespaceSpec, jobSpec.Name, resolveLookup).Return(
+			[]models.JobEventRecord{recordOf(failure)}, nil)
+		standIn := &eventsStandIn{}
+		server := httptest.NewServer(standIn)
+		defer server.Close()
+
+		success := models.NotifyAttrs{
+			Namespace: namespaceSpec,
+			JobSpec:   jobSpec,
+			JobEvent:  successOfRun("2021-10-02T00:00:00Z"),
+			Route:     "data-platform",
+		}
+		failureAttrs := success
+		failureAttrs.JobEvent = failure
+		for _, notify := range []func(*Notifier) error{
+			func(client *Notifier) error { return client.Notify(context.Background(), failureAttrs) },
+			func(client *Notifier) error { return client.Notify(context.Background(), success) },
+		} {
+			ctx, cancel := context.WithCancel(context.Background())
+			client := NewNotifier(ctx, server.Client(), server.URL, eventRepo, time.Millisecond*500, time.Millisecond, func(err error) {})
+			assert.Nil(t, notify(client))
+			cancel()
+			assert.Nil(t, client.Close())
+		}
+
+		assert.Equal(t, 3, len(standIn.events))
+		assert.Equal(t, "trigger", standIn.events[0].EventAction)
+		assert.Equal(t, "resolve", standIn.events[2].EventAction)
+		assert.Equal(t, standIn.events[0].DedupKey, standIn.events[2].DedupKey)
+	})
+	t.Run("should trigger incident again if run fails after being resolved", func(t *testing.T) {
+		eventRepo := new(mock.JobEventRepository)
+		eventRepo.On("List", context.Background(), namespaceSpec, jobSpec.Name, resolveLookup).Return(
+			[]models.JobEventRecord{}, nil)
+		standIn := &eventsStandIn{}
+		server := httptest.NewServer(standIn)
+		defer server.Close()
+
+		ctx, cancel := context.WithCancel(context.Background())
+		client := NewNotifier(ctx, server.Client(), server.URL, eventRepo, time.Millisecond*500, time.Millisecond, func(err error) {})
+		failureAttrs := models.NotifyAttrs{
+			Namespace: namespaceSpec,
+			JobSpec:   jobSpec,
+			JobEvent:  failureOfRun("2021-10-01T00:00:00Z"),
+			Route:     "data-platform",
+		}
+		assert.Nil(t, client.Notify(context.Background(), failureAttrs))
+		assert.Nil(t, client.Resolve(context.Background(), failureAttrs))
+		assert.Nil(t, client.Notify(context.Background(), failureAttrs))
+		cancel()
+		assert.Nil(t, client.Close())
+
+		var actions []string
+		for _, evt := range standIn.events {
+			actions = append(actions, evt.EventAction)
+		}
+		assert.Equal(t, []string{"trigger", "resolve", "trigger"}, actions)
+	})
+	t.Run("should fail to resolve if failed runs of job can't be found", func(t *testing.T) {
+		eventRepo := new(mock.JobEventRepository)
+		eventRepo.On("List", context.Background(), namespaceSpec, jobSpec.Name, resolveLookup).Return(
+			[]models.JobEventRecord{}, errors.New("connection refused"))
+		ctx, cancel := context.WithCancel(context.Background())
+		client := NewNotifier(ctx, http.DefaultClient, DefaultEventsURL, eventRepo, time.Millisecond*500, time.Millisecond, func(err error) {})
+		defer client.Close()
+		defer cancel()
+
+		err := client.Resolve(context.Background(), models.NotifyAttrs{
+			Namespace: namespaceSpec,
+			JobSpec:   jobSpec,
+			JobEvent:  successOfRun("2021-10-02T00:00:00Z"),
+			Route:     "data-platform",
+		})
+		assert.NotNil(t, err)
+		assert.Contains(t, err.Error(), "connection refused")
+	})
+	t.Run("should retry event if pagerduty fails temporarily", func(t *testing.T) {
+		eventRepo := new(mock.JobEventRepository)
+		standIn := &eventsStandIn{
+			statusCode: []int{http.StatusTooManyRequests, http.StatusInternalServerError},
+		}
+		server := httptest.NewServer(standIn)
+		defer server.Close()
+
+		ctx, cancel := context.WithCancel(context.Background())
+		var sendErrors []error
+		client := NewNotifier(ctx, server.Client(), server.URL, eventRepo, time.Millisecond*500, time.Millisecond, func(err error) {
+			sendErrors = append(sendErrors, err)
+		})
+		assert.Nil(t, client.Notify(context.Background(), models.NotifyAttrs{
+			Namespace: namespaceSpec,
+			JobSpec:   jobSpec,
+			JobEvent:  failureOfRun("2021-10-01T00:00:00Z"),
+			Route:     "data-platform",
+		}))
+		cancel()
+		assert.Nil(t, client.Close())
+		assert.Nil(t, sendErrors)
+		assert.Equal(t, MaxSendAttempts, len(standIn.events))
+	})
+	t.Run("should report error if pagerduty rejects event", func(t *testing.T) {
+		eventRepo := new(mock.JobEventRepository)
+		standIn := &eventsStandIn{
+			statusCode: []int{http.StatusBadRequest},
+		}
+		server := httptest.NewServer(standIn)
+		defer server.Close()
+
+		ctx, cancel := context.WithCancel(context.Background())
+		var sendErrors []error
+		client := NewNotifier(ctx, server.Client(), server.URL, eventRepo, time.Millisecond*500, time.Millisecond, func(err error) {
+			sendErrors = append(sendErrors, err)
+		})
+		assert.Nil(t, client.Notify(context.Background(), models.NotifyAttrs{
+			Namespace: namespaceSpec,
+			JobSpec:   jobSpec,
+			JobEvent:  failureOfRun("2021-10-01T00:00:00Z"),
+			Route:     "data-platform",
+		}))
+		cancel()
+		assert.Nil(t, client.Close())
+		assert.Equal(t, 1, len(standIn.events))
+		assert.Equal(t, 1, len(sendErrors))
+		assert.Contains(t, sendErrors[0].Error(), "400 Bad Request")
+	})
+	t.Run("should fail to notify if integration key of service is not registered", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		client := NewNotifier(ctx, http.DefaultClient, DefaultEventsURL, new(mock.JobEventRepository), time.Millisecond*500, time.Millisecond, func(err error) {})
+		defer client.Close()
+		defer cancel()
+
+		err := client.Notify(context.Background(), models.NotifyAttrs{
+			Namespace: namespaceSpec,
+			JobSpec:   jobSpec,
+			JobEvent:  failureOfRun("2021-10-01T00:00:00Z"),
+			Route:     "unknown-service",
+		})
+		assert.NotNil(t, err)
+		assert.Contains(t, err.Error(), "unknown-service")
+	})
+}
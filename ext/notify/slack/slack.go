@@ -194,6 +194,25 @@ func buildMessageBlocks(events []event) []api.Block {
 			if taskID, ok := evt.meta.Value["task_id"]; ok && taskID.GetStringValue() != "" {
 				fieldSlice = append(fieldSlice, api.NewTextBlockObject("mrkdwn", fmt.Sprintf("*Task ID:*\n%s", taskID.GetStringValue()), false, false))
 			}
+		case models.JobEventTypeStart, models.JobEventTypeSuccess, models.JobEventTypeRetry:
+			headings := map[models.JobEventType]string{
+				models.JobEventTypeStart:   "Started",
+				models.JobEventTypeSuccess: "Success",
+				models.JobEventTypeRetry:   "Retry",
+			}
+			heading := api.NewTextBlockObject("plain_text",
+				fmt.Sprintf("[Job] %s | %s/%s", headings[evt.meta.Type], evt.projectName, evt.namespaceName), true, false)
+			blocks = append(blocks, api.NewHeaderBlock(heading))
+
+			if scheduledAt, ok := evt.meta.Value["scheduled_at"]; ok && scheduledAt.GetStringValue() != "" {
+				fieldSlice = append(fieldSlice, api.NewTextBlockObject("mrkdwn", fmt.Sprintf("*Scheduled At:*\n%s", scheduledAt.GetStringValue()), false, false))
+			}
+			if taskID, ok := evt.meta.Value["task_id"]; ok && taskID.GetStringValue() != "" {
+				fieldSlice = append(fieldSlice, api.NewTextBlockObject("mrkdwn", fmt.Sprintf("*Task ID:*\n%s", taskID.GetStringValue()), false, false))
+			}
+			if attempt, ok := evt.meta.Value["attempt"]; ok && attempt.GetNumberValue() > 0 {
+				fieldSlice = append(fieldSlice, api.NewTextBlockObject("mrkdwn", fmt.Sprintf("*Attempt:*\n%d", int64(attempt.GetNumberValue())), false, false))
+			}
 		default:
 			// unknown event
 			continue
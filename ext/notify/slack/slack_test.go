@@ -214,6 +214,12 @@ func TestBuildMessages(t *testing.T) {
                 }
             ]
         }`))
+	retryValues := &structpb.Struct{}
+	_ = retryValues.UnmarshalJSON([]byte(`{
+            "task_id": "bq2bq",
+            "scheduled_at": "2021-07-12T07:40:00Z",
+            "attempt": 2
+        }`))
 	type args struct {
 		events []event
 	}
@@ -267,6 +273,57 @@ func TestBuildMessages(t *testing.T) {
             }
         ]
     }
+]`,
+		},
+		{
+			name: "should parse values of retry correctly",
+			args: args{events: []event{
+				{
+					authToken:     "xx",
+					projectName:   "ss",
+					namespaceName: "bb",
+					jobName:       "cc",
+					owner:         "rr",
+					meta: models.JobEvent{
+						Type:  models.JobEventTypeRetry,
+						Value: retryValues.GetFields(),
+					},
+				},
+			}},
+			want: `[
+    {
+        "type": "header",
+        "text": {
+            "type": "plain_text",
+            "text": "[Job] Retry | ss/bb",
+            "emoji": true
+        }
+    },
+    {
+        "type": "section",
+        "fields": [
+            {
+                "type": "mrkdwn",
+                "text": "*Job:*\ncc"
+            },
+            {
+                "type": "mrkdwn",
+                "text": "*Owner:*\nrr"
+            },
+            {
+                "type": "mrkdwn",
+                "text": "*Scheduled At:*\n2021-07-12T07:40:00Z"
+            },
+            {
+                "type": "mrkdwn",
+                "text": "*Task ID:*\nbq2bq"
+            },
+            {
+                "type": "mrkdwn",
+                "text": "*Attempt:*\n2"
+            }
+        ]
+    }
 ]`,
 		},
 	}
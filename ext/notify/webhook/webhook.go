@@ -0,0 +1,276 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/structpb"
+
+	"github.com/odpf/optimus/models"
+	"github.com/pkg/errors"
+)
+
+const (
+	// HeadersSecretName is the project secret holding headers sent to each
+	// route as json, e.g. {"hooks.example.io/optimus": {"Authorization": "Bearer token"}}
+	HeadersSecretName         = "NOTIFY_WEBHOOK"
+	DefaultEventBatchInterval = time.Second * 10
+	DefaultRequestTimeout     = time.Second * 30
+	DefaultRetryBackoff       = time.Second * 2
+	MaxSendAttempts           = 3
+)
+
+var (
+	webhookQueueCounter = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "notify_webhook_queue",
+		Help: "Items queued in webhook notification channel",
+	})
+	webhookWorkerBatchCounter = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "notify_webhook_worker_batch",
+		Help: "Worker execution count in webhook notification channel",
+	})
+	webhookWorkerSendRetryCounter = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "notify_webhook_worker_send_retry",
+		Help: "Retries of messages in webhook notification channel worker",
+	})
+	webhookWorkerSendErrCounter = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "notify_webhook_worker_send_err",
+		Help: "Failure of messages in webhook notification channel worker",
+	})
+)
+
+type Notifier struct {
+	io.Closer
+
+	client        *http.Client
+	routeMsgBatch map[route][]event
+	wg            sync.WaitGroup
+	mu            sync.Mutex
+	workerErrChan chan error
+
+	eventBatchInterval time.Duration
+	retryBackoff       time.Duration
+}
+
+type route struct {
+	endpoint string
+	// headers are kept serialized so that route can be used as map key
+	headers string
+}
+
+type event struct {
+	Project   string          `json:"project"`
+	Namespace string          `json:"namespace"`
+	Job       string          `json:"job"`
+	Owner     string          `json:"owner"`
+	Type      string          `json:"type"`
+	Value     json.RawMessage `json:"value"`
+}
+
+type payload struct {
+	Events []event `json:"events"`
+}
+
+// Notify queues the event to be posted to route, events of same route are
+// sent together in a batch
+func (s *Notifier) Notify(ctx context.Context, attr models.NotifyAttrs) error {
+	endpoint, err := parseRoute(attr.Route)
+	if err != nil {
+		return err
+	}
+
+	// headers are registered by route without scheme
+	routeName := strings.TrimPrefix(attr.Route, endpoint.Scheme+"://")
+	headers, err := routeHeaders(attr.Namespace.ProjectSpec.Secret, routeName, endpoint.Host)
+	if err != nil {
+		return err
+	}
+	serializedHeaders, err := json.Marshal(headers)
+	if err != nil {
+		return err
+	}
+
+	value, err := protojson.Marshal(&structpb.Struct{Fields: attr.JobEvent.Value})
+	if err != nil {
+		return errors.Wrap(err, "failed to serialize event")
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	rt := route{
+		endpoint: endpoint.String(),
+		headers:  string(serializedHeaders),
+	}
+	s.routeMsgBatch[rt] = append(s.routeMsgBatch[rt], event{
+		Project:   attr.Namespace.ProjectSpec.Name,
+		Namespace: attr.Namespace.Name,
+		Job:       attr.JobSpec.Name,
+		Owner:     attr.JobSpec.Owner,
+		Type:      string(attr.JobEvent.Type),
+		Value:     value,
+	})
+	webhookQueueCounter.Inc()
+	return nil
+}
+
+// routeHeaders finds headers of route from project secret, headers registered
+// for the exact route are preferred over the ones registered for its host
+// parseRoute takes scheme of endpoint from route, routes without a
+// scheme are posted over https
+func parseRoute(routeName string) (*url.URL, error) {
+	address := routeName
+	if !strings.HasPrefix(address, "http://") && !strings.HasPrefix(address, "https://") {
+		address = "https://" + address
+	}
+	endpoint, err := url.Parse(address)
+	if err != nil || endpoint.Host == "" {
+		return nil, errors.Errorf("invalid webhook route %s", routeName)
+	}
+	return endpoint, nil
+}
+
+func routeHeaders(secrets models.ProjectSecrets, routeName, host string) (map[string]string, error) {
+	secret, ok := secrets.GetByName(HeadersSecretName)
+	if !ok {
+		return map[string]string{}, nil
+	}
+	var headersByRoute map[string]map[string]string
+	if err := json.Unmarshal([]byte(secret), &headersByRoute); err != nil {
+		return nil, errors.Wrapf(err, "failed to parse %s secret, it should be a json of headers by route", HeadersSecretName)
+	}
+	if headers, ok := headersByRoute[routeName]; ok {
+		return headers, nil
+	}
+	if headers, ok := headersByRoute[host]; ok {
+		return headers, nil
+	}
+	return map[string]string{}, nil
+}
+
+// send posts events to route, failed requests are retried with exponential
+// backoff unless the endpoint rejects the payload
+func (s *Notifier) send(rt route, events []event) error {
+	var headers map[string]string
+	if err := json.Unmarshal([]byte(rt.headers), &headers); err != nil {
+		return err
+	}
+	body, err := json.Marshal(payload{Events: events})
+	if err != nil {
+		return err
+	}
+
+	backoff := s.retryBackoff
+	for attempt := 1; ; attempt++ {
+		retryable, err := s.post(rt.endpoint, headers, body)
+		if err == nil {
+			return nil
+		}
+		if !retryable || attempt == MaxSendAttempts {
+			return err
+		}
+		webhookWorkerSendRetryCounter.Inc()
+		time.Sleep(backoff)
+		backoff *= 2
+	}
+}
+
+// post returns if the request can be retried when it fails
+func (s *Notifier) post(endpoint string, headers map[string]string, body []byte) (bool, error) {
+	req, err := http.NewRequest(http.MethodPost, endpoint, bytes.NewReader(body))
+	if err != nil {
+		return false, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	for key, val := range headers {
+		req.Header.Set(key, val)
+	}
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return true, err
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(ioutil.Discard, resp.Body)
+
+	if resp.StatusCode >= http.StatusOK && resp.StatusCode < http.StatusMultipleChoices {
+		return false, nil
+	}
+	retryable := resp.StatusCode >= http.StatusInternalServerError || resp.StatusCode == http.StatusTooManyRequests
+	return retryable, fmt.Errorf("webhook responded with status %s", resp.Status)
+}
+
+func (s *Notifier) Worker(ctx context.Context) {
+	defer s.wg.Done()
+	for {
+		// send events in batches, queued events are flushed once more
+		// before exiting when context is done
+		select {
+		case <-ctx.Done():
+		case <-time.After(s.eventBatchInterval):
+		}
+
+		// take out queued batches so that events can be queued while sending
+		s.mu.Lock()
+		batches := s.routeMsgBatch
+		s.routeMsgBatch = map[route][]event{}
+		s.mu.Unlock()
+
+		for rt, events := range batches {
+			if len(events) == 0 {
+				continue
+			}
+			if err := s.send(rt, events); err != nil {
+				// headers are not reported as they may have credentials
+				s.workerErrChan <- errors.Wrapf(err, "Worker_SendMessage: %s: %d events", rt.endpoint, len(events))
+			}
+		}
+		webhookWorkerBatchCounter.Inc()
+
+		if ctx.Err() != nil {
+			close(s.workerErrChan)
+			return
+		}
+	}
+}
+
+func (s *Notifier) Close() error {
+	// drain batches
+	s.wg.Wait()
+	return nil
+}
+
+func NewNotifier(ctx context.Context, client *http.Client, eventBatchInterval, retryBackoff time.Duration,
+	errHandler func(error)) *Notifier {
+	this := &Notifier{
+		client:             client,
+		routeMsgBatch:      map[route][]event{},
+		workerErrChan:      make(chan error),
+		eventBatchInterval: eventBatchInterval,
+		retryBackoff:       retryBackoff,
+	}
+
+	this.wg.Add(1)
+	go func() {
+		for err := range this.workerErrChan {
+			errHandler(err)
+			webhookWorkerSendErrCounter.Inc()
+		}
+		this.wg.Done()
+	}()
+
+	this.wg.Add(1)
+	go this.Worker(ctx)
+	return this
+}
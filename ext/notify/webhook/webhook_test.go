@@ -0,0 +1,229 @@
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/odpf/optimus/models"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+func TestWebhook(t *testing.T) {
+	namespaceSpec := models.NamespaceSpec{
+		Name: "test",
+		ProjectSpec: models.ProjectSpec{
+			Name: "foo",
+		},
+	}
+	failureEvent := models.JobEvent{
+		Type: models.JobEventTypeFailure,
+		Value: map[string]*structpb.Value{
+			"task_id":      structpb.NewStringValue("bq2bq"),
+			"scheduled_at": structpb.NewStringValue("2021-10-01T00:00:00Z"),
+		},
+	}
+
+	t.Run("should post batched events of a route with headers from project secret", func(t *testing.T) {
+		var mu sync.Mutex
+		var requests []payload
+		var authHeaders []string
+		server := httptest.NewTLSServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+			body, _ := ioutil.ReadAll(r.Body)
+			var p payload
+			assert.Nil(t, json.Unmarshal(body, &p))
+			assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+
+			mu.Lock()
+			defer mu.Unlock()
+			requests = append(requests, p)
+			authHeaders = append(authHeaders, r.Header.Get("Authorization"))
+			rw.WriteHeader(http.StatusAccepted)
+		}))
+		defer server.Close()
+		host := strings.TrimPrefix(server.URL, "https://")
+
+		ctx, cancel := context.WithCancel(context.Background())
+		var sendErrors []error
+		client := NewNotifier(ctx, server.Client(), time.Millisecond*500, time.Millisecond, func(err error) {
+			sendErrors = append(sendErrors, err)
+		})
+
+		projectSpec := namespaceSpec.ProjectSpec
+		projectSpec.Secret = models.ProjectSecrets{
+			{
+				Name:  HeadersSecretName,
+				Value: `{"` + host + `": {"Authorization": "Bearer host-token"}, "` + host + `/incidents": {"Authorization": "Bearer route-token"}}`,
+			},
+		}
+		withSecret := namespaceSpec
+		withSecret.ProjectSpec = projectSpec
+		for _, jobName := range []string{"foo-job-1", "foo-job-2"} {
+			err := client.Notify(context.Background(), models.NotifyAttrs{
+				Namespace: withSecret,
+				JobSpec: models.JobSpec{
+					Name:  jobName,
+					Owner: "optimus@test.com",
+				},
+				JobEvent: failureEvent,
+				Route:    host + "/incidents",
+			})
+			assert.Nil(t, err)
+		}
+		cancel()
+		assert.Nil(t, client.Close())
+		assert.Nil(t, sendErrors)
+
+		assert.Equal(t, 1, len(requests))
+		assert.Equal(t, []string{"Bearer route-token"}, authHeaders)
+		assert.Equal(t, 2, len(requests[0].Events))
+		evt := requests[0].Events[1]
+		assert.Equal(t, "foo", evt.Project)
+		assert.Equal(t, "test", evt.Namespace)
+		assert.Equal(t, "foo-job-2", evt.Job)
+		assert.Equal(t, "optimus@test.com", evt.Owner)
+		assert.Equal(t, "failure", evt.Type)
+		assert.JSONEq(t, `{"task_id": "bq2bq", "scheduled_at": "2021-10-01T00:00:00Z"}`, string(evt.Value))
+	})
+	t.Run("should post events over scheme of route", func(t *testing.T) {
+		var mu sync.Mutex
+		var authHeaders []string
+		server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+			mu.Lock()
+			defer mu.Unlock()
+			authHeaders = append(authHeaders, r.Header.Get("Authorization"))
+			rw.WriteHeader(http.StatusOK)
+		}))
+		defer server.Close()
+		host := strings.TrimPrefix(server.URL, "http://")
+
+		ctx, cancel := context.WithCancel(context.Background())
+		var sendErrors []error
+		client := NewNotifier(ctx, server.Client(), time.Millisecond*500, time.Millisecond, func(err error) {
+			sendErrors = append(sendErrors, err)
+		})
+
+		withSecret := namespaceSpec
+		withSecret.ProjectSpec.Secret = models.ProjectSecrets{
+			{
+				Name:  HeadersSecretName,
+				Value: `{"` + host + `/incidents": {"Authorization": "Bearer route-token"}}`,
+			},
+		}
+		err := client.Notify(context.Background(), models.NotifyAttrs{
+			Namespace: withSecret,
+			JobSpec:   models.JobSpec{Name: "foo-job"},
+			JobEvent:  failureEvent,
+			Route:     server.URL + "/incidents",
+		})
+		assert.Nil(t, err)
+		cancel()
+		assert.Nil(t, client.Close())
+		assert.Nil(t, sendErrors)
+		assert.Equal(t, []string{"Bearer route-token"}, authHeaders)
+	})
+	t.Run("should retry posting events if endpoint fails temporarily", func(t *testing.T) {
+		var mu sync.Mutex
+		attempts := 0
+		server := httptest.NewTLSServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+			mu.Lock()
+			defer mu.Unlock()
+			attempts++
+			if attempts < MaxSendAttempts {
+				rw.WriteHeader(http.StatusServiceUnavailable)
+				return
+			}
+			rw.WriteHeader(http.StatusOK)
+		}))
+		defer server.Close()
+
+		ctx, cancel := context.WithCancel(context.Background())
+		var sendErrors []error
+		client := NewNotifier(ctx, server.Client(), time.Millisecond*500, time.Millisecond, func(err error) {
+			sendErrors = append(sendErrors, err)
+		})
+		err := client.Notify(context.Background(), models.NotifyAttrs{
+			Namespace: namespaceSpec,
+			JobSpec:   models.JobSpec{Name: "foo-job"},
+			JobEvent:  failureEvent,
+			Route:     strings.TrimPrefix(server.URL, "https://"),
+		})
+		assert.Nil(t, err)
+		cancel()
+		assert.Nil(t, client.Close())
+		assert.Nil(t, sendErrors)
+		assert.Equal(t, MaxSendAttempts, attempts)
+	})
+	t.Run("should report error without retrying if endpoint rejects events", func(t *testing.T) {
+		var mu sync.Mutex
+		attempts := 0
+		server := httptest.NewTLSServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+			mu.Lock()
+			defer mu.Unlock()
+			attempts++
+			rw.WriteHeader(http.StatusUnauthorized)
+		}))
+		defer server.Close()
+
+		ctx, cancel := context.WithCancel(context.Background())
+		var sendErrors []error
+		client := NewNotifier(ctx, server.Client(), time.Millisecond*500, time.Millisecond, func(err error) {
+			sendErrors = append(sendErrors, err)
+		})
+		err := client.Notify(context.Background(), models.NotifyAttrs{
+			Namespace: namespaceSpec,
+			JobSpec:   models.JobSpec{Name: "foo-job"},
+			JobEvent:  failureEvent,
+			Route:     strings.TrimPrefix(server.URL, "https://"),
+		})
+		assert.Nil(t, err)
+		cancel()
+		assert.Nil(t, client.Close())
+		assert.Equal(t, 1, attempts)
+		assert.Equal(t, 1, len(sendErrors))
+		assert.Contains(t, sendErrors[0].Error(), "401 Unauthorized")
+	})
+	t.Run("should fail to queue event if headers secret is not valid", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		client := NewNotifier(ctx, http.DefaultClient, time.Millisecond*500, time.Millisecond, func(err error) {})
+		defer client.Close()
+		defer cancel()
+
+		withSecret := namespaceSpec
+		withSecret.ProjectSpec.Secret = models.ProjectSecrets{
+			{
+				Name:  HeadersSecretName,
+				Value: "Bearer token",
+			},
+		}
+		err := client.Notify(context.Background(), models.NotifyAttrs{
+			Namespace: withSecret,
+			JobSpec:   models.JobSpec{Name: "foo-job"},
+			JobEvent:  failureEvent,
+			Route:     "hooks.example.io/optimus",
+		})
+		assert.NotNil(t, err)
+		assert.Contains(t, err.Error(), HeadersSecretName)
+	})
+	t.Run("should fail to queue event if route is not valid", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		client := NewNotifier(ctx, http.DefaultClient, time.Millisecond*500, time.Millisecond, func(err error) {})
+		defer client.Close()
+		defer cancel()
+
+		err := client.Notify(context.Background(), models.NotifyAttrs{
+			Namespace: namespaceSpec,
+			JobSpec:   models.JobSpec{Name: "foo-job"},
+			JobEvent:  failureEvent,
+			Route:     "/optimus",
+		})
+		assert.NotNil(t, err)
+	})
+}
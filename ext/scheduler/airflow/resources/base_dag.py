@@ -9,7 +9,8 @@ from airflow.configuration import conf
 from airflow.utils.weight_rule import WeightRule
 from kubernetes.client import models as k8s
 
-from __lib import optimus_failure_notify, optimus_sla_miss_notify, SuperKubernetesPodOperator, \
+from __lib import optimus_failure_notify, optimus_sla_miss_notify, optimus_job_start_notify, \
+    optimus_job_success_notify, optimus_retry_notify, SuperKubernetesPodOperator, \
     SuperExternalTaskSensor, CrossTenantDependencySensor
 
 SENSOR_DEFAULT_POKE_INTERVAL_IN_SECS = int(Variable.get("sensor_poke_interval_in_secs", default_var=15 * 60))
@@ -33,6 +34,7 @@ default_args = {
     "start_date": datetime.strptime({{ .Job.Schedule.StartDate.Format "2006-01-02T15:04:05" | quote }}, "%Y-%m-%dT%H:%M:%S"),
     {{if .Job.Schedule.EndDate -}}"end_date": datetime.strptime({{ .Job.Schedule.EndDate.Format "2006-01-02T15:04:05" | quote}},"%Y-%m-%dT%H:%M:%S"),{{- else -}}{{- end}}
     "on_failure_callback": optimus_failure_notify,
+    "on_retry_callback": optimus_retry_notify,
     "weight_rule": WeightRule.ABSOLUTE
 }
 
@@ -41,6 +43,7 @@ dag = DAG(
     default_args=default_args,
     schedule_interval={{ if eq .Job.Schedule.Interval "" }}None{{- else -}} {{ .Job.Schedule.Interval | quote}}{{end}},
     sla_miss_callback=optimus_sla_miss_notify,
+    on_success_callback=optimus_job_success_notify,
     catchup ={{ if .Job.Behavior.CatchUp }} True{{ else }} False{{ end }}
 )
 
@@ -97,9 +100,11 @@ transformation_{{$baseTaskSchema.Name | replace "-" "__dash__" | replace "." "__
     in_cluster=True,
     is_delete_operator_pod=True,
     do_xcom_push=False,
+    on_execute_callback=optimus_job_start_notify,
     secrets=[{{ if ne $baseTaskSchema.SecretPath "" -}} transformation_secret {{- end }}],
     env_vars={
         "JOB_NAME":'{{.Job.Name}}', "OPTIMUS_HOSTNAME":'{{.Hostname}}',
+        "OPTIMUS_AUTH_BEARER_TOKEN":"{{ "{{ var.value.get('optimus_auth_token', '') }}" }}", "OPTIMUS_TLS_ENABLED":"{{ "{{ var.value.get('optimus_tls_enabled', '') }}" }}",
         "JOB_LABELS":'{{.Job.GetLabelsAsString}}', "NAMESPACE":'{{.Namespace.Name}}',
         "JOB_DIR":'/data', "PROJECT":'{{.Namespace.ProjectSpec.Name}}',
         "INSTANCE_TYPE":'{{$.InstanceTypeTask}}', "INSTANCE_NAME":'{{$baseTaskSchema.Name}}',
@@ -141,6 +146,7 @@ hook_{{$hookSchema.Name | replace "-" "__dash__"}} = SuperKubernetesPodOperator(
     secrets=[{{ if ne $hookSchema.SecretPath "" -}} hook_{{$hookSchema.Name | replace "-" "_"}}_secret {{- end }}],
     env_vars={
         "JOB_NAME":'{{$.Job.Name}}', "OPTIMUS_HOSTNAME":'{{$.Hostname}}',
+        "OPTIMUS_AUTH_BEARER_TOKEN":"{{ "{{ var.value.get('optimus_auth_token', '') }}" }}", "OPTIMUS_TLS_ENABLED":"{{ "{{ var.value.get('optimus_tls_enabled', '') }}" }}",
         "JOB_LABELS":'{{$.Job.GetLabelsAsString}}', "NAMESPACE":'{{$.Namespace.Name}}',
         "JOB_DIR":'/data', "PROJECT":'{{$.Namespace.ProjectSpec.Name}}',
         "INSTANCE_TYPE":'{{$.InstanceTypeHook}}', "INSTANCE_NAME":'{{$hookSchema.Name}}',
@@ -9,7 +9,8 @@ from airflow.configuration import conf
 from airflow.utils.weight_rule import WeightRule
 from kubernetes.client import models as k8s
 
-from __lib import optimus_failure_notify, optimus_sla_miss_notify, SuperKubernetesPodOperator, \
+from __lib import optimus_failure_notify, optimus_sla_miss_notify, optimus_job_start_notify, \
+    optimus_job_success_notify, optimus_retry_notify, SuperKubernetesPodOperator, \
     SuperExternalTaskSensor, CrossTenantDependencySensor
 
 SENSOR_DEFAULT_POKE_INTERVAL_IN_SECS = int(Variable.get("sensor_poke_interval_in_secs", default_var=15 * 60))
@@ -33,6 +34,7 @@ default_args = {
     "start_date": datetime.strptime("2000-11-11T00:00:00", "%Y-%m-%dT%H:%M:%S"),
     "end_date": datetime.strptime("2020-11-11T00:00:00","%Y-%m-%dT%H:%M:%S"),
     "on_failure_callback": optimus_failure_notify,
+    "on_retry_callback": optimus_retry_notify,
     "weight_rule": WeightRule.ABSOLUTE
 }
 
@@ -41,6 +43,7 @@ dag = DAG(
     default_args=default_args,
     schedule_interval="* * * * *",
     sla_miss_callback=optimus_sla_miss_notify,
+    on_success_callback=optimus_job_success_notify,
     catchup = True
 )
 
@@ -63,9 +66,11 @@ transformation_bq = SuperKubernetesPodOperator(
     in_cluster=True,
     is_delete_operator_pod=True,
     do_xcom_push=False,
+    on_execute_callback=optimus_job_start_notify,
     secrets=[transformation_secret],
     env_vars={
         "JOB_NAME":'foo', "OPTIMUS_HOSTNAME":'http://airflow.example.io',
+        "OPTIMUS_AUTH_BEARER_TOKEN":"{{ var.value.get('optimus_auth_token', '') }}", "OPTIMUS_TLS_ENABLED":"{{ var.value.get('optimus_tls_enabled', '') }}",
         "JOB_LABELS":'orchestrator=optimus', "NAMESPACE":'bar-namespace',
         "JOB_DIR":'/data', "PROJECT":'foo-project',
         "INSTANCE_TYPE":'task', "INSTANCE_NAME":'bq',
@@ -99,6 +104,7 @@ hook_transporter = SuperKubernetesPodOperator(
     secrets=[hook_transporter_secret],
     env_vars={
         "JOB_NAME":'foo', "OPTIMUS_HOSTNAME":'http://airflow.example.io',
+        "OPTIMUS_AUTH_BEARER_TOKEN":"{{ var.value.get('optimus_auth_token', '') }}", "OPTIMUS_TLS_ENABLED":"{{ var.value.get('optimus_tls_enabled', '') }}",
         "JOB_LABELS":'orchestrator=optimus', "NAMESPACE":'bar-namespace',
         "JOB_DIR":'/data', "PROJECT":'foo-project',
         "INSTANCE_TYPE":'hook', "INSTANCE_NAME":'transporter',
@@ -124,6 +130,7 @@ hook_predator = SuperKubernetesPodOperator(
     secrets=[],
     env_vars={
         "JOB_NAME":'foo', "OPTIMUS_HOSTNAME":'http://airflow.example.io',
+        "OPTIMUS_AUTH_BEARER_TOKEN":"{{ var.value.get('optimus_auth_token', '') }}", "OPTIMUS_TLS_ENABLED":"{{ var.value.get('optimus_tls_enabled', '') }}",
         "JOB_LABELS":'orchestrator=optimus', "NAMESPACE":'bar-namespace',
         "JOB_DIR":'/data', "PROJECT":'foo-project',
         "INSTANCE_TYPE":'hook', "INSTANCE_NAME":'predator',
@@ -149,6 +156,7 @@ hook_hook__dash__for__dash__fail = SuperKubernetesPodOperator(
     secrets=[],
     env_vars={
         "JOB_NAME":'foo', "OPTIMUS_HOSTNAME":'http://airflow.example.io',
+        "OPTIMUS_AUTH_BEARER_TOKEN":"{{ var.value.get('optimus_auth_token', '') }}", "OPTIMUS_TLS_ENABLED":"{{ var.value.get('optimus_tls_enabled', '') }}",
         "JOB_LABELS":'orchestrator=optimus', "NAMESPACE":'bar-namespace',
         "JOB_DIR":'/data', "PROJECT":'foo-project',
         "INSTANCE_TYPE":'hook', "INSTANCE_NAME":'hook-for-fail',
@@ -0,0 +1,38 @@
+import unittest
+import sys
+sys.path.insert(1, '../resources')
+
+from __lib import OptimusAPIClient
+
+from unittest.mock import patch
+
+
+class TestOptimusAPIClient(unittest.TestCase):
+    variables = {
+        "optimus_auth_token": "some-token",
+        "optimus_tls_enabled": "true",
+        "optimus_tls_ca_file": "/etc/optimus/ca.pem",
+    }
+
+    @patch("__lib.Variable")
+    def test_should_send_token_over_tls_if_configured(self, variable_mock):
+        variable_mock.get.side_effect = lambda key, default_var=None: self.variables.get(key, default_var)
+        client = OptimusAPIClient("optimus.example.io:9100")
+
+        self.assertEqual("https://optimus.example.io:9100", client.host)
+        session = client._get_session()
+        self.assertEqual("Bearer some-token", session.headers["Authorization"])
+        self.assertEqual("/etc/optimus/ca.pem", session.verify)
+
+    @patch("__lib.Variable")
+    def test_should_not_send_token_if_not_configured(self, variable_mock):
+        variable_mock.get.side_effect = lambda key, default_var=None: default_var
+        client = OptimusAPIClient("optimus.example.io:9100")
+
+        self.assertEqual("http://optimus.example.io:9100", client.host)
+        self.assertNotIn("Authorization", client._get_session().headers)
+
+    def test_should_not_read_variables_until_first_request(self):
+        with patch("__lib.Variable") as variable_mock:
+            OptimusAPIClient("optimus.example.io:9100")
+            variable_mock.get.assert_not_called()
@@ -226,22 +226,53 @@ class SuperExternalTaskSensor(BaseSensorOperator):
         return expected_upstream_executions
 
 
+# airflow variables used for reaching optimus when authentication or tls is
+# enabled on optimus server
+OPTIMUS_AUTH_TOKEN_VARIABLE = "optimus_auth_token"
+OPTIMUS_TLS_ENABLED_VARIABLE = "optimus_tls_enabled"
+OPTIMUS_TLS_CA_FILE_VARIABLE = "optimus_tls_ca_file"
+
+
 class OptimusAPIClient:
     def __init__(self, optimus_host):
-        self.host = self._add_connection_adapter_if_absent(optimus_host)
+        self._optimus_host = optimus_host
+        self._tls_enabled = False
+        self._session = None
+
+    @property
+    def host(self):
+        self._get_session()
+        return self._add_connection_adapter_if_absent(self._optimus_host)
 
     def _add_connection_adapter_if_absent(self, host):
         if host.startswith("http://") or host.startswith("https://"):
             return host
+        if self._tls_enabled:
+            return "https://" + host
         return "http://" + host
 
+    def _get_session(self) -> requests.Session:
+        # variables are read on first request instead of while parsing dags
+        if self._session is not None:
+            return self._session
+        self._tls_enabled = Variable.get(OPTIMUS_TLS_ENABLED_VARIABLE, default_var="").lower() == "true"
+        session = requests.Session()
+        auth_token = Variable.get(OPTIMUS_AUTH_TOKEN_VARIABLE, default_var="")
+        if auth_token:
+            session.headers["Authorization"] = "Bearer " + auth_token
+        ca_file = Variable.get(OPTIMUS_TLS_CA_FILE_VARIABLE, default_var="")
+        if ca_file:
+            session.verify = ca_file
+        self._session = session
+        return session
+
     def get_job_run_status(self, optimus_project: str, optimus_job: str) -> dict:
         url = '{optimus_host}/api/v1beta1/project/{optimus_project}/job/{optimus_job}/status'.format(
             optimus_host=self.host,
             optimus_project=optimus_project,
             optimus_job=optimus_job,
         )
-        response = requests.get(url)
+        response = self._get_session().get(url)
         self._raise_error_if_request_failed(response)
         return response.json()
 
@@ -254,7 +285,7 @@ class OptimusAPIClient:
             window_offset=window_offset,
             window_truncate_upto=window_truncate_upto,
         )
-        response = requests.get(url)
+        response = self._get_session().get(url)
         self._raise_error_if_request_failed(response)
         return response.json()
 
@@ -267,7 +298,7 @@ class OptimusAPIClient:
             "instance_type": "TYPE_TASK",
             "instance_name": "none"
         }
-        response = requests.post(url, data=json.dumps(request_data))
+        response = self._get_session().post(url, data=json.dumps(request_data))
         self._raise_error_if_request_failed(response)
         return response.json()
 
@@ -281,7 +312,7 @@ class OptimusAPIClient:
         request_data = {
             "event": event
         }
-        response = requests.post(url, data=json.dumps(request_data))
+        response = self._get_session().post(url, data=json.dumps(request_data))
         self._raise_error_if_request_failed(response)
         return response.json()
 
@@ -478,6 +509,63 @@ def optimus_sla_miss_notify(dag, task_list, blocking_task_list, slas, blocking_t
     return
 
 
+def optimus_job_start_notify(context):
+    # job is started once its transformation starts executing, posting the
+    # event should never fail the task
+    params = context.get("params")
+    current_execution_date = context.get('execution_date')
+    message = {
+        "log_url": context.get('task_instance').log_url,
+        "task_id": context.get('task_instance').task_id,
+        "run_id": context.get('run_id'),
+        "scheduled_at": current_execution_date.strftime("%Y-%m-%dT%H:%M:%SZ")
+    }
+    try:
+        _post_job_event(params, "TYPE_JOB_START", message)
+    except Exception as e:
+        print("failed to post job start event ", params, e)
+    return
+
+
+def optimus_job_success_notify(context):
+    params = context.get("params")
+    current_dag_id = context.get('dag').dag_id
+    current_execution_date = context.get('execution_date')
+    webserver_url = conf.get(section='webserver', key='base_url')
+    message = {
+        "run_id": context.get('run_id'),
+        "scheduled_at": current_execution_date.strftime("%Y-%m-%dT%H:%M:%SZ"),
+        "job_url": "{}/tree?dag_id={}".format(webserver_url, current_dag_id),
+    }
+    _post_job_event(params, "TYPE_JOB_SUCCESS", message)
+    return
+
+
+def optimus_retry_notify(context):
+    params = context.get("params")
+    current_execution_date = context.get('execution_date')
+    message = {
+        "log_url": context.get('task_instance').log_url,
+        "task_id": context.get('task_instance').task_id,
+        "run_id": context.get('run_id'),
+        "attempt": context.get('task_instance').try_number,
+        "exception": str(context.get('exception')),
+        "scheduled_at": current_execution_date.strftime("%Y-%m-%dT%H:%M:%SZ")
+    }
+    _post_job_event(params, "TYPE_RETRY", message)
+    return
+
+
+def _post_job_event(params, event_type, message):
+    optimus_client = OptimusAPIClient(params["optimus_hostname"])
+    event = {
+        "type": event_type,
+        "value": message,
+    }
+    resp = optimus_client.notify_event(params["project_name"], params["namespace"], params["job_name"], event)
+    print("posted event ", params, event, resp)
+
+
 # everything below this is here for legacy reasons, should be cleaned up in future
 
 def alert_failed_to_slack(context):
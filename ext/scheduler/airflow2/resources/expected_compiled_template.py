@@ -9,7 +9,8 @@ from airflow.configuration import conf
 from airflow.utils.weight_rule import WeightRule
 from kubernetes.client import models as k8s
 
-from __lib import optimus_failure_notify, optimus_sla_miss_notify, SuperKubernetesPodOperator, \
+from __lib import optimus_failure_notify, optimus_sla_miss_notify, optimus_job_start_notify, \
+    optimus_job_success_notify, optimus_retry_notify, SuperKubernetesPodOperator, \
     SuperExternalTaskSensor, CrossTenantDependencySensor
 
 SENSOR_DEFAULT_POKE_INTERVAL_IN_SECS = int(Variable.get("sensor_poke_interval_in_secs", default_var=15 * 60))
@@ -33,6 +34,7 @@ default_args = {
     "start_date": datetime.strptime("2000-11-11T00:00:00", "%Y-%m-%dT%H:%M:%S"),
     "end_date": datetime.strptime("2020-11-11T00:00:00","%Y-%m-%dT%H:%M:%S"),
     "on_failure_callback": optimus_failure_notify,
+    "on_retry_callback": optimus_retry_notify,
     "weight_rule": WeightRule.ABSOLUTE
 }
 
@@ -41,6 +43,7 @@ dag = DAG(
     default_args=default_args,
     schedule_interval="* * * * *",
     sla_miss_callback=optimus_sla_miss_notify,
+    on_success_callback=optimus_job_success_notify,
     catchup = True
 )
 
@@ -63,10 +66,13 @@ transformation_bq = SuperKubernetesPodOperator(
     in_cluster=True,
     is_delete_operator_pod=True,
     do_xcom_push=False,
+    on_execute_callback=optimus_job_start_notify,
     secrets=[transformation_secret],
     env_vars = [
         k8s.V1EnvVar(name="JOB_NAME",value='foo'),
         k8s.V1EnvVar(name="OPTIMUS_HOSTNAME",value='http://airflow.example.io'),
+        k8s.V1EnvVar(name="OPTIMUS_AUTH_BEARER_TOKEN",value="{{ var.value.get('optimus_auth_token', '') }}"),
+        k8s.V1EnvVar(name="OPTIMUS_TLS_ENABLED",value="{{ var.value.get('optimus_tls_enabled', '') }}"),
         k8s.V1EnvVar(name="JOB_LABELS",value='orchestrator=optimus'),
         k8s.V1EnvVar(name="JOB_DIR",value='/data'),
         k8s.V1EnvVar(name="PROJECT",value='foo-project'),
@@ -104,6 +110,8 @@ hook_transporter = SuperKubernetesPodOperator(
     env_vars = [
         k8s.V1EnvVar(name="JOB_NAME",value='foo'),
         k8s.V1EnvVar(name="OPTIMUS_HOSTNAME",value='http://airflow.example.io'),
+        k8s.V1EnvVar(name="OPTIMUS_AUTH_BEARER_TOKEN",value="{{ var.value.get('optimus_auth_token', '') }}"),
+        k8s.V1EnvVar(name="OPTIMUS_TLS_ENABLED",value="{{ var.value.get('optimus_tls_enabled', '') }}"),
         k8s.V1EnvVar(name="JOB_LABELS",value='orchestrator=optimus'),
         k8s.V1EnvVar(name="JOB_DIR",value='/data'),
         k8s.V1EnvVar(name="PROJECT",value='foo-project'),
@@ -133,6 +141,8 @@ hook_predator = SuperKubernetesPodOperator(
     env_vars = [
         k8s.V1EnvVar(name="JOB_NAME",value='foo'),
         k8s.V1EnvVar(name="OPTIMUS_HOSTNAME",value='http://airflow.example.io'),
+        k8s.V1EnvVar(name="OPTIMUS_AUTH_BEARER_TOKEN",value="{{ var.value.get('optimus_auth_token', '') }}"),
+        k8s.V1EnvVar(name="OPTIMUS_TLS_ENABLED",value="{{ var.value.get('optimus_tls_enabled', '') }}"),
         k8s.V1EnvVar(name="JOB_LABELS",value='orchestrator=optimus'),
         k8s.V1EnvVar(name="JOB_DIR",value='/data'),
         k8s.V1EnvVar(name="PROJECT",value='foo-project'),
@@ -162,6 +172,8 @@ hook_hook__dash__for__dash__fail = SuperKubernetesPodOperator(
     env_vars = [
         k8s.V1EnvVar(name="JOB_NAME",value='foo'),
         k8s.V1EnvVar(name="OPTIMUS_HOSTNAME",value='http://airflow.example.io'),
+        k8s.V1EnvVar(name="OPTIMUS_AUTH_BEARER_TOKEN",value="{{ var.value.get('optimus_auth_token', '') }}"),
+        k8s.V1EnvVar(name="OPTIMUS_TLS_ENABLED",value="{{ var.value.get('optimus_tls_enabled', '') }}"),
         k8s.V1EnvVar(name="JOB_LABELS",value='orchestrator=optimus'),
         k8s.V1EnvVar(name="JOB_DIR",value='/data'),
         k8s.V1EnvVar(name="PROJECT",value='foo-project'),
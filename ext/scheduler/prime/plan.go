@@ -0,0 +1,165 @@
+package prime
+
+import (
+	"github.com/odpf/optimus/models"
+	"github.com/pkg/errors"
+)
+
+var (
+	ErrHookCyclicDependency = errors.New("cyclic dependency detected between hooks")
+)
+
+// ExecutionPlan is the ordered list of hooks that needs to be executed
+// around the task of a job run
+// pre hooks are executed before the task, post hooks after the task
+// succeeds and fail hooks if anything before them fails
+type ExecutionPlan struct {
+	Pre  []models.JobSpecHook
+	Post []models.JobSpecHook
+	Fail []models.JobSpecHook
+}
+
+// NewExecutionPlan groups hooks of the job by their hook type and orders
+// each group in a way that a hook is always placed after the hooks it
+// depends on
+func NewExecutionPlan(spec models.JobSpec) (ExecutionPlan, error) {
+	var pre, post, fail []models.JobSpecHook
+	for _, hook := range spec.Hooks {
+		switch hook.Unit.Info().HookType {
+		case models.HookTypePre:
+			pre = append(pre, hook)
+		case models.HookTypePost:
+			post = append(post, hook)
+		case models.HookTypeFail:
+			fail = append(fail, hook)
+		}
+	}
+
+	var plan ExecutionPlan
+	var err error
+	if plan.Pre, err = sortHooks(pre); err != nil {
+		return ExecutionPlan{}, errors.Wrapf(err, "pre hooks of job %s", spec.Name)
+	}
+	if plan.Post, err = sortHooks(post); err != nil {
+		return ExecutionPlan{}, errors.Wrapf(err, "post hooks of job %s", spec.Name)
+	}
+	if plan.Fail, err = sortHooks(fail); err != nil {
+		return ExecutionPlan{}, errors.Wrapf(err, "fail hooks of job %s", spec.Name)
+	}
+	return plan, nil
+}
+
+// sortHooks topologically sorts hooks using their dependencies, hooks which
+// doesn't depend on each other keep the order they were defined in.
+// Dependencies on hooks outside of the provided list are ignored as
+// they are handled by the order in which hook groups are executed
+func sortHooks(hooks []models.JobSpecHook) ([]models.JobSpecHook, error) {
+	indexByName := map[string]int{}
+	for idx, hook := range hooks {
+		indexByName[hook.Unit.Info().Name] = idx
+	}
+
+	const (
+		unvisited = iota
+		visiting
+		visited
+	)
+	marks := make([]int, len(hooks))
+	sorted := make([]models.JobSpecHook, 0, len(hooks))
+
+	var visit func(idx int) error
+	visit = func(idx int) error {
+		switch marks[idx] {
+		case visited:
+			return nil
+		case visiting:
+			return errors.Wrap(ErrHookCyclicDependency, hooks[idx].Unit.Info().Name)
+		}
+		marks[idx] = visiting
+		for _, depName := range hookDependencies(hooks[idx]) {
+			depIdx, ok := indexByName[depName]
+			if !ok {
+				continue
+			}
+			if err := visit(depIdx); err != nil {
+				return err
+			}
+		}
+		marks[idx] = visited
+		sorted = append(sorted, hooks[idx])
+		return nil
+	}
+
+	for idx := range hooks {
+		if err := visit(idx); err != nil {
+			return nil, err
+		}
+	}
+	return sorted, nil
+}
+
+// hookDependencies returns names of hooks the provided hook depends on, hook
+// dependencies are resolved while deploying a job but could be missing
+// in specs fetched from store, so plugin info is used as well
+func hookDependencies(hook models.JobSpecHook) []string {
+	var names []string
+	for _, dep := range hook.DependsOn {
+		if dep == nil || dep.Unit == nil {
+			continue
+		}
+		names = append(names, dep.Unit.Info().Name)
+	}
+	names = append(names, hook.Unit.Info().DependsOn...)
+	return names
+}
+
+// inferRunState computes state of the job run using the state of its instances
+// A run succeeds when its pre hooks, task and post hooks succeed, it fails when
+// any one of them fails and all the fail hooks are done executing
+func inferRunState(jobRun models.JobRun, plan ExecutionPlan) models.JobRunState {
+	if len(jobRun.Instances) == 0 {
+		// no node has picked this run yet
+		return jobRun.Status
+	}
+
+	instanceState := func(instanceType models.InstanceType, name string) models.JobRunState {
+		instance, err := jobRun.GetInstance(name, instanceType)
+		if err != nil {
+			return ""
+		}
+		if instance.Status == models.RunStateFailed && canRetryInstance(jobRun.Spec, instance) {
+			// run is not failed till instance exhausts all of its retries
+			return models.RunStateRunning
+		}
+		return instance.Status
+	}
+
+	required := []models.JobRunState{instanceState(models.InstanceTypeTask, jobRun.Spec.Task.Unit.Info().Name)}
+	for _, hook := range plan.Pre {
+		required = append(required, instanceState(models.InstanceTypeHook, hook.Unit.Info().Name))
+	}
+	for _, hook := range plan.Post {
+		required = append(required, instanceState(models.InstanceTypeHook, hook.Unit.Info().Name))
+	}
+
+	successCount := 0
+	for _, state := range required {
+		if state == models.RunStateFailed {
+			for _, hook := range plan.Fail {
+				failHookState := instanceState(models.InstanceTypeHook, hook.Unit.Info().Name)
+				if failHookState != models.RunStateSuccess && failHookState != models.RunStateFailed {
+					// wait for fail hooks to finish
+					return models.RunStateRunning
+				}
+			}
+			return models.RunStateFailed
+		}
+		if state == models.RunStateSuccess {
+			successCount++
+		}
+	}
+	if successCount == len(required) {
+		return models.RunStateSuccess
+	}
+	return models.RunStateRunning
+}
@@ -0,0 +1,171 @@
+package prime
+
+import (
+	"testing"
+
+	"github.com/odpf/optimus/mock"
+	"github.com/odpf/optimus/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestPlugin(info models.PluginInfoResponse) *models.Plugin {
+	base := new(mock.BasePlugin)
+	base.On("PluginInfo").Return(&info, nil)
+	return &models.Plugin{Base: base}
+}
+
+func TestExecutionPlan(t *testing.T) {
+	taskUnit := newTestPlugin(models.PluginInfoResponse{Name: "task", PluginType: models.PluginTypeTask})
+	preHook1 := models.JobSpecHook{Unit: newTestPlugin(models.PluginInfoResponse{
+		Name: "pre1", HookType: models.HookTypePre, DependsOn: []string{"pre2"},
+	})}
+	preHook2 := models.JobSpecHook{Unit: newTestPlugin(models.PluginInfoResponse{
+		Name: "pre2", HookType: models.HookTypePre,
+	})}
+	postHook := models.JobSpecHook{Unit: newTestPlugin(models.PluginInfoResponse{
+		Name: "post1", HookType: models.HookTypePost, DependsOn: []string{"pre1"},
+	})}
+	failHook := models.JobSpecHook{Unit: newTestPlugin(models.PluginInfoResponse{
+		Name: "fail1", HookType: models.HookTypeFail,
+	})}
+	jobSpec := models.JobSpec{
+		Name:  "job",
+		Task:  models.JobSpecTask{Unit: taskUnit},
+		Hooks: []models.JobSpecHook{failHook, preHook1, postHook, preHook2},
+	}
+
+	t.Run("NewExecutionPlan", func(t *testing.T) {
+		t.Run("should group hooks by type and order them by dependencies", func(t *testing.T) {
+			plan, err := NewExecutionPlan(jobSpec)
+			assert.Nil(t, err)
+			assert.Equal(t, []models.JobSpecHook{preHook2, preHook1}, plan.Pre)
+			assert.Equal(t, []models.JobSpecHook{postHook}, plan.Post)
+			assert.Equal(t, []models.JobSpecHook{failHook}, plan.Fail)
+		})
+		t.Run("should use resolved hook dependencies", func(t *testing.T) {
+			hookA := models.JobSpecHook{Unit: newTestPlugin(models.PluginInfoResponse{
+				Name: "a", HookType: models.HookTypePost,
+			})}
+			hookB := models.JobSpecHook{Unit: newTestPlugin(models.PluginInfoResponse{
+				Name: "b", HookType: models.HookTypePost,
+			})}
+			hookA.DependsOn = []*models.JobSpecHook{&hookB}
+
+			plan, err := NewExecutionPlan(models.JobSpec{
+				Task:  models.JobSpecTask{Unit: taskUnit},
+				Hooks: []models.JobSpecHook{hookA, hookB},
+			})
+			assert.Nil(t, err)
+			assert.Equal(t, []models.JobSpecHook{hookB, hookA}, plan.Post)
+		})
+		t.Run("should fail if hooks have cyclic dependency", func(t *testing.T) {
+			hookA := models.JobSpecHook{Unit: newTestPlugin(models.PluginInfoResponse{
+				Name: "a", HookType: models.HookTypePre, DependsOn: []string{"b"},
+			})}
+			hookB := models.JobSpecHook{Unit: newTestPlugin(models.PluginInfoResponse{
+				Name: "b", HookType: models.HookTypePre, DependsOn: []string{"a"},
+			})}
+
+			_, err := NewExecutionPlan(models.JobSpec{
+				Task:  models.JobSpecTask{Unit: taskUnit},
+				Hooks: []models.JobSpecHook{hookA, hookB},
+			})
+			assert.ErrorIs(t, err, ErrHookCyclicDependency)
+		})
+	})
+	t.Run("inferRunState", func(t *testing.T) {
+		plan, err := NewExecutionPlan(jobSpec)
+		assert.Nil(t, err)
+
+		instance := func(name string, instanceType models.InstanceType, state models.JobRunState) models.InstanceSpec {
+			return models.InstanceSpec{Name: name, Type: instanceType, Status: state}
+		}
+		cases := []struct {
+			name       string
+			status     models.JobRunState
+			retryCount int
+			instances  []models.InstanceSpec
+			expected   models.JobRunState
+		}{
+			{
+				name:     "should keep the state if no instance is created",
+				status:   models.RunStateAccepted,
+				expected: models.RunStateAccepted,
+			},
+			{
+				name:   "should be running till post hooks are finished",
+				status: models.RunStateAccepted,
+				instances: []models.InstanceSpec{
+					instance("pre2", models.InstanceTypeHook, models.RunStateSuccess),
+					instance("pre1", models.InstanceTypeHook, models.RunStateSuccess),
+					instance("task", models.InstanceTypeTask, models.RunStateSuccess),
+				},
+				expected: models.RunStateRunning,
+			},
+			{
+				name:   "should succeed when pre hooks, task and post hooks succeed",
+				status: models.RunStateRunning,
+				instances: []models.InstanceSpec{
+					instance("pre2", models.InstanceTypeHook, models.RunStateSuccess),
+					instance("pre1", models.InstanceTypeHook, models.RunStateSuccess),
+					instance("task", models.InstanceTypeTask, models.RunStateSuccess),
+					instance("post1", models.InstanceTypeHook, models.RunStateSuccess),
+				},
+				expected: models.RunStateSuccess,
+			},
+			{
+				name:   "should be running till fail hooks are finished",
+				status: models.RunStateRunning,
+				instances: []models.InstanceSpec{
+					instance("pre2", models.InstanceTypeHook, models.RunStateFailed),
+				},
+				expected: models.RunStateRunning,
+			},
+			{
+				name:   "should fail once fail hooks are finished",
+				status: models.RunStateRunning,
+				instances: []models.InstanceSpec{
+					instance("pre2", models.InstanceTypeHook, models.RunStateSuccess),
+					instance("pre1", models.InstanceTypeHook, models.RunStateSuccess),
+					instance("task", models.InstanceTypeTask, models.RunStateFailed),
+					instance("fail1", models.InstanceTypeHook, models.RunStateSuccess),
+				},
+				expected: models.RunStateFailed,
+			},
+			{
+				name:       "should be running till failed task has retries left",
+				status:     models.RunStateRunning,
+				retryCount: 1,
+				instances: []models.InstanceSpec{
+					instance("pre2", models.InstanceTypeHook, models.RunStateSuccess),
+					instance("pre1", models.InstanceTypeHook, models.RunStateSuccess),
+					{Name: "task", Type: models.InstanceTypeTask, Status: models.RunStateFailed, Attempt: 1},
+				},
+				expected: models.RunStateRunning,
+			},
+			{
+				name:       "should fail once failed task exhausts its retries",
+				status:     models.RunStateRunning,
+				retryCount: 1,
+				instances: []models.InstanceSpec{
+					instance("pre2", models.InstanceTypeHook, models.RunStateSuccess),
+					instance("pre1", models.InstanceTypeHook, models.RunStateSuccess),
+					{Name: "task", Type: models.InstanceTypeTask, Status: models.RunStateFailed, Attempt: 2},
+					instance("fail1", models.InstanceTypeHook, models.RunStateSuccess),
+				},
+				expected: models.RunStateFailed,
+			},
+		}
+		for _, tt := range cases {
+			t.Run(tt.name, func(t *testing.T) {
+				jobRun := models.JobRun{
+					Spec:      jobSpec,
+					Status:    tt.status,
+					Instances: tt.instances,
+				}
+				jobRun.Spec.Behavior.Retry.Count = tt.retryCount
+				assert.Equal(t, tt.expected, inferRunState(jobRun, plan))
+			})
+		}
+	})
+}
@@ -2,6 +2,9 @@ package prime
 
 import (
 	"context"
+	"fmt"
+	"sort"
+	"strconv"
 	"sync"
 	"time"
 
@@ -10,31 +13,51 @@ import (
 	"github.com/google/uuid"
 	"github.com/hashicorp/serf/serf"
 	pb "github.com/odpf/optimus/api/proto/odpf/optimus/cluster/v1beta1"
+	"github.com/odpf/optimus/config"
+	"github.com/odpf/optimus/core/cron"
 	"github.com/odpf/optimus/core/gossip"
 	"github.com/odpf/optimus/models"
-	"github.com/odpf/optimus/utils"
+	"github.com/odpf/optimus/store"
+	"github.com/pkg/errors"
 	"google.golang.org/protobuf/proto"
 )
 
 const (
-	// PeerPoolSize is fixed at the moment, should be configurable
-	// this dictates how many jobs can be executed by a single optimus peer
-	// at a time
-	PeerPoolSize = 20
+	// DefaultPeerPoolSize dictates how many jobs can be executed by a single
+	// optimus peer at a time if the peer doesn't advertise its capacity
+	DefaultPeerPoolSize = 20
 
-	SleepTime = time.Second * 10
+	// DefaultSleepTime is the wait between each planner cycle
+	DefaultSleepTime = time.Second * 10
 
-	// InstanceRunTimeout will mark an instance as failed if a instance is in
+	// DefaultInstanceRunTimeout will mark an instance as failed if a instance is in
 	// running state more than provided time
-	InstanceRunTimeout = time.Hour * 4
+	DefaultInstanceRunTimeout = time.Hour * 4
 
-	// NodeJobRunTimeout will clear job run move it back to pending state to be
+	// DefaultNodeJobRunTimeout will clear job run move it back to pending state to be
 	// handled by another/same peer again
-	NodeJobRunTimeout = time.Hour * 1
+	DefaultNodeJobRunTimeout = time.Hour * 1
+
+	// noExitCode is recorded for instances which finished without the
+	// executor reporting their exit code
+	noExitCode = -1
+
+	// reasonZombie is recorded for instances killed after they stopped
+	// reporting while running
+	reasonZombie = "zombie instance killed"
+
+	// reasonCancelled is recorded for instances stopped because their run
+	// was cancelled by an operator
+	reasonCancelled = "cancelled"
+
+	// maxScheduledRunsPerCycle limits how many runs of a job are created in a
+	// single planner cycle while catching up
+	maxScheduledRunsPerCycle = 100
 )
 
 type ClusterManager interface {
 	IsLeader() bool
+	GetLeader() string
 	ApplyCommand(*pb.CommandLog) error
 	GetState() gossip.State
 	GetClusterMembers() []serf.Member
@@ -46,11 +69,29 @@ type ClusterManager interface {
 type Planner struct {
 	l log.Logger
 
-	clusterManager  ClusterManager
-	jobRunRepoFac   RunRepoFactory
-	instanceRepoFac InstanceRepoFactory
-	executor        models.ExecutorUnit
-	uuidProvider    utils.UUIDProvider
+	clusterManager    ClusterManager
+	jobRunRepoFac     RunRepoFactory
+	instanceRepoFac   InstanceRepoFactory
+	deploymentRepoFac DeploymentRepoFactory
+	projectRepoFac    ProjectRepoFactory
+	executor          models.ExecutorUnit
+	runService        models.RunService
+
+	// batchMode is enabled when built-in scheduler is used as the batch
+	// scheduler, planner then creates runs as per the schedule of deployed jobs
+	batchMode bool
+
+	peerPoolSize       int
+	sleepTime          time.Duration
+	instanceRunTimeout time.Duration
+	nodeJobRunTimeout  time.Duration
+
+	// runSlots bounds how many allocated runs this peer executes at a
+	// time, runs being executed are tracked in executingRuns
+	runSlots      chan struct{}
+	executingRuns map[uuid.UUID]bool
+	executingMu   *sync.Mutex
+	runWg         *sync.WaitGroup
 
 	wg      *sync.WaitGroup
 	errChan chan error
@@ -63,6 +104,9 @@ func (p *Planner) Init(ctx context.Context) error {
 			p.l.Error("planner error accumulator", "error", err)
 		}
 	}()
+	if p.batchMode {
+		go p.leaderJobSchedule(ctx)
+	}
 	go p.leaderJobAllocation(ctx)
 	go p.leaderJobReconcile(ctx)
 	go p.peerJobExecution(ctx)
@@ -74,22 +118,158 @@ func (p *Planner) Close() error {
 	return nil
 }
 
+// leaderJobSchedule creates runs of all the deployed jobs as their
+// schedule becomes due
+func (p *Planner) leaderJobSchedule(ctx context.Context) {
+	p.wg.Add(1)
+	defer p.wg.Done()
+	for {
+		if p.clusterManager.IsLeader() {
+			deployments, err := p.deploymentRepoFac.New().GetAll(ctx)
+			if err != nil {
+				p.errChan <- err
+			}
+			for _, deployment := range deployments {
+				if err := p.scheduleDeployment(ctx, deployment); err != nil {
+					p.errChan <- err
+				}
+			}
+		}
+
+		// followers keep waiting till they become the leader or are stopped
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(p.sleepTime):
+		}
+	}
+}
+
+// scheduleDeployment creates pending runs of the job which are due since
+// its last scheduled run
+func (p *Planner) scheduleDeployment(ctx context.Context, deployment models.JobDeployment) error {
+	spec := deployment.Spec
+	scheduleTimes, err := getScheduleTimes(spec, deployment.LastScheduledAt, p.now())
+	if err != nil {
+		return err
+	}
+	if len(scheduleTimes) == 0 {
+		return nil
+	}
+
+	runRepo := p.jobRunRepoFac.New()
+	if spec.Behavior.DependsOnPast {
+		// runs are created one by one only after the previous one succeeds
+		scheduleTimes = scheduleTimes[:1]
+		if !deployment.LastScheduledAt.IsZero() {
+			lastRuns, err := runRepo.GetByJobNameAndScheduledAt(ctx, deployment.Namespace.ProjectSpec, spec.Name,
+				deployment.LastScheduledAt, deployment.LastScheduledAt, 0, 1)
+			if err != nil {
+				return err
+			}
+			if len(lastRuns) > 0 && lastRuns[0].Status != models.RunStateSuccess {
+				return nil
+			}
+		}
+	}
+
+	for _, scheduledAt := range scheduleTimes {
+		_, _, err := runRepo.GetByScheduledAt(ctx, spec.ID, scheduledAt)
+		if err == nil {
+			// already created
+			continue
+		}
+		if !errors.Is(err, store.ErrResourceNotFound) {
+			return err
+		}
+		if err := runRepo.Save(ctx, deployment.Namespace, models.JobRun{
+			Spec:        spec,
+			Trigger:     models.TriggerSchedule,
+			Status:      models.RunStatePending,
+			ScheduledAt: scheduledAt,
+			ExecutedAt:  p.now(),
+		}); err != nil {
+			return errors.Wrapf(err, "failed to schedule run of job %s", spec.Name)
+		}
+		p.l.Debug("scheduled job run", "job name", spec.Name, "scheduled at", scheduledAt)
+	}
+	return p.deploymentRepoFac.New().UpdateLastScheduledAt(ctx, deployment.ID, scheduleTimes[len(scheduleTimes)-1])
+}
+
+// getScheduleTimes returns the schedule times of job runs which are due since
+// the last scheduled run. Similar to airflow a run is scheduled once its
+// interval is over, so the first run is scheduled at the first tick after the
+// start date. If job doesn't catch up, only the latest due run is returned
+// and the schedule is walked from just before it
+func getScheduleTimes(spec models.JobSpec, lastScheduledAt, now time.Time) ([]time.Time, error) {
+	if spec.Schedule.Interval == "" {
+		return nil, nil
+	}
+	schedule, err := cron.ParseCronSchedule(spec.Schedule.Interval)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to parse schedule interval of job %s", spec.Name)
+	}
+
+	from := spec.Schedule.StartDate
+	if lastScheduledAt.After(from) {
+		from = lastScheduledAt
+	}
+	if !spec.Behavior.CatchUp {
+		until := now
+		if spec.Schedule.EndDate != nil && spec.Schedule.EndDate.Before(until) {
+			until = *spec.Schedule.EndDate
+		}
+		from = getLookbackStart(schedule, from, until)
+	}
+	var scheduleTimes []time.Time
+	for next := schedule.Next(from); !next.After(now); next = schedule.Next(next) {
+		if spec.Schedule.EndDate != nil && next.After(*spec.Schedule.EndDate) {
+			break
+		}
+		scheduleTimes = append(scheduleTimes, next)
+		if spec.Behavior.CatchUp && len(scheduleTimes) == maxScheduledRunsPerCycle {
+			break
+		}
+	}
+	if !spec.Behavior.CatchUp && len(scheduleTimes) > 1 {
+		scheduleTimes = scheduleTimes[len(scheduleTimes)-1:]
+	}
+	return scheduleTimes, nil
+}
+
+// getLookbackStart finds a time after from which is close enough to until that
+// there are only a few ticks of schedule between them, with the latest tick
+// before until being one of them. Lookback is doubled till a tick is found
+// so the schedule of a job which was never scheduled is not walked from its
+// start date.
+func getLookbackStart(schedule *cron.ScheduleSpec, from, until time.Time) time.Time {
+	for lookback := time.Minute; ; lookback *= 2 {
+		start := until.Add(-lookback)
+		if !start.After(from) {
+			return from
+		}
+		if !schedule.Next(start).After(until) {
+			return start
+		}
+	}
+}
+
 func (p *Planner) leaderJobAllocation(ctx context.Context) {
 	p.wg.Add(1)
 	defer p.wg.Done()
 	loopIdx := 0
 	for {
 		if !p.clusterManager.IsLeader() {
-			time.Sleep(SleepTime)
+			time.Sleep(p.sleepTime)
 			continue
 		}
 
-		allocNodeID, allocRunIDs, err := p.getJobAllocations(ctx)
+		allocations, err := p.getJobAllocations(ctx)
 		if err != nil {
 			p.errChan <- err
 			return
 		}
-		if len(allocRunIDs) > 0 {
+		for allocNodeID, allocRunIDs := range allocations {
 			var stringRunIDs []string
 			for _, ri := range allocRunIDs {
 				stringRunIDs = append(stringRunIDs, ri.String())
@@ -128,60 +308,235 @@ func (p *Planner) leaderJobAllocation(ctx context.Context) {
 			return
 		default:
 			loopIdx++
-			time.Sleep(SleepTime)
+			time.Sleep(p.sleepTime)
 		}
 	}
 }
 
 // getJobAllocations looks for job runs which are in pending state that means
-// they are not allocated to any peer for execution. This allocation is mostly
-// done based on which node has most capacity available for execution.
-// We assume if in case a node goes down, it will come back up for sure
-// and we will not scale down the cluster once its scaled up. This is a
-// temporary approach and ideally we should timeout jobs which are assigned
-// to jobs which went down and move them back to the pending state list.
-func (p *Planner) getJobAllocations(ctx context.Context) (mostCapNodeID string, runIDs []uuid.UUID, err error) {
-	pendingJobRuns, err := p.jobRunRepoFac.New().GetByTrigger(ctx, models.TriggerManual, models.RunStatePending)
+// they are not allocated to any peer for execution. Pending runs still waiting
+// for their upstreams are skipped, rest are ordered by their priority and spread across all alive peers proportionally to the
+// free slots each of them has. Nothing is allocated while allocation is paused
+// and drained peers are never picked.
+// If a node goes down, its allocations are released by the cluster leader
+// and reconciliation moves the affected runs back to the pending state list.
+func (p *Planner) getJobAllocations(ctx context.Context) (map[string][]uuid.UUID, error) {
+	currentState := p.clusterManager.GetState()
+	if currentState.AllocationPaused {
+		return nil, nil
+	}
+
+	runRepo := p.jobRunRepoFac.New()
+	pendingJobRuns, err := p.getJobRuns(ctx, runRepo, models.RunStatePending)
 	if err != nil {
-		return
+		return nil, err
+	}
+	if pendingJobRuns, err = p.getRunnableJobRuns(ctx, runRepo, pendingJobRuns); err != nil {
+		return nil, err
 	}
+	if len(pendingJobRuns) == 0 {
+		return nil, nil
+	}
+	sortByPriority(pendingJobRuns)
 
-	// find which node has most capacity
-	peerUtilization := map[string]int{}
+	// find how many slots are free in each node
+	peerCapacity := map[string]int{}
 	for _, mem := range p.clusterManager.GetClusterMembers() {
-		// it is possible that the allocation state is empty so we need to initialize
-		// the map with 0 for all cluster members first
-		peerUtilization[mem.Name] = 0
+		if mem.Status != serf.StatusAlive || currentState.Drained[mem.Name] {
+			continue
+		}
+		peerCapacity[mem.Name] = p.peerPoolSize
+		if rawCapacity, ok := mem.Tags[gossip.TagCapacity]; ok {
+			if capacity, err := strconv.Atoi(rawCapacity); err == nil && capacity >= 0 {
+				peerCapacity[mem.Name] = capacity
+			}
+		}
 	}
-	currentState := p.clusterManager.GetState()
 	for nodeID, allocationSet := range currentState.Allocation {
+		if _, ok := peerCapacity[nodeID]; !ok {
+			continue
+		}
 		for _, rawAlloc := range allocationSet.Values() {
 			alloc := rawAlloc.(gossip.StateJob)
 			if alloc.Status == models.RunStateAccepted.String() ||
 				alloc.Status == models.RunStateRunning.String() {
-				peerUtilization[nodeID]++
+				peerCapacity[nodeID]--
 			}
 		}
 	}
-	var mostCapSize = PeerPoolSize
-	for nodeID, utilization := range peerUtilization {
-		if utilization < mostCapSize {
-			mostCapNodeID = nodeID
-			mostCapSize = utilization
+	peerFreeSlots := map[string]int{}
+	for nodeID, free := range peerCapacity {
+		if free > 0 {
+			peerFreeSlots[nodeID] = free
 		}
 	}
-	// cluster is full at the moment
-	if mostCapNodeID == "" {
-		return
+
+	allocations := map[string][]uuid.UUID{}
+	shares := distributeSlots(peerFreeSlots, len(pendingJobRuns))
+	runIdx := 0
+	for _, nodeID := range sortedKeys(shares) {
+		for i := 0; i < shares[nodeID]; i++ {
+			allocations[nodeID] = append(allocations[nodeID], pendingJobRuns[runIdx].ID)
+			runIdx++
+		}
 	}
+	return allocations, nil
+}
 
-	for idx, pendingRun := range pendingJobRuns {
-		runIDs = append(runIDs, pendingRun.ID)
-		if idx+mostCapSize > PeerPoolSize {
-			break
+// getJobRuns returns runs in provided states which are executed by the
+// planner, scheduled runs are only executed in batch mode
+func (p *Planner) getJobRuns(ctx context.Context, runRepo store.JobRunRepository, states ...models.JobRunState) ([]models.JobRun, error) {
+	jobRuns, err := runRepo.GetByTrigger(ctx, models.TriggerManual, states...)
+	if err != nil {
+		return nil, err
+	}
+	if p.batchMode {
+		scheduledRuns, err := runRepo.GetByTrigger(ctx, models.TriggerSchedule, states...)
+		if err != nil {
+			return nil, err
 		}
+		jobRuns = append(jobRuns, scheduledRuns...)
 	}
-	return
+	return jobRuns, nil
+}
+
+// getRunnableJobRuns filters out runs which are still waiting for their
+// upstreams, reason of waiting is recorded in the run and cleared once it
+// is ready to be executed
+func (p *Planner) getRunnableJobRuns(ctx context.Context, runRepo store.JobRunRepository, jobRuns []models.JobRun) ([]models.JobRun, error) {
+	var runnableJobRuns []models.JobRun
+	for _, jobRun := range jobRuns {
+		reason, err := p.getWaitReason(ctx, runRepo, jobRun)
+		if err != nil {
+			return nil, err
+		}
+		if reason != jobRun.WaitReason {
+			if err := runRepo.UpdateWaitReason(ctx, jobRun.ID, reason); err != nil {
+				return nil, err
+			}
+		}
+		if reason == "" {
+			runnableJobRuns = append(runnableJobRuns, jobRun)
+		}
+	}
+	return runnableJobRuns, nil
+}
+
+// getWaitReason checks runs of all intra project upstreams scheduled in the
+// window of job run, a run is ready only when all of them are successful.
+// Upstream runs are looked up in (window start, window end] same as the
+// external sensors used with airflow. Manual runs are requested by users for
+// any time and are never held for their upstreams.
+func (p *Planner) getWaitReason(ctx context.Context, runRepo store.JobRunRepository, jobRun models.JobRun) (string, error) {
+	if jobRun.Trigger == models.TriggerManual {
+		return "", nil
+	}
+	var upstreams []string
+	for depName, dep := range jobRun.Spec.Dependencies {
+		if dep.Type == models.JobSpecDependencyTypeIntra {
+			upstreams = append(upstreams, depName)
+		}
+	}
+	if len(upstreams) == 0 {
+		return "", nil
+	}
+	sort.Strings(upstreams)
+
+	// job run listing doesn't carry the namespace it belongs to
+	_, namespace, err := runRepo.GetByID(ctx, jobRun.ID)
+	if err != nil {
+		return "", err
+	}
+	windowStart := jobRun.Spec.Task.Window.GetStart(jobRun.ScheduledAt)
+	windowEnd := jobRun.Spec.Task.Window.GetEnd(jobRun.ScheduledAt)
+	for _, upstream := range upstreams {
+		upstreamRuns, err := runRepo.GetByJobNameAndScheduledAt(ctx, namespace.ProjectSpec, upstream, windowStart, windowEnd, 0, 0)
+		if err != nil {
+			return "", err
+		}
+		found := false
+		for _, upstreamRun := range upstreamRuns {
+			if upstreamRun.ScheduledAt.Equal(windowStart) && windowStart.Before(windowEnd) {
+				continue
+			}
+			if upstreamRun.Status != models.RunStateSuccess {
+				return fmt.Sprintf("waiting for upstream %s scheduled at %s to succeed", upstream,
+					upstreamRun.ScheduledAt.Format(time.RFC3339)), nil
+			}
+			found = true
+		}
+		if !found {
+			return fmt.Sprintf("waiting for upstream %s to run between %s and %s", upstream,
+				windowStart.Format(time.RFC3339), windowEnd.Format(time.RFC3339)), nil
+		}
+	}
+	return "", nil
+}
+
+// sortByPriority orders job runs with highest priority first, runs with same
+// priority are ordered by their schedule time
+func sortByPriority(jobRuns []models.JobRun) {
+	sort.SliceStable(jobRuns, func(i, j int) bool {
+		if jobRuns[i].Spec.Task.Priority != jobRuns[j].Spec.Task.Priority {
+			return jobRuns[i].Spec.Task.Priority > jobRuns[j].Spec.Task.Priority
+		}
+		return jobRuns[i].ScheduledAt.Before(jobRuns[j].ScheduledAt)
+	})
+}
+
+// distributeSlots divides count among nodes proportionally to their free
+// slots, largest remainders are used to distribute what is left after
+// rounding down. A node is never assigned more than its free slots
+func distributeSlots(freeSlots map[string]int, count int) map[string]int {
+	totalFree := 0
+	for _, free := range freeSlots {
+		totalFree += free
+	}
+	if count > totalFree {
+		count = totalFree
+	}
+	shares := map[string]int{}
+	if count <= 0 {
+		return shares
+	}
+
+	type remainder struct {
+		nodeID string
+		value  int
+	}
+	var remainders []remainder
+	assigned := 0
+	for _, nodeID := range sortedKeys(freeSlots) {
+		share := freeSlots[nodeID] * count / totalFree
+		shares[nodeID] = share
+		assigned += share
+		remainders = append(remainders, remainder{
+			nodeID: nodeID,
+			value:  freeSlots[nodeID] * count % totalFree,
+		})
+	}
+	sort.SliceStable(remainders, func(i, j int) bool {
+		return remainders[i].value > remainders[j].value
+	})
+	for i := 0; assigned < count; i++ {
+		shares[remainders[i].nodeID]++
+		assigned++
+	}
+	for nodeID, share := range shares {
+		if share == 0 {
+			delete(shares, nodeID)
+		}
+	}
+	return shares
+}
+
+func sortedKeys(mp map[string]int) []string {
+	var keys []string
+	for key := range mp {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
 }
 
 // leaderJobReconcile should update the job run state from running to
@@ -193,13 +548,13 @@ func (p *Planner) leaderJobReconcile(ctx context.Context) {
 	loopIdx := 0
 	for {
 		if !p.clusterManager.IsLeader() {
-			time.Sleep(SleepTime)
+			time.Sleep(p.sleepTime)
 			continue
 		}
 
 		runRepo := p.jobRunRepoFac.New()
 		// check for non assignment, non terminating states
-		waitingJobs, err := runRepo.GetByTrigger(ctx, models.TriggerManual, models.RunStateAccepted, models.RunStateRunning)
+		waitingJobs, err := p.getJobRuns(ctx, runRepo, models.RunStateAccepted, models.RunStateRunning)
 		if err != nil {
 			p.errChan <- err
 			continue
@@ -209,6 +564,7 @@ func (p *Planner) leaderJobReconcile(ctx context.Context) {
 			// if the job is in non terminating, non assignment state and its not
 			// assigned to a node, we must have lost our WAL, mark it to be rescheduled
 			var allocatedNode string
+			var allocation gossip.StateJob
 			for nodeID, allocSet := range p.clusterManager.GetState().Allocation {
 				if allocatedNode != "" {
 					break
@@ -217,6 +573,7 @@ func (p *Planner) leaderJobReconcile(ctx context.Context) {
 					alloc := rawAlloc.(gossip.StateJob)
 					if alloc.UUID == currentRun.ID.String() {
 						allocatedNode = nodeID
+						allocation = alloc
 						break
 					}
 				}
@@ -229,22 +586,21 @@ func (p *Planner) leaderJobReconcile(ctx context.Context) {
 				p.l.Debug("cleared orphaned run for reassignment", "run id", currentRun.ID)
 				continue
 			}
+			if p.isNodeJobRunTimedOut(currentRun, allocation) {
+				// peer failed to pick it up in time, release it for reassignment
+				if err := p.releaseJobRun(ctx, allocatedNode, currentRun.ID); err != nil {
+					p.errChan <- err
+				}
+				continue
+			}
 
 			// check if we need to update the run state inferred from instance states
 			finalState := currentRun.Status
-			instanceStates := map[models.JobRunState]int{}
-			for _, instance := range currentRun.Instances {
-				instanceStates[instance.Status]++
-			}
-
-			if instanceStates[models.RunStateFailed] > 0 {
+			if plan, err := NewExecutionPlan(currentRun.Spec); err == nil {
+				finalState = inferRunState(currentRun, plan)
+			} else if len(currentRun.Instances) > 0 {
+				// run can't be executed and peer has recorded the reason
 				finalState = models.RunStateFailed
-			} else if instanceStates[models.RunStateSuccess] == len(currentRun.Instances) && len(currentRun.Instances) > 0 {
-				finalState = models.RunStateSuccess
-			} else if instanceStates[models.RunStateRunning] > 0 || len(currentRun.Instances) > 0 {
-				// if anyone is in running or no instances created so far for this job run
-				// we should be in running or no node has picked this run yet
-				finalState = models.RunStateRunning
 			}
 
 			if finalState != currentRun.Status {
@@ -281,160 +637,543 @@ func (p *Planner) leaderJobReconcile(ctx context.Context) {
 			return
 		default:
 			loopIdx++
-			time.Sleep(SleepTime)
+			time.Sleep(p.sleepTime)
 		}
 	}
 }
 
+// isNodeJobRunTimedOut checks if the run is sitting in the allocation of a
+// peer for longer than node job run timeout without being started
+func (p *Planner) isNodeJobRunTimedOut(jobRun models.JobRun, allocation gossip.StateJob) bool {
+	if jobRun.Status != models.RunStateAccepted || allocation.AllocatedAt.IsZero() {
+		return false
+	}
+	return allocation.AllocatedAt.Add(p.nodeJobRunTimeout).Before(p.now())
+}
+
+// releaseJobRun deallocates the run from the peer across cluster and
+// clears it so that it gets planned again
+func (p *Planner) releaseJobRun(ctx context.Context, nodeID string, runID uuid.UUID) error {
+	payload, err := proto.Marshal(&pb.CommandUpdateJob{
+		Patches: []*pb.CommandUpdateJob_Patch{
+			{
+				RunId:  runID.String(),
+				Status: models.RunStatePending.String(),
+			},
+		},
+		PeerId: nodeID,
+	})
+	if err != nil {
+		return err
+	}
+	if err := p.clusterManager.ApplyCommand(&pb.CommandLog{
+		Type:    pb.CommandLogType_COMMAND_LOG_TYPE_UPDATE_JOB,
+		Payload: payload,
+	}); err != nil {
+		return err
+	}
+	if err := p.jobRunRepoFac.New().Clear(ctx, runID); err != nil {
+		return err
+	}
+	p.l.Info("released timed out run for reassignment", "run id", runID, "node id", nodeID)
+	return nil
+}
+
 // peerJobExecution looks for job assigned to this node and executes them
 func (p *Planner) peerJobExecution(ctx context.Context) {
 	p.wg.Add(1)
 	defer p.wg.Done()
 	loopIdx := 0
 	for {
-		runRepo := p.jobRunRepoFac.New()
-
-		// find what we need to execute
-		localNodeID := p.clusterManager.GetLocalMember().Name
-		currentAllocations, ok := p.clusterManager.GetState().Allocation[localNodeID]
-		if ok {
-			for _, rawAlloc := range currentAllocations.Values() {
-				alloc := rawAlloc.(gossip.StateJob)
-				if alloc.Status == models.ReplayStatusAccepted {
-					// execute this
-					runUUID, err := uuid.Parse(alloc.UUID)
-					if err != nil {
-						p.errChan <- err
-						return
-					}
-					jobRun, namespaceSpec, err := runRepo.GetByID(ctx, runUUID)
-					if err != nil {
-						p.errChan <- err
-						return
-					}
-
-					if err := p.executeRun(ctx, namespaceSpec, jobRun); err != nil {
-						p.errChan <- err
-						return
-					}
-				}
-			}
-		}
+		p.executeAllocations(ctx)
 
 		select {
 		case <-ctx.Done():
+			p.runWg.Wait()
 			return
 		default:
 			loopIdx++
-			time.Sleep(SleepTime)
+			time.Sleep(p.sleepTime)
 		}
 	}
 }
 
+// executeAllocations starts executing runs accepted by this node which are
+// not being executed already. Each run is executed in its own goroutine and
+// runs which don't get a free slot are picked in the next cycle
+func (p *Planner) executeAllocations(ctx context.Context) {
+	localNodeID := p.clusterManager.GetLocalMember().Name
+	currentAllocations, ok := p.clusterManager.GetState().Allocation[localNodeID]
+	if !ok {
+		return
+	}
+	for _, rawAlloc := range currentAllocations.Values() {
+		alloc := rawAlloc.(gossip.StateJob)
+		if alloc.Status != models.ReplayStatusAccepted {
+			continue
+		}
+		runUUID, err := uuid.Parse(alloc.UUID)
+		if err != nil {
+			p.errChan <- err
+			continue
+		}
+
+		p.executingMu.Lock()
+		if p.executingRuns[runUUID] {
+			p.executingMu.Unlock()
+			continue
+		}
+		select {
+		case p.runSlots <- struct{}{}:
+		default:
+			// peer is busy
+			p.executingMu.Unlock()
+			return
+		}
+		p.executingRuns[runUUID] = true
+		p.executingMu.Unlock()
+
+		p.runWg.Add(1)
+		go func(runID uuid.UUID) {
+			defer func() {
+				p.executingMu.Lock()
+				delete(p.executingRuns, runID)
+				p.executingMu.Unlock()
+				<-p.runSlots
+				p.runWg.Done()
+			}()
+
+			// run might have been deleted while it is still allocated
+			jobRun, namespaceSpec, err := p.jobRunRepoFac.New().GetByID(ctx, runID)
+			if err != nil {
+				p.errChan <- err
+				return
+			}
+			if err := p.executeRun(ctx, namespaceSpec, jobRun); err != nil {
+				p.errChan <- err
+			}
+		}(runUUID)
+	}
+}
+
 // executeRun finds all tasks/hooks that belong to this run job spec and
 // execute them in order
+// pre hooks are executed before the task, post hooks once the task
+// succeeds and fail hooks if any of the pre hook, task or post hook fails
 // As each context gets executed, its state should be updated in job run
 // instance store
 func (p *Planner) executeRun(ctx context.Context, namespace models.NamespaceSpec, jobRun models.JobRun) error {
-	// for each exec instance in job
-	// - check if job run instances(tasks/hooks) are already in finished state
-	// - if not find which one is not and create/execute them
-	// for now we will only support executing each task of a job and ignore
-	// all attached hooks
-
-	instanceRepo := p.instanceRepoFac.New()
-
-	// first check if this task is already in terminating state
-	for _, instance := range jobRun.Instances {
-		if instance.Type == models.InstanceTypeTask &&
-			instance.Name == jobRun.Spec.Task.Unit.Info().Name {
-			if instance.Status == models.RunStateSuccess || instance.Status == models.RunStateFailed {
-				// already finished
-				return nil
-			}
+	plan, err := NewExecutionPlan(jobRun.Spec)
+	if err != nil {
+		// run can never be executed with this spec
+		return p.failRun(ctx, namespace, jobRun, fmt.Sprintf("invalid execution plan: %s", err))
+	}
 
-			if instance.Status == models.RunStateRunning &&
-				instance.UpdatedAt.Add(InstanceRunTimeout).After(p.now()) {
-				// heartbeat timeout, mark task zombie
-				p.l.Warn("found a zombie instance", "job name", jobRun.Spec.Name, "task name", jobRun.Spec.Task.Unit.Info().Name)
+	var toExecute []models.InstanceSpec
+	for _, hook := range plan.Pre {
+		toExecute = append(toExecute, models.InstanceSpec{Name: hook.Unit.Info().Name, Type: models.InstanceTypeHook})
+	}
+	toExecute = append(toExecute, models.InstanceSpec{Name: jobRun.Spec.Task.Unit.Info().Name, Type: models.InstanceTypeTask})
+	for _, hook := range plan.Post {
+		toExecute = append(toExecute, models.InstanceSpec{Name: hook.Unit.Info().Name, Type: models.InstanceTypeHook})
+	}
 
-				// cancel task and move back state to accepted
-				if err := instanceRepo.UpdateStatus(ctx, instance.ID, models.RunStateAccepted); err != nil {
-					return err
-				}
+	failed := false
+	for _, instance := range toExecute {
+		state, err := p.executeInstance(ctx, namespace, jobRun, instance.Type, instance.Name)
+		if err != nil {
+			return err
+		}
+		if state == models.RunStateFailed {
+			failed = true
+			break
+		}
+		if state != models.RunStateSuccess {
+			// instance is not finished yet, will be picked again later
+			return nil
+		}
+	}
+	if !failed {
+		p.l.Info("finished executing job spec", "job name", jobRun.Spec.Name)
+		return nil
+	}
 
-				return p.executor.Stop(ctx, models.ExecutorStopRequest{
-					ID:     instance.ID.String(),
-					Signal: "SIGKILL",
-				})
-			}
+	if !p.isAllocatedLocally(jobRun.ID) {
+		// run was cancelled, fail hooks are not executed
+		p.l.Info("stopped executing cancelled job spec", "job name", jobRun.Spec.Name)
+		return nil
+	}
+
+	// something failed, execute all fail hooks
+	for _, hook := range plan.Fail {
+		state, err := p.executeInstance(ctx, namespace, jobRun, models.InstanceTypeHook, hook.Unit.Info().Name)
+		if err != nil {
+			return err
 		}
+		if state != models.RunStateSuccess && state != models.RunStateFailed {
+			return nil
+		}
+	}
+	p.l.Info("finished executing job spec with failure", "job name", jobRun.Spec.Name)
+	return nil
+}
+
+// failRun records the reason of failure on the task instance of a run which
+// can't be executed, leader marks the run failed once it is recorded
+func (p *Planner) failRun(ctx context.Context, namespace models.NamespaceSpec, jobRun models.JobRun, reason string) error {
+	taskName := jobRun.Spec.Task.Unit.Info().Name
+	if instance, err := jobRun.GetInstance(taskName, models.InstanceTypeTask); err == nil && instance.Status == models.RunStateFailed {
+		// already recorded
+		return nil
 	}
+	p.l.Warn("failing job run", "job name", jobRun.Spec.Name, "reason", reason)
 
-	// create an instance
-	instanceID, err := p.uuidProvider.NewUUID()
+	instance, err := p.runService.Register(ctx, namespace, jobRun, models.InstanceTypeTask, taskName)
 	if err != nil {
 		return err
 	}
-	newInstance := models.InstanceSpec{
-		ID:     instanceID,
-		Name:   jobRun.Spec.Task.Unit.Info().Name,
-		Type:   models.InstanceTypeTask,
-		Status: models.RunStateAccepted,
+	instance.Status = models.RunStateFailed
+	instance.ExitCode = noExitCode
+	instance.Reason = reason
+	instance.FinishedAt = p.now()
+	return p.instanceRepoFac.New().UpdateExecution(ctx, instance.ID, instance)
+}
+
+// executeInstance creates an instance of provided task/hook in job run
+// and executes it till completion. It returns the final state of the instance.
+// If the instance was already executed before, its existing state is returned
+func (p *Planner) executeInstance(ctx context.Context, namespace models.NamespaceSpec, jobRun models.JobRun,
+	instanceType models.InstanceType, instanceName string) (models.JobRunState, error) {
+	// first check if this instance is already in terminating state
+	if instance, err := jobRun.GetInstance(instanceName, instanceType); err == nil {
+		if instance.Status == models.RunStateRunning && instance.UpdatedAt.Add(p.instanceRunTimeout).Before(p.now()) {
+			// heartbeat timeout, mark instance zombie
+			p.l.Warn("found a zombie instance", "job name", jobRun.Spec.Name, "instance name", instanceName)
+
+			// executor might have lost track of it already if peer restarted
+			if err := p.executor.Stop(ctx, models.ExecutorStopRequest{
+				ID:     instance.ID.String(),
+				Signal: "SIGKILL",
+			}); err != nil {
+				p.l.Warn("failed to stop zombie instance", "instance id", instance.ID, "error", err)
+			}
+			return p.finishInstance(ctx, jobRun, instance, noExitCode, reasonZombie)
+		}
+
+		if instance.Status == models.RunStateFailed && canRetryInstance(jobRun.Spec, instance) {
+			if p.now().Before(getRetryTime(jobRun.Spec, instance)) {
+				// wait for the backoff to pass, will be picked again later
+				return models.RunStateRunning, nil
+			}
+			p.l.Info("retrying failed job instance", "job name", jobRun.Spec.Name,
+				"instance name", instanceName, "attempt", instance.Attempt+1)
+		} else if instance.Status == models.RunStateSuccess || instance.Status == models.RunStateFailed {
+			// already finished
+			return instance.Status, nil
+		}
 	}
-	if err := p.jobRunRepoFac.New().AddInstance(ctx, namespace, jobRun, newInstance); err != nil {
-		return err
+
+	// runs are read without secrets of their project, resolve them from
+	// the secret backend of project for templating instance context
+	projectSpec, err := p.projectRepoFac.New().GetByName(ctx, namespace.ProjectSpec.Name)
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to resolve secrets of project %s", namespace.ProjectSpec.Name)
+	}
+	namespace.ProjectSpec.Secret = projectSpec.Secret
+
+	// create an instance with its run context
+	newInstance, err := p.runService.Register(ctx, namespace, jobRun, instanceType, instanceName)
+	if err != nil {
+		return "", err
 	}
 
 	// send it to executor for execution
-	p.l.Info("starting executing job", "job name", jobRun.Spec.Name)
+	p.l.Info("starting executing job instance", "job name", jobRun.Spec.Name,
+		"instance name", instanceName, "instance type", instanceType)
+	newInstance.StartedAt = p.now()
 	_, err = p.executor.Start(ctx, models.ExecutorStartRequest{
 		ID:        newInstance.ID.String(),
 		Job:       jobRun.Spec,
 		Namespace: namespace,
+		JobRun:    jobRun,
+		Instance:  newInstance,
 	})
 	if err != nil {
-		return err
+		return p.finishInstance(ctx, jobRun, newInstance, noExitCode, fmt.Sprintf("executor error: %s", err))
 	}
-	if err := p.instanceRepoFac.New().UpdateStatus(ctx, instanceID, models.RunStateRunning); err != nil {
-		return err
+	if err := p.instanceRepoFac.New().UpdateExecution(ctx, newInstance.ID, newInstance); err != nil {
+		return "", err
 	}
 
-	// block until the given task finishes
+	// block until the given instance finishes
 	finishChan, err := p.executor.WaitForFinish(ctx, newInstance.ID.String())
 	if err != nil {
-		return err
+		return p.finishInstance(ctx, jobRun, newInstance, noExitCode, fmt.Sprintf("executor error: %s", err))
 	}
-	finishCode := <-finishChan
+	var reason string
+	var finishCode int
+	timeout := time.After(p.instanceRunTimeout)
+	cancelCheck := time.NewTicker(p.sleepTime)
+	defer cancelCheck.Stop()
+	for waiting := true; waiting; {
+		select {
+		case finishCode = <-finishChan:
+			waiting = false
+		case <-timeout:
+			p.l.Warn("job instance timed out", "job name", jobRun.Spec.Name,
+				"instance name", instanceName, "instance type", instanceType)
+			if err := p.executor.Stop(ctx, models.ExecutorStopRequest{
+				ID:     newInstance.ID.String(),
+				Signal: "SIGKILL",
+			}); err != nil {
+				return "", err
+			}
+			finishCode = <-finishChan
+			reason = fmt.Sprintf("timed out after %s", p.instanceRunTimeout)
+			waiting = false
+		case <-cancelCheck.C:
+			if p.isAllocatedLocally(jobRun.ID) {
+				continue
+			}
+			p.l.Warn("job instance cancelled", "job name", jobRun.Spec.Name,
+				"instance name", instanceName, "instance type", instanceType)
+			if err := p.executor.Stop(ctx, models.ExecutorStopRequest{
+				ID:     newInstance.ID.String(),
+				Signal: "SIGKILL",
+			}); err != nil {
+				return "", err
+			}
+			finishCode = <-finishChan
+			reason = reasonCancelled
+			waiting = false
+		case <-ctx.Done():
+			return "", ctx.Err()
+		}
+	}
+	p.logInstanceOutput(ctx, jobRun, newInstance)
 	if finishCode != 0 {
-		p.l.Warn("job finished with non zero code", "code", finishCode, "job name", jobRun.Spec.Name)
+		p.l.Warn("job instance finished with non zero code", "code", finishCode, "job name", jobRun.Spec.Name,
+			"instance name", instanceName, "instance type", instanceType)
+		if reason == "" {
+			reason = fmt.Sprintf("exited with code %d", finishCode)
+		}
+	}
+	return p.finishInstance(ctx, jobRun, newInstance, finishCode, reason)
+}
 
-		// mark instance failed
-		if err := instanceRepo.UpdateStatus(ctx, newInstance.ID, models.RunStateFailed); err != nil {
-			return err
+// logInstanceOutput reads the output of finished instance from executor,
+// executors are free to drop it once it is read
+func (p *Planner) logInstanceOutput(ctx context.Context, jobRun models.JobRun, instance models.InstanceSpec) {
+	stats, err := p.executor.Stats(ctx, instance.ID.String())
+	if err != nil {
+		p.l.Warn("failed to read job instance logs", "job name", jobRun.Spec.Name,
+			"instance name", instance.Name, "error", err)
+		return
+	}
+	p.l.Info("job instance logs", "job name", jobRun.Spec.Name, "instance name", instance.Name,
+		"instance type", instance.Type, "status", stats.Status, "logs", string(stats.Logs))
+}
+
+// isAllocatedLocally checks if the run is still allocated to this peer, runs
+// are deallocated when they are cancelled or released by the leader
+func (p *Planner) isAllocatedLocally(runID uuid.UUID) bool {
+	allocations, ok := p.clusterManager.GetState().Allocation[p.clusterManager.GetLocalMember().Name]
+	if !ok {
+		return false
+	}
+	for _, rawAlloc := range allocations.Values() {
+		if rawAlloc.(gossip.StateJob).UUID == runID.String() {
+			return true
 		}
 	}
+	return false
+}
+
+// finishInstance records the result of instance execution, instance is
+// failed if it finished with a non zero code or a reason. Returned state is
+// running if the failed instance will be retried
+func (p *Planner) finishInstance(ctx context.Context, jobRun models.JobRun, instance models.InstanceSpec,
+	exitCode int, reason string) (models.JobRunState, error) {
+	instance.Status = models.RunStateSuccess
+	if exitCode != 0 || reason != "" {
+		instance.Status = models.RunStateFailed
+	}
+	instance.ExitCode = exitCode
+	instance.Reason = reason
+	instance.FinishedAt = p.now()
+	if err := p.instanceRepoFac.New().UpdateExecution(ctx, instance.ID, instance); err != nil {
+		return "", err
+	}
+	if instance.Status == models.RunStateFailed && canRetryInstance(jobRun.Spec, instance) {
+		// instance will be retried once the backoff passes
+		return models.RunStateRunning, nil
+	}
+	return instance.Status, nil
+}
+
+// canRetryInstance checks if a failed task instance has attempts left as
+// per the retry policy of job, hooks are not retried
+func canRetryInstance(spec models.JobSpec, instance models.InstanceSpec) bool {
+	if instance.Type != models.InstanceTypeTask {
+		return false
+	}
+	attempt := instance.Attempt
+	if attempt < 1 {
+		attempt = 1
+	}
+	return attempt <= spec.Behavior.Retry.Count
+}
 
-	// mark instance success
-	if err := instanceRepo.UpdateStatus(ctx, newInstance.ID, models.RunStateSuccess); err != nil {
+// getRetryTime returns when the failed instance should be attempted again,
+// with exponential backoff the delay doubles after every attempt
+func getRetryTime(spec models.JobSpec, instance models.InstanceSpec) time.Time {
+	delay := spec.Behavior.Retry.Delay
+	if spec.Behavior.Retry.ExponentialBackoff && instance.Attempt > 1 {
+		delay *= time.Duration(1 << uint(instance.Attempt-1))
+	}
+	return instance.UpdatedAt.Add(delay)
+}
+
+// GetState returns members of the cluster along with the runs allocated to
+// each of them as replicated in local state
+func (p *Planner) GetState(ctx context.Context) (models.ClusterState, error) {
+	currentState := p.clusterManager.GetState()
+	clusterState := models.ClusterState{
+		Leader:           p.clusterManager.GetLeader(),
+		AllocationPaused: currentState.AllocationPaused,
+	}
+	for _, mem := range p.clusterManager.GetClusterMembers() {
+		member := models.ClusterMember{
+			Name:    mem.Name,
+			Address: mem.Tags["raftAddr"],
+			Status:  mem.Status.String(),
+			Drained: currentState.Drained[mem.Name],
+		}
+		if allocationSet, ok := currentState.Allocation[mem.Name]; ok {
+			for _, rawAlloc := range allocationSet.Values() {
+				alloc := rawAlloc.(gossip.StateJob)
+				member.Allocations = append(member.Allocations, models.ClusterAllocation{
+					RunID:       alloc.UUID,
+					Status:      alloc.Status,
+					AllocatedAt: alloc.AllocatedAt,
+				})
+			}
+			sort.Slice(member.Allocations, func(i, j int) bool {
+				return member.Allocations[i].AllocatedAt.Before(member.Allocations[j].AllocatedAt)
+			})
+		}
+		clusterState.Members = append(clusterState.Members, member)
+	}
+	sort.Slice(clusterState.Members, func(i, j int) bool {
+		return clusterState.Members[i].Name < clusterState.Members[j].Name
+	})
+	return clusterState, nil
+}
+
+// CancelJobRun marks the run failed and deallocates it across the cluster,
+// the peer executing it stops the running instance on noticing the change
+func (p *Planner) CancelJobRun(ctx context.Context, runID uuid.UUID) error {
+	if !p.clusterManager.IsLeader() {
+		return models.ErrNotClusterLeader
+	}
+	runRepo := p.jobRunRepoFac.New()
+	jobRun, _, err := runRepo.GetByID(ctx, runID)
+	if err != nil {
 		return err
 	}
-	p.l.Info("finished executing job spec", "job name", jobRun.Spec.Name)
-	return nil
+	if jobRun.Status == models.RunStateSuccess || jobRun.Status == models.RunStateFailed {
+		return errors.Errorf("job run is already finished with %s", jobRun.Status)
+	}
+
+	// mark it failed first so that reconciliation doesn't reschedule the
+	// run once it is deallocated
+	if err := runRepo.UpdateStatus(ctx, runID, models.RunStateFailed); err != nil {
+		return err
+	}
+	return p.applyCommand(pb.CommandLogType_COMMAND_LOG_TYPE_CANCEL_JOB, &pb.CommandCancelJob{
+		RunId: runID.String(),
+	})
+}
+
+// DrainPeer stops or resumes allocation of new runs to the peer, runs
+// already allocated to it are executed as usual
+func (p *Planner) DrainPeer(ctx context.Context, peerID string, drain bool) error {
+	if !p.clusterManager.IsLeader() {
+		return models.ErrNotClusterLeader
+	}
+	found := false
+	for _, mem := range p.clusterManager.GetClusterMembers() {
+		if mem.Name == peerID {
+			found = true
+			break
+		}
+	}
+	if !found {
+		return errors.Errorf("peer %s is not a member of cluster", peerID)
+	}
+	return p.applyCommand(pb.CommandLogType_COMMAND_LOG_TYPE_DRAIN_PEER, &pb.CommandDrainPeer{
+		PeerId: peerID,
+		Drain:  drain,
+	})
+}
+
+// PauseAllocation stops or resumes allocation of new runs to all peers
+func (p *Planner) PauseAllocation(ctx context.Context, pause bool) error {
+	if !p.clusterManager.IsLeader() {
+		return models.ErrNotClusterLeader
+	}
+	return p.applyCommand(pb.CommandLogType_COMMAND_LOG_TYPE_PAUSE_ALLOCATION, &pb.CommandPauseAllocation{
+		Pause: pause,
+	})
+}
+
+func (p *Planner) applyCommand(cmdType pb.CommandLogType, cmd proto.Message) error {
+	payload, err := proto.Marshal(cmd)
+	if err != nil {
+		return err
+	}
+	return p.clusterManager.ApplyCommand(&pb.CommandLog{
+		Type:    cmdType,
+		Payload: payload,
+	})
 }
 
 func NewPlanner(l log.Logger, sv ClusterManager, jobRunRepoFac RunRepoFactory,
-	instanceRepoFactory InstanceRepoFactory, uuidProvider utils.UUIDProvider,
-	executor models.ExecutorUnit, now func() time.Time) *Planner {
-	return &Planner{
-		l:               l,
-		clusterManager:  sv,
-		jobRunRepoFac:   jobRunRepoFac,
-		instanceRepoFac: instanceRepoFactory,
-		executor:        executor,
-		uuidProvider:    uuidProvider,
-		now:             now,
-		wg:              new(sync.WaitGroup),
-		errChan:         make(chan error),
+	instanceRepoFactory InstanceRepoFactory, deploymentRepoFac DeploymentRepoFactory, projectRepoFac ProjectRepoFactory,
+	runService models.RunService, executor models.ExecutorUnit, conf config.SchedulerConfig, now func() time.Time) *Planner {
+	planner := &Planner{
+		l:                  l,
+		clusterManager:     sv,
+		jobRunRepoFac:      jobRunRepoFac,
+		instanceRepoFac:    instanceRepoFactory,
+		deploymentRepoFac:  deploymentRepoFac,
+		projectRepoFac:     projectRepoFac,
+		executor:           executor,
+		runService:         runService,
+		batchMode:          conf.Name == Name,
+		peerPoolSize:       conf.Capacity,
+		sleepTime:          conf.PlannerInterval,
+		instanceRunTimeout: conf.InstanceRunTimeout,
+		nodeJobRunTimeout:  conf.NodeJobRunTimeout,
+		now:                now,
+		executingRuns:      map[uuid.UUID]bool{},
+		executingMu:        new(sync.Mutex),
+		runWg:              new(sync.WaitGroup),
+		wg:                 new(sync.WaitGroup),
+		errChan:            make(chan error),
+	}
+	if planner.peerPoolSize <= 0 {
+		planner.peerPoolSize = DefaultPeerPoolSize
+	}
+	planner.runSlots = make(chan struct{}, planner.peerPoolSize)
+	if planner.sleepTime <= 0 {
+		planner.sleepTime = DefaultSleepTime
+	}
+	if planner.instanceRunTimeout <= 0 {
+		planner.instanceRunTimeout = DefaultInstanceRunTimeout
+	}
+	if planner.nodeJobRunTimeout <= 0 {
+		planner.nodeJobRunTimeout = DefaultNodeJobRunTimeout
 	}
+	return planner
 }
@@ -0,0 +1,948 @@
+package prime
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/serf/serf"
+	pb "github.com/odpf/optimus/api/proto/odpf/optimus/cluster/v1beta1"
+	"github.com/odpf/optimus/config"
+	"github.com/odpf/optimus/core/gossip"
+	"github.com/odpf/optimus/core/set"
+	"github.com/odpf/optimus/mock"
+	"github.com/odpf/optimus/models"
+	"github.com/odpf/optimus/run"
+	"github.com/odpf/optimus/store"
+	"github.com/odpf/salt/log"
+	"github.com/stretchr/testify/assert"
+	mock2 "github.com/stretchr/testify/mock"
+	"google.golang.org/protobuf/proto"
+)
+
+type testClusterManager struct {
+	isLeader bool
+	leader   string
+	members  []serf.Member
+	state    gossip.State
+	commands []*pb.CommandLog
+}
+
+func (c *testClusterManager) IsLeader() bool {
+	return c.isLeader
+}
+
+func (c *testClusterManager) GetLeader() string {
+	return c.leader
+}
+
+func (c *testClusterManager) ApplyCommand(cmd *pb.CommandLog) error {
+	c.commands = append(c.commands, cmd)
+	return nil
+}
+
+func (c *testClusterManager) GetState() gossip.State {
+	return c.state
+}
+
+func (c *testClusterManager) GetClusterMembers() []serf.Member {
+	return c.members
+}
+
+func (c *testClusterManager) GetLocalMember() serf.Member {
+	return c.members[0]
+}
+
+type testExecutor struct {
+	started  []models.ExecutorStartRequest
+	startErr error
+	exitCode int
+	stopped  []string
+	// ids of executions whose stats are read
+	statsRead []string
+
+	// running instances only finish once they are stopped
+	running    bool
+	finishChan chan int
+}
+
+func (e *testExecutor) Start(ctx context.Context, req models.ExecutorStartRequest) (*models.ExecutorStartResponse, error) {
+	e.started = append(e.started, req)
+	return &models.ExecutorStartResponse{}, e.startErr
+}
+
+func (e *testExecutor) Stop(ctx context.Context, req models.ExecutorStopRequest) error {
+	e.stopped = append(e.stopped, req.ID)
+	if e.running && e.finishChan != nil {
+		e.finishChan <- noExitCode
+	}
+	return nil
+}
+
+func (e *testExecutor) WaitForFinish(ctx context.Context, id string) (chan int, error) {
+	e.finishChan = make(chan int, 1)
+	if !e.running {
+		e.finishChan <- e.exitCode
+	}
+	return e.finishChan, nil
+}
+
+func (e *testExecutor) Stats(ctx context.Context, id string) (*models.ExecutorStats, error) {
+	e.statsRead = append(e.statsRead, id)
+	return &models.ExecutorStats{}, nil
+}
+
+func newTestMember(name string, capacity string, status serf.MemberStatus) serf.Member {
+	tags := map[string]string{}
+	if capacity != "" {
+		tags[gossip.TagCapacity] = capacity
+	}
+	return serf.Member{Name: name, Tags: tags, Status: status}
+}
+
+func newTestProjectRepoFac(ctx context.Context, projectSpec models.ProjectSpec) *mock.ProjectRepoFactory {
+	projectRepo := new(mock.ProjectRepository)
+	projectRepo.On("GetByName", ctx, projectSpec.Name).Return(projectSpec, nil)
+	projectRepoFac := new(mock.ProjectRepoFactory)
+	projectRepoFac.On("New").Return(projectRepo)
+	return projectRepoFac
+}
+
+func TestPlanner(t *testing.T) {
+	ctx := context.Background()
+	now := func() time.Time { return time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC) }
+
+	t.Run("distributeSlots", func(t *testing.T) {
+		t.Run("should distribute proportionally to free slots", func(t *testing.T) {
+			shares := distributeSlots(map[string]int{"node-1": 6, "node-2": 3, "node-3": 1}, 5)
+			assert.Equal(t, map[string]int{"node-1": 3, "node-2": 2}, shares)
+		})
+		t.Run("should not assign more than free slots", func(t *testing.T) {
+			shares := distributeSlots(map[string]int{"node-1": 2, "node-2": 1}, 10)
+			assert.Equal(t, map[string]int{"node-1": 2, "node-2": 1}, shares)
+		})
+		t.Run("should return nothing if cluster is full", func(t *testing.T) {
+			shares := distributeSlots(map[string]int{}, 10)
+			assert.Equal(t, map[string]int{}, shares)
+		})
+	})
+	t.Run("getJobAllocations", func(t *testing.T) {
+		t.Run("should allocate runs by priority across alive members using advertised capacity", func(t *testing.T) {
+			lowPriorityRun := models.JobRun{ID: uuid.Must(uuid.NewRandom()), Spec: models.JobSpec{Task: models.JobSpecTask{Priority: 10}}}
+			highPriorityRun := models.JobRun{ID: uuid.Must(uuid.NewRandom()), Spec: models.JobSpec{Task: models.JobSpecTask{Priority: 100}}}
+			midPriorityRun := models.JobRun{ID: uuid.Must(uuid.NewRandom()), Spec: models.JobSpec{Task: models.JobSpecTask{Priority: 50}}}
+
+			jobRunRepo := new(mock.JobRunRepository)
+			jobRunRepo.On("GetByTrigger", ctx, models.TriggerManual, []models.JobRunState{models.RunStatePending}).
+				Return([]models.JobRun{lowPriorityRun, highPriorityRun, midPriorityRun}, nil)
+			defer jobRunRepo.AssertExpectations(t)
+			jobRunRepoFac := new(mock.JobRunRepoFactory)
+			jobRunRepoFac.On("New").Return(jobRunRepo)
+			defer jobRunRepoFac.AssertExpectations(t)
+
+			node1Alloc := set.NewHashSet()
+			node1Alloc.Add(gossip.StateJob{UUID: "a", Status: models.RunStateRunning.String()})
+			cluster := &testClusterManager{
+				members: []serf.Member{
+					newTestMember("node-1", "2", serf.StatusAlive),
+					newTestMember("node-2", "", serf.StatusAlive),
+					newTestMember("node-3", "10", serf.StatusFailed),
+				},
+				state: gossip.State{Allocation: map[string]set.Set{"node-1": node1Alloc}},
+			}
+			planner := NewPlanner(log.NewNoop(), cluster, jobRunRepoFac, nil, nil, nil, nil, nil,
+				config.SchedulerConfig{Capacity: 2}, now)
+
+			allocations, err := planner.getJobAllocations(ctx)
+			assert.Nil(t, err)
+			assert.Equal(t, map[string][]uuid.UUID{
+				"node-1": {highPriorityRun.ID},
+				"node-2": {midPriorityRun.ID, lowPriorityRun.ID},
+			}, allocations)
+		})
+		t.Run("should not allocate anything when all members are full", func(t *testing.T) {
+			pendingRun := models.JobRun{ID: uuid.Must(uuid.NewRandom())}
+			jobRunRepo := new(mock.JobRunRepository)
+			jobRunRepo.On("GetByTrigger", ctx, models.TriggerManual, []models.JobRunState{models.RunStatePending}).
+				Return([]models.JobRun{pendingRun}, nil)
+			defer jobRunRepo.AssertExpectations(t)
+			jobRunRepoFac := new(mock.JobRunRepoFactory)
+			jobRunRepoFac.On("New").Return(jobRunRepo)
+			defer jobRunRepoFac.AssertExpectations(t)
+
+			node1Alloc := set.NewHashSet()
+			node1Alloc.Add(gossip.StateJob{UUID: "a", Status: models.RunStateAccepted.String()})
+			cluster := &testClusterManager{
+				members: []serf.Member{newTestMember("node-1", "1", serf.StatusAlive)},
+				state:   gossip.State{Allocation: map[string]set.Set{"node-1": node1Alloc}},
+			}
+			planner := NewPlanner(log.NewNoop(), cluster, jobRunRepoFac, nil, nil, nil, nil, nil,
+				config.SchedulerConfig{}, now)
+
+			allocations, err := planner.getJobAllocations(ctx)
+			assert.Nil(t, err)
+			assert.Empty(t, allocations)
+		})
+		t.Run("should not allocate to drained members", func(t *testing.T) {
+			pendingRun := models.JobRun{ID: uuid.Must(uuid.NewRandom())}
+			jobRunRepo := new(mock.JobRunRepository)
+			jobRunRepo.On("GetByTrigger", ctx, models.TriggerManual, []models.JobRunState{models.RunStatePending}).
+				Return([]models.JobRun{pendingRun}, nil)
+			defer jobRunRepo.AssertExpectations(t)
+			jobRunRepoFac := new(mock.JobRunRepoFactory)
+			jobRunRepoFac.On("New").Return(jobRunRepo)
+			defer jobRunRepoFac.AssertExpectations(t)
+
+			cluster := &testClusterManager{
+				members: []serf.Member{
+					newTestMember("node-1", "", serf.StatusAlive),
+					newTestMember("node-2", "", serf.StatusAlive),
+				},
+				state: gossip.State{Drained: map[string]bool{"node-1": true}},
+			}
+			planner := NewPlanner(log.NewNoop(), cluster, jobRunRepoFac, nil, nil, nil, nil, nil,
+				config.SchedulerConfig{}, now)
+
+			allocations, err := planner.getJobAllocations(ctx)
+			assert.Nil(t, err)
+			assert.Equal(t, map[string][]uuid.UUID{"node-2": {pendingRun.ID}}, allocations)
+		})
+		t.Run("should not allocate anything while allocation is paused", func(t *testing.T) {
+			cluster := &testClusterManager{
+				members: []serf.Member{newTestMember("node-1", "", serf.StatusAlive)},
+				state:   gossip.State{AllocationPaused: true},
+			}
+			planner := NewPlanner(log.NewNoop(), cluster, new(mock.JobRunRepoFactory), nil, nil, nil, nil, nil,
+				config.SchedulerConfig{}, now)
+
+			allocations, err := planner.getJobAllocations(ctx)
+			assert.Nil(t, err)
+			assert.Empty(t, allocations)
+		})
+	})
+	t.Run("getRunnableJobRuns", func(t *testing.T) {
+		projectSpec := models.ProjectSpec{ID: uuid.Must(uuid.NewRandom()), Name: "project"}
+		namespaceSpec := models.NamespaceSpec{ID: uuid.Must(uuid.NewRandom()), Name: "namespace", ProjectSpec: projectSpec}
+		newRun := func() models.JobRun {
+			return models.JobRun{
+				ID: uuid.Must(uuid.NewRandom()),
+				Spec: models.JobSpec{
+					Name: "downstream",
+					Task: models.JobSpecTask{
+						Window: models.JobSpecTaskWindow{Size: time.Hour * 24, TruncateTo: "d"},
+					},
+					Dependencies: map[string]models.JobSpecDependency{
+						"upstream":       {Type: models.JobSpecDependencyTypeIntra},
+						"other/upstream": {Type: models.JobSpecDependencyTypeInter},
+					},
+				},
+				Status:      models.RunStatePending,
+				Trigger:     models.TriggerSchedule,
+				ScheduledAt: now(),
+			}
+		}
+		windowStart := now().Add(-time.Hour * 24)
+
+		t.Run("should return runs without intra dependencies right away", func(t *testing.T) {
+			jobRun := models.JobRun{ID: uuid.Must(uuid.NewRandom())}
+			planner := NewPlanner(log.NewNoop(), &testClusterManager{}, nil, nil, nil, nil, nil, nil, config.SchedulerConfig{}, now)
+			runs, err := planner.getRunnableJobRuns(ctx, new(mock.JobRunRepository), []models.JobRun{jobRun})
+			assert.Nil(t, err)
+			assert.Equal(t, []models.JobRun{jobRun}, runs)
+		})
+		t.Run("should return run and clear its wait reason if all upstream runs in window succeeded", func(t *testing.T) {
+			jobRun := newRun()
+			jobRun.WaitReason = "waiting"
+			jobRunRepo := new(mock.JobRunRepository)
+			jobRunRepo.On("GetByID", ctx, jobRun.ID).Return(jobRun, namespaceSpec, nil)
+			jobRunRepo.On("GetByJobNameAndScheduledAt", ctx, projectSpec, "upstream", windowStart, now(), 0, 0).
+				Return([]models.JobRun{
+					{ScheduledAt: windowStart, Status: models.RunStateFailed},
+					{ScheduledAt: now(), Status: models.RunStateSuccess},
+				}, nil)
+			jobRunRepo.On("UpdateWaitReason", ctx, jobRun.ID, "").Return(nil)
+			defer jobRunRepo.AssertExpectations(t)
+
+			planner := NewPlanner(log.NewNoop(), &testClusterManager{}, nil, nil, nil, nil, nil, nil, config.SchedulerConfig{}, now)
+			runs, err := planner.getRunnableJobRuns(ctx, jobRunRepo, []models.JobRun{jobRun})
+			assert.Nil(t, err)
+			assert.Equal(t, []models.JobRun{jobRun}, runs)
+		})
+		t.Run("should hold run if upstream has not succeeded in window", func(t *testing.T) {
+			jobRun := newRun()
+			jobRunRepo := new(mock.JobRunRepository)
+			jobRunRepo.On("GetByID", ctx, jobRun.ID).Return(jobRun, namespaceSpec, nil)
+			jobRunRepo.On("GetByJobNameAndScheduledAt", ctx, projectSpec, "upstream", windowStart, now(), 0, 0).
+				Return([]models.JobRun{{ScheduledAt: now(), Status: models.RunStateRunning}}, nil)
+			jobRunRepo.On("UpdateWaitReason", ctx, jobRun.ID,
+				"waiting for upstream upstream scheduled at 2021-01-01T00:00:00Z to succeed").Return(nil)
+			defer jobRunRepo.AssertExpectations(t)
+
+			planner := NewPlanner(log.NewNoop(), &testClusterManager{}, nil, nil, nil, nil, nil, nil, config.SchedulerConfig{}, now)
+			runs, err := planner.getRunnableJobRuns(ctx, jobRunRepo, []models.JobRun{jobRun})
+			assert.Nil(t, err)
+			assert.Empty(t, runs)
+		})
+		t.Run("should return manual run without checking its upstreams", func(t *testing.T) {
+			jobRun := newRun()
+			jobRun.Trigger = models.TriggerManual
+			jobRunRepo := new(mock.JobRunRepository)
+			defer jobRunRepo.AssertExpectations(t)
+
+			planner := NewPlanner(log.NewNoop(), &testClusterManager{}, nil, nil, nil, nil, nil, nil, config.SchedulerConfig{}, now)
+			runs, err := planner.getRunnableJobRuns(ctx, jobRunRepo, []models.JobRun{jobRun})
+			assert.Nil(t, err)
+			assert.Equal(t, []models.JobRun{jobRun}, runs)
+		})
+		t.Run("should hold run if upstream has no run in window", func(t *testing.T) {
+			jobRun := newRun()
+			jobRun.WaitReason = "waiting for upstream upstream to run between 2020-12-31T00:00:00Z and 2021-01-01T00:00:00Z"
+			jobRunRepo := new(mock.JobRunRepository)
+			jobRunRepo.On("GetByID", ctx, jobRun.ID).Return(jobRun, namespaceSpec, nil)
+			jobRunRepo.On("GetByJobNameAndScheduledAt", ctx, projectSpec, "upstream", windowStart, now(), 0, 0).
+				Return([]models.JobRun{}, nil)
+			defer jobRunRepo.AssertExpectations(t)
+
+			planner := NewPlanner(log.NewNoop(), &testClusterManager{}, nil, nil, nil, nil, nil, nil, config.SchedulerConfig{}, now)
+			runs, err := planner.getRunnableJobRuns(ctx, jobRunRepo, []models.JobRun{jobRun})
+			assert.Nil(t, err)
+			assert.Empty(t, runs)
+		})
+	})
+	t.Run("getScheduleTimes", func(t *testing.T) {
+		startDate := time.Date(2020, 12, 29, 0, 0, 0, 0, time.UTC)
+		t.Run("should return all due times since start date if job catches up", func(t *testing.T) {
+			spec := models.JobSpec{
+				Schedule: models.JobSpecSchedule{StartDate: startDate, Interval: "0 0 * * *"},
+				Behavior: models.JobSpecBehavior{CatchUp: true},
+			}
+			times, err := getScheduleTimes(spec, time.Time{}, now())
+			assert.Nil(t, err)
+			assert.Equal(t, []time.Time{
+				time.Date(2020, 12, 30, 0, 0, 0, 0, time.UTC),
+				time.Date(2020, 12, 31, 0, 0, 0, 0, time.UTC),
+				time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+			}, times)
+		})
+		t.Run("should only return latest due time if job doesn't catch up", func(t *testing.T) {
+			spec := models.JobSpec{
+				Schedule: models.JobSpecSchedule{StartDate: startDate, Interval: "0 0 * * *"},
+			}
+			times, err := getScheduleTimes(spec, time.Time{}, now())
+			assert.Nil(t, err)
+			assert.Equal(t, []time.Time{now()}, times)
+		})
+		t.Run("should return due times after last scheduled run", func(t *testing.T) {
+			spec := models.JobSpec{
+				Schedule: models.JobSpecSchedule{StartDate: startDate, Interval: "0 0 * * *"},
+				Behavior: models.JobSpecBehavior{CatchUp: true},
+			}
+			times, err := getScheduleTimes(spec, time.Date(2020, 12, 31, 0, 0, 0, 0, time.UTC), now())
+			assert.Nil(t, err)
+			assert.Equal(t, []time.Time{now()}, times)
+		})
+		t.Run("should not return times after end date", func(t *testing.T) {
+			endDate := time.Date(2020, 12, 30, 12, 0, 0, 0, time.UTC)
+			spec := models.JobSpec{
+				Schedule: models.JobSpecSchedule{StartDate: startDate, EndDate: &endDate, Interval: "0 0 * * *"},
+				Behavior: models.JobSpecBehavior{CatchUp: true},
+			}
+			times, err := getScheduleTimes(spec, time.Time{}, now())
+			assert.Nil(t, err)
+			assert.Equal(t, []time.Time{time.Date(2020, 12, 30, 0, 0, 0, 0, time.UTC)}, times)
+		})
+		t.Run("should return latest due time without walking schedule since start date if job doesn't catch up", func(t *testing.T) {
+			spec := models.JobSpec{
+				Schedule: models.JobSpecSchedule{StartDate: time.Date(1990, 1, 1, 0, 0, 0, 0, time.UTC), Interval: "* * * * *"},
+			}
+			times, err := getScheduleTimes(spec, time.Time{}, now().Add(time.Second*30))
+			assert.Nil(t, err)
+			assert.Equal(t, []time.Time{now()}, times)
+		})
+		t.Run("should return latest due time before end date if job doesn't catch up", func(t *testing.T) {
+			endDate := time.Date(2020, 12, 30, 12, 0, 0, 0, time.UTC)
+			spec := models.JobSpec{
+				Schedule: models.JobSpecSchedule{StartDate: startDate, EndDate: &endDate, Interval: "0 0 * * *"},
+			}
+			times, err := getScheduleTimes(spec, time.Time{}, now())
+			assert.Nil(t, err)
+			assert.Equal(t, []time.Time{time.Date(2020, 12, 30, 0, 0, 0, 0, time.UTC)}, times)
+		})
+		t.Run("should return nothing for jobs without schedule", func(t *testing.T) {
+			times, err := getScheduleTimes(models.JobSpec{}, time.Time{}, now())
+			assert.Nil(t, err)
+			assert.Empty(t, times)
+		})
+		t.Run("should fail for invalid interval", func(t *testing.T) {
+			spec := models.JobSpec{
+				Schedule: models.JobSpecSchedule{StartDate: startDate, Interval: "invalid"},
+			}
+			_, err := getScheduleTimes(spec, time.Time{}, now())
+			assert.NotNil(t, err)
+		})
+	})
+	t.Run("leaderJobSchedule", func(t *testing.T) {
+		t.Run("should stop waiting for leadership once context is done", func(t *testing.T) {
+			planner := NewPlanner(log.NewNoop(), &testClusterManager{}, nil, nil, nil, nil, nil, nil,
+				config.SchedulerConfig{PlannerInterval: time.Hour}, now)
+			cancelCtx, cancel := context.WithCancel(ctx)
+			stopped := make(chan struct{})
+			go func() {
+				planner.leaderJobSchedule(cancelCtx)
+				close(stopped)
+			}()
+			cancel()
+			select {
+			case <-stopped:
+			case <-time.After(time.Second * 5):
+				t.Fatal("planner kept waiting for leadership")
+			}
+		})
+	})
+	t.Run("scheduleDeployment", func(t *testing.T) {
+		namespaceSpec := models.NamespaceSpec{
+			ID:          uuid.Must(uuid.NewRandom()),
+			Name:        "namespace",
+			ProjectSpec: models.ProjectSpec{Name: "project"},
+		}
+		t.Run("should create pending runs for due times and record last schedule", func(t *testing.T) {
+			deployment := models.JobDeployment{
+				ID:        uuid.Must(uuid.NewRandom()),
+				Namespace: namespaceSpec,
+				Spec: models.JobSpec{
+					ID:       uuid.Must(uuid.NewRandom()),
+					Name:     "job",
+					Schedule: models.JobSpecSchedule{StartDate: now().Add(-time.Hour * 2), Interval: "@hourly"},
+					Behavior: models.JobSpecBehavior{CatchUp: true},
+				},
+			}
+			firstRun := now().Add(-time.Hour)
+
+			jobRunRepo := new(mock.JobRunRepository)
+			jobRunRepo.On("GetByScheduledAt", ctx, deployment.Spec.ID, firstRun).
+				Return(models.JobRun{}, models.NamespaceSpec{}, nil)
+			jobRunRepo.On("GetByScheduledAt", ctx, deployment.Spec.ID, now()).
+				Return(models.JobRun{}, models.NamespaceSpec{}, store.ErrResourceNotFound)
+			jobRunRepo.On("Save", ctx, namespaceSpec, models.JobRun{
+				Spec:        deployment.Spec,
+				Trigger:     models.TriggerSchedule,
+				Status:      models.RunStatePending,
+				ScheduledAt: now(),
+				ExecutedAt:  now(),
+			}).Return(nil)
+			defer jobRunRepo.AssertExpectations(t)
+			jobRunRepoFac := new(mock.JobRunRepoFactory)
+			jobRunRepoFac.On("New").Return(jobRunRepo)
+			defer jobRunRepoFac.AssertExpectations(t)
+
+			deploymentRepo := new(mock.JobDeploymentRepository)
+			deploymentRepo.On("UpdateLastScheduledAt", ctx, deployment.ID, now()).Return(nil)
+			defer deploymentRepo.AssertExpectations(t)
+			deploymentRepoFac := new(mock.JobDeploymentRepoFactory)
+			deploymentRepoFac.On("New").Return(deploymentRepo)
+			defer deploymentRepoFac.AssertExpectations(t)
+
+			planner := NewPlanner(log.NewNoop(), &testClusterManager{}, jobRunRepoFac, nil, deploymentRepoFac, nil, nil, nil,
+				config.SchedulerConfig{Name: Name}, now)
+			err := planner.scheduleDeployment(ctx, deployment)
+			assert.Nil(t, err)
+		})
+		t.Run("should wait for last run to succeed if job depends on past", func(t *testing.T) {
+			deployment := models.JobDeployment{
+				ID:        uuid.Must(uuid.NewRandom()),
+				Namespace: namespaceSpec,
+				Spec: models.JobSpec{
+					ID:       uuid.Must(uuid.NewRandom()),
+					Name:     "job",
+					Schedule: models.JobSpecSchedule{StartDate: now().Add(-time.Hour * 2), Interval: "@hourly"},
+					Behavior: models.JobSpecBehavior{CatchUp: true, DependsOnPast: true},
+				},
+				LastScheduledAt: now().Add(-time.Hour),
+			}
+
+			jobRunRepo := new(mock.JobRunRepository)
+			jobRunRepo.On("GetByJobNameAndScheduledAt", ctx, namespaceSpec.ProjectSpec, "job",
+				deployment.LastScheduledAt, deployment.LastScheduledAt, 0, 1).
+				Return([]models.JobRun{{Status: models.RunStateRunning}}, nil)
+			defer jobRunRepo.AssertExpectations(t)
+			jobRunRepoFac := new(mock.JobRunRepoFactory)
+			jobRunRepoFac.On("New").Return(jobRunRepo)
+			defer jobRunRepoFac.AssertExpectations(t)
+
+			planner := NewPlanner(log.NewNoop(), &testClusterManager{}, jobRunRepoFac, nil, new(mock.JobDeploymentRepoFactory),
+				nil, nil, nil, config.SchedulerConfig{Name: Name}, now)
+			err := planner.scheduleDeployment(ctx, deployment)
+			assert.Nil(t, err)
+		})
+	})
+	t.Run("canRetryInstance", func(t *testing.T) {
+		spec := models.JobSpec{Behavior: models.JobSpecBehavior{Retry: models.JobSpecBehaviorRetry{Count: 2}}}
+		t.Run("should retry task till attempts exceed retry count", func(t *testing.T) {
+			assert.True(t, canRetryInstance(spec, models.InstanceSpec{Type: models.InstanceTypeTask, Attempt: 1}))
+			assert.True(t, canRetryInstance(spec, models.InstanceSpec{Type: models.InstanceTypeTask, Attempt: 2}))
+			assert.False(t, canRetryInstance(spec, models.InstanceSpec{Type: models.InstanceTypeTask, Attempt: 3}))
+		})
+		t.Run("should not retry hooks", func(t *testing.T) {
+			assert.False(t, canRetryInstance(spec, models.InstanceSpec{Type: models.InstanceTypeHook, Attempt: 1}))
+		})
+		t.Run("should not retry if job has no retry policy", func(t *testing.T) {
+			assert.False(t, canRetryInstance(models.JobSpec{}, models.InstanceSpec{Type: models.InstanceTypeTask, Attempt: 1}))
+		})
+	})
+	t.Run("getRetryTime", func(t *testing.T) {
+		t.Run("should wait for fixed delay after last attempt", func(t *testing.T) {
+			spec := models.JobSpec{Behavior: models.JobSpecBehavior{Retry: models.JobSpecBehaviorRetry{
+				Count: 3, Delay: time.Minute,
+			}}}
+			assert.Equal(t, now().Add(time.Minute), getRetryTime(spec, models.InstanceSpec{Attempt: 3, UpdatedAt: now()}))
+		})
+		t.Run("should double the delay after every attempt with exponential backoff", func(t *testing.T) {
+			spec := models.JobSpec{Behavior: models.JobSpecBehavior{Retry: models.JobSpecBehaviorRetry{
+				Count: 3, Delay: time.Minute, ExponentialBackoff: true,
+			}}}
+			assert.Equal(t, now().Add(time.Minute), getRetryTime(spec, models.InstanceSpec{Attempt: 1, UpdatedAt: now()}))
+			assert.Equal(t, now().Add(time.Minute*4), getRetryTime(spec, models.InstanceSpec{Attempt: 3, UpdatedAt: now()}))
+		})
+	})
+	t.Run("executeInstance", func(t *testing.T) {
+		namespaceSpec := models.NamespaceSpec{ID: uuid.Must(uuid.NewRandom()), Name: "namespace"}
+		jobRun := models.JobRun{
+			ID:     uuid.Must(uuid.NewRandom()),
+			Spec:   models.JobSpec{Name: "job"},
+			Status: models.RunStateAccepted,
+		}
+		newInstance := models.InstanceSpec{
+			ID:      uuid.Must(uuid.NewRandom()),
+			Name:    "task",
+			Type:    models.InstanceTypeTask,
+			Status:  models.RunStateRunning,
+			Attempt: 1,
+		}
+		startedInstance := newInstance
+		startedInstance.StartedAt = now()
+
+		t.Run("should record exit code and reason if instance fails", func(t *testing.T) {
+			runService := new(mock.RunService)
+			runService.On("Register", ctx, namespaceSpec, jobRun, models.InstanceTypeTask, "task").Return(newInstance, nil)
+			defer runService.AssertExpectations(t)
+
+			failedInstance := startedInstance
+			failedInstance.Status = models.RunStateFailed
+			failedInstance.ExitCode = 2
+			failedInstance.FinishedAt = now()
+			failedInstance.Reason = "exited with code 2"
+			instanceRepo := new(mock.InstanceSpecRepository)
+			instanceRepo.On("UpdateExecution", ctx, newInstance.ID, startedInstance).Return(nil)
+			instanceRepo.On("UpdateExecution", ctx, newInstance.ID, failedInstance).Return(nil)
+			defer instanceRepo.AssertExpectations(t)
+			instanceRepoFac := new(mock.InstanceSpecRepoFactory)
+			instanceRepoFac.On("New").Return(instanceRepo)
+
+			executor := &testExecutor{exitCode: 2}
+			planner := NewPlanner(log.NewNoop(), &testClusterManager{}, nil, instanceRepoFac, nil,
+				newTestProjectRepoFac(ctx, namespaceSpec.ProjectSpec), runService, executor, config.SchedulerConfig{}, now)
+			state, err := planner.executeInstance(ctx, namespaceSpec, jobRun, models.InstanceTypeTask, "task")
+			assert.Nil(t, err)
+			assert.Equal(t, models.RunStateFailed, state)
+			// logs are read before execution is forgotten by executor
+			assert.Equal(t, []string{newInstance.ID.String()}, executor.statsRead)
+		})
+		t.Run("should fail instance with reason if executor fails to start it", func(t *testing.T) {
+			runService := new(mock.RunService)
+			runService.On("Register", ctx, namespaceSpec, jobRun, models.InstanceTypeTask, "task").Return(newInstance, nil)
+			defer runService.AssertExpectations(t)
+
+			failedInstance := startedInstance
+			failedInstance.Status = models.RunStateFailed
+			failedInstance.ExitCode = noExitCode
+			failedInstance.FinishedAt = now()
+			failedInstance.Reason = "executor error: random error"
+			instanceRepo := new(mock.InstanceSpecRepository)
+			instanceRepo.On("UpdateExecution", ctx, newInstance.ID, failedInstance).Return(nil)
+			defer instanceRepo.AssertExpectations(t)
+			instanceRepoFac := new(mock.InstanceSpecRepoFactory)
+			instanceRepoFac.On("New").Return(instanceRepo)
+
+			planner := NewPlanner(log.NewNoop(), &testClusterManager{}, nil, instanceRepoFac, nil,
+				newTestProjectRepoFac(ctx, namespaceSpec.ProjectSpec), runService, &testExecutor{startErr: errors.New("random error")}, config.SchedulerConfig{}, now)
+			state, err := planner.executeInstance(ctx, namespaceSpec, jobRun, models.InstanceTypeTask, "task")
+			assert.Nil(t, err)
+			assert.Equal(t, models.RunStateFailed, state)
+		})
+		t.Run("should resolve secrets of project for templating instance context", func(t *testing.T) {
+			projectSpec := models.ProjectSpec{
+				Name: "project",
+				Secret: models.ProjectSecrets{
+					{
+						Name:  "DB_PASSWORD",
+						Value: "super-secret",
+					},
+				},
+			}
+			// runs are read with their project but without its secrets
+			runNamespaceSpec := namespaceSpec
+			runNamespaceSpec.ProjectSpec = models.ProjectSpec{Name: projectSpec.Name}
+			resolvedNamespaceSpec := runNamespaceSpec
+			resolvedNamespaceSpec.ProjectSpec.Secret = projectSpec.Secret
+
+			cliMod := new(mock.CLIMod)
+			cliMod.On("CompileAssets", mock2.Anything, mock2.Anything).Return(&models.CompileAssetsResponse{}, nil)
+			secretRun := jobRun
+			secretRun.Spec.Task = models.JobSpecTask{
+				Unit: &models.Plugin{Base: new(mock.BasePlugin), CLIMod: cliMod},
+				Config: models.JobSpecConfigs{
+					{
+						Name:  "PASSWORD",
+						Value: "{{.secret.DB_PASSWORD}}",
+					},
+				},
+			}
+			runService := new(mock.RunService)
+			runService.On("Register", ctx, resolvedNamespaceSpec, secretRun, models.InstanceTypeTask, "task").Return(newInstance, nil)
+			defer runService.AssertExpectations(t)
+
+			finishedInstance := startedInstance
+			finishedInstance.Status = models.RunStateSuccess
+			finishedInstance.FinishedAt = now()
+			instanceRepo := new(mock.InstanceSpecRepository)
+			instanceRepo.On("UpdateExecution", ctx, newInstance.ID, startedInstance).Return(nil)
+			instanceRepo.On("UpdateExecution", ctx, newInstance.ID, finishedInstance).Return(nil)
+			defer instanceRepo.AssertExpectations(t)
+			instanceRepoFac := new(mock.InstanceSpecRepoFactory)
+			instanceRepoFac.On("New").Return(instanceRepo)
+
+			executor := &testExecutor{}
+			planner := NewPlanner(log.NewNoop(), &testClusterManager{}, nil, instanceRepoFac, nil,
+				newTestProjectRepoFac(ctx, projectSpec), runService, executor, config.SchedulerConfig{}, now)
+			state, err := planner.executeInstance(ctx, runNamespaceSpec, secretRun, models.InstanceTypeTask, "task")
+			assert.Nil(t, err)
+			assert.Equal(t, models.RunStateSuccess, state)
+
+			assert.Len(t, executor.started, 1)
+			req := executor.started[0]
+			envs, _, err := run.NewContextManager(req.Namespace, req.JobRun, run.NewGoEngine()).Generate(req.Instance)
+			assert.Nil(t, err)
+			assert.Equal(t, "super-secret", envs["PASSWORD"])
+		})
+		t.Run("should kill zombie instance and keep run going if it can be retried", func(t *testing.T) {
+			zombieInstance := startedInstance
+			zombieInstance.UpdatedAt = now().Add(-DefaultInstanceRunTimeout * 2)
+			zombieRun := jobRun
+			zombieRun.Spec.Behavior.Retry.Count = 1
+			zombieRun.Instances = []models.InstanceSpec{zombieInstance}
+
+			killedInstance := zombieInstance
+			killedInstance.Status = models.RunStateFailed
+			killedInstance.ExitCode = noExitCode
+			killedInstance.FinishedAt = now()
+			killedInstance.Reason = reasonZombie
+			instanceRepo := new(mock.InstanceSpecRepository)
+			instanceRepo.On("UpdateExecution", ctx, zombieInstance.ID, killedInstance).Return(nil)
+			defer instanceRepo.AssertExpectations(t)
+			instanceRepoFac := new(mock.InstanceSpecRepoFactory)
+			instanceRepoFac.On("New").Return(instanceRepo)
+
+			executor := &testExecutor{}
+			planner := NewPlanner(log.NewNoop(), &testClusterManager{}, nil, instanceRepoFac, nil, nil, nil,
+				executor, config.SchedulerConfig{}, now)
+			state, err := planner.executeInstance(ctx, namespaceSpec, zombieRun, models.InstanceTypeTask, "task")
+			assert.Nil(t, err)
+			assert.Equal(t, models.RunStateRunning, state)
+			assert.Equal(t, []string{zombieInstance.ID.String()}, executor.stopped)
+		})
+		t.Run("should stop instance if its run is cancelled", func(t *testing.T) {
+			runService := new(mock.RunService)
+			runService.On("Register", ctx, namespaceSpec, jobRun, models.InstanceTypeTask, "task").Return(newInstance, nil)
+			defer runService.AssertExpectations(t)
+
+			cancelledInstance := startedInstance
+			cancelledInstance.Status = models.RunStateFailed
+			cancelledInstance.ExitCode = noExitCode
+			cancelledInstance.FinishedAt = now()
+			cancelledInstance.Reason = reasonCancelled
+			instanceRepo := new(mock.InstanceSpecRepository)
+			instanceRepo.On("UpdateExecution", ctx, newInstance.ID, startedInstance).Return(nil)
+			instanceRepo.On("UpdateExecution", ctx, newInstance.ID, cancelledInstance).Return(nil)
+			defer instanceRepo.AssertExpectations(t)
+			instanceRepoFac := new(mock.InstanceSpecRepoFactory)
+			instanceRepoFac.On("New").Return(instanceRepo)
+
+			// run is not allocated to the local peer anymore
+			cluster := &testClusterManager{
+				members: []serf.Member{newTestMember("node-1", "", serf.StatusAlive)},
+			}
+			executor := &testExecutor{running: true}
+			planner := NewPlanner(log.NewNoop(), cluster, nil, instanceRepoFac, nil,
+				newTestProjectRepoFac(ctx, namespaceSpec.ProjectSpec), runService, executor,
+				config.SchedulerConfig{PlannerInterval: time.Millisecond}, now)
+			state, err := planner.executeInstance(ctx, namespaceSpec, jobRun, models.InstanceTypeTask, "task")
+			assert.Nil(t, err)
+			assert.Equal(t, models.RunStateFailed, state)
+			assert.Equal(t, []string{newInstance.ID.String()}, executor.stopped)
+		})
+	})
+	t.Run("executeRun", func(t *testing.T) {
+		t.Run("should fail task of run with reason if hooks have cyclic dependency", func(t *testing.T) {
+			namespaceSpec := models.NamespaceSpec{ID: uuid.Must(uuid.NewRandom()), Name: "namespace"}
+			jobRun := models.JobRun{
+				ID: uuid.Must(uuid.NewRandom()),
+				Spec: models.JobSpec{
+					Name: "job",
+					Task: models.JobSpecTask{Unit: newTestPlugin(models.PluginInfoResponse{Name: "task"})},
+					Hooks: []models.JobSpecHook{
+						{Unit: newTestPlugin(models.PluginInfoResponse{Name: "a", HookType: models.HookTypePre, DependsOn: []string{"b"}})},
+						{Unit: newTestPlugin(models.PluginInfoResponse{Name: "b", HookType: models.HookTypePre, DependsOn: []string{"a"}})},
+					},
+				},
+				Status: models.RunStateAccepted,
+			}
+			newInstance := models.InstanceSpec{
+				ID:     uuid.Must(uuid.NewRandom()),
+				Name:   "task",
+				Type:   models.InstanceTypeTask,
+				Status: models.RunStateRunning,
+			}
+			runService := new(mock.RunService)
+			runService.On("Register", ctx, namespaceSpec, jobRun, models.InstanceTypeTask, "task").Return(newInstance, nil)
+			defer runService.AssertExpectations(t)
+
+			failedInstance := newInstance
+			failedInstance.Status = models.RunStateFailed
+			failedInstance.ExitCode = noExitCode
+			failedInstance.FinishedAt = now()
+			failedInstance.Reason = "invalid execution plan: pre hooks of job job: a: cyclic dependency detected between hooks"
+			instanceRepo := new(mock.InstanceSpecRepository)
+			instanceRepo.On("UpdateExecution", ctx, newInstance.ID, failedInstance).Return(nil)
+			defer instanceRepo.AssertExpectations(t)
+			instanceRepoFac := new(mock.InstanceSpecRepoFactory)
+			instanceRepoFac.On("New").Return(instanceRepo)
+
+			planner := NewPlanner(log.NewNoop(), &testClusterManager{}, nil, instanceRepoFac, nil, nil, runService,
+				&testExecutor{}, config.SchedulerConfig{}, now)
+			assert.Nil(t, planner.executeRun(ctx, namespaceSpec, jobRun))
+
+			// failure is recorded only once
+			jobRun.Instances = []models.InstanceSpec{failedInstance}
+			assert.Nil(t, planner.executeRun(ctx, namespaceSpec, jobRun))
+		})
+	})
+	t.Run("executeAllocations", func(t *testing.T) {
+		t.Run("should execute allocated runs concurrently up to capacity of peer", func(t *testing.T) {
+			finishedRun := models.JobRun{
+				Spec: models.JobSpec{
+					Name: "job",
+					Task: models.JobSpecTask{Unit: newTestPlugin(models.PluginInfoResponse{Name: "task"})},
+				},
+				Instances: []models.InstanceSpec{{Name: "task", Type: models.InstanceTypeTask, Status: models.RunStateSuccess}},
+			}
+			release := make(chan time.Time)
+			jobRunRepo := new(mock.JobRunRepository)
+			localAlloc := set.NewHashSet()
+			for i := 0; i < 3; i++ {
+				runID := uuid.Must(uuid.NewRandom())
+				localAlloc.Add(gossip.StateJob{UUID: runID.String(), Status: models.RunStateAccepted.String()})
+				jobRunRepo.On("GetByID", ctx, runID).WaitUntil(release).Return(finishedRun, models.NamespaceSpec{}, nil)
+			}
+			jobRunRepoFac := new(mock.JobRunRepoFactory)
+			jobRunRepoFac.On("New").Return(jobRunRepo)
+
+			cluster := &testClusterManager{
+				members: []serf.Member{newTestMember("node-1", "", serf.StatusAlive)},
+				state:   gossip.State{Allocation: map[string]set.Set{"node-1": localAlloc}},
+			}
+			planner := NewPlanner(log.NewNoop(), cluster, jobRunRepoFac, nil, nil, nil, nil, nil,
+				config.SchedulerConfig{Capacity: 2}, now)
+
+			planner.executeAllocations(ctx)
+			assert.Len(t, planner.executingRuns, 2)
+			// runs being executed are not picked again
+			planner.executeAllocations(ctx)
+			assert.Len(t, planner.executingRuns, 2)
+
+			close(release)
+			planner.runWg.Wait()
+			assert.Empty(t, planner.executingRuns)
+			jobRunRepo.AssertNumberOfCalls(t, "GetByID", 2)
+		})
+	})
+	t.Run("isNodeJobRunTimedOut", func(t *testing.T) {
+		planner := NewPlanner(log.NewNoop(), &testClusterManager{}, nil, nil, nil, nil, nil, nil,
+			config.SchedulerConfig{NodeJobRunTimeout: time.Hour}, now)
+		t.Run("should time out accepted runs allocated before node timeout", func(t *testing.T) {
+			assert.True(t, planner.isNodeJobRunTimedOut(models.JobRun{Status: models.RunStateAccepted},
+				gossip.StateJob{AllocatedAt: now().Add(-time.Hour * 2)}))
+		})
+		t.Run("should not time out recently allocated runs", func(t *testing.T) {
+			assert.False(t, planner.isNodeJobRunTimedOut(models.JobRun{Status: models.RunStateAccepted},
+				gossip.StateJob{AllocatedAt: now().Add(-time.Minute)}))
+		})
+		t.Run("should not time out runs which already started", func(t *testing.T) {
+			assert.False(t, planner.isNodeJobRunTimedOut(models.JobRun{Status: models.RunStateRunning},
+				gossip.StateJob{AllocatedAt: now().Add(-time.Hour * 2)}))
+		})
+		t.Run("should not time out runs with unknown allocation time", func(t *testing.T) {
+			assert.False(t, planner.isNodeJobRunTimedOut(models.JobRun{Status: models.RunStateAccepted},
+				gossip.StateJob{}))
+		})
+	})
+	t.Run("releaseJobRun", func(t *testing.T) {
+		t.Run("should deallocate run from peer and clear it for reassignment", func(t *testing.T) {
+			runID := uuid.Must(uuid.NewRandom())
+			jobRunRepo := new(mock.JobRunRepository)
+			jobRunRepo.On("Clear", ctx, runID).Return(nil)
+			defer jobRunRepo.AssertExpectations(t)
+			jobRunRepoFac := new(mock.JobRunRepoFactory)
+			jobRunRepoFac.On("New").Return(jobRunRepo)
+			defer jobRunRepoFac.AssertExpectations(t)
+
+			cluster := &testClusterManager{isLeader: true}
+			planner := NewPlanner(log.NewNoop(), cluster, jobRunRepoFac, nil, nil, nil, nil, nil,
+				config.SchedulerConfig{}, now)
+
+			err := planner.releaseJobRun(ctx, "node-1", runID)
+			assert.Nil(t, err)
+			assert.Len(t, cluster.commands, 1)
+			assert.Equal(t, pb.CommandLogType_COMMAND_LOG_TYPE_UPDATE_JOB, cluster.commands[0].Type)
+
+			update := &pb.CommandUpdateJob{}
+			assert.Nil(t, proto.Unmarshal(cluster.commands[0].Payload, update))
+			assert.Equal(t, "node-1", update.PeerId)
+			assert.Equal(t, runID.String(), update.Patches[0].RunId)
+			assert.Equal(t, models.RunStatePending.String(), update.Patches[0].Status)
+		})
+	})
+	t.Run("GetState", func(t *testing.T) {
+		t.Run("should return members with their allocations", func(t *testing.T) {
+			node1Alloc := set.NewHashSet()
+			node1Alloc.Add(gossip.StateJob{UUID: "b", Status: models.RunStateRunning.String(), AllocatedAt: now().Add(time.Minute)})
+			node1Alloc.Add(gossip.StateJob{UUID: "a", Status: models.RunStateAccepted.String(), AllocatedAt: now()})
+			cluster := &testClusterManager{
+				leader: "node-1",
+				members: []serf.Member{
+					newTestMember("node-2", "", serf.StatusAlive),
+					newTestMember("node-1", "", serf.StatusAlive),
+				},
+				state: gossip.State{
+					Allocation:       map[string]set.Set{"node-1": node1Alloc},
+					Drained:          map[string]bool{"node-2": true},
+					AllocationPaused: true,
+				},
+			}
+			planner := NewPlanner(log.NewNoop(), cluster, nil, nil, nil, nil, nil, nil, config.SchedulerConfig{}, now)
+
+			state, err := planner.GetState(ctx)
+			assert.Nil(t, err)
+			assert.Equal(t, models.ClusterState{
+				Leader:           "node-1",
+				AllocationPaused: true,
+				Members: []models.ClusterMember{
+					{
+						Name:   "node-1",
+						Status: serf.StatusAlive.String(),
+						Allocations: []models.ClusterAllocation{
+							{RunID: "a", Status: models.RunStateAccepted.String(), AllocatedAt: now()},
+							{RunID: "b", Status: models.RunStateRunning.String(), AllocatedAt: now().Add(time.Minute)},
+						},
+					},
+					{
+						Name:    "node-2",
+						Status:  serf.StatusAlive.String(),
+						Drained: true,
+					},
+				},
+			}, state)
+		})
+	})
+	t.Run("CancelJobRun", func(t *testing.T) {
+		runID := uuid.Must(uuid.NewRandom())
+		t.Run("should fail the run and deallocate it across cluster", func(t *testing.T) {
+			jobRunRepo := new(mock.JobRunRepository)
+			jobRunRepo.On("GetByID", ctx, runID).Return(models.JobRun{ID: runID, Status: models.RunStateRunning}, models.NamespaceSpec{}, nil)
+			jobRunRepo.On("UpdateStatus", ctx, runID, models.RunStateFailed).Return(nil)
+			defer jobRunRepo.AssertExpectations(t)
+			jobRunRepoFac := new(mock.JobRunRepoFactory)
+			jobRunRepoFac.On("New").Return(jobRunRepo)
+			defer jobRunRepoFac.AssertExpectations(t)
+
+			cluster := &testClusterManager{isLeader: true}
+			planner := NewPlanner(log.NewNoop(), cluster, jobRunRepoFac, nil, nil, nil, nil, nil, config.SchedulerConfig{}, now)
+
+			err := planner.CancelJobRun(ctx, runID)
+			assert.Nil(t, err)
+			assert.Len(t, cluster.commands, 1)
+			assert.Equal(t, pb.CommandLogType_COMMAND_LOG_TYPE_CANCEL_JOB, cluster.commands[0].Type)
+
+			cancel := &pb.CommandCancelJob{}
+			assert.Nil(t, proto.Unmarshal(cluster.commands[0].Payload, cancel))
+			assert.Equal(t, runID.String(), cancel.RunId)
+		})
+		t.Run("should not cancel finished runs", func(t *testing.T) {
+			jobRunRepo := new(mock.JobRunRepository)
+			jobRunRepo.On("GetByID", ctx, runID).Return(models.JobRun{ID: runID, Status: models.RunStateSuccess}, models.NamespaceSpec{}, nil)
+			defer jobRunRepo.AssertExpectations(t)
+			jobRunRepoFac := new(mock.JobRunRepoFactory)
+			jobRunRepoFac.On("New").Return(jobRunRepo)
+			defer jobRunRepoFac.AssertExpectations(t)
+
+			cluster := &testClusterManager{isLeader: true}
+			planner := NewPlanner(log.NewNoop(), cluster, jobRunRepoFac, nil, nil, nil, nil, nil, config.SchedulerConfig{}, now)
+
+			err := planner.CancelJobRun(ctx, runID)
+			assert.Equal(t, "job run is already finished with success", err.Error())
+			assert.Empty(t, cluster.commands)
+		})
+		t.Run("should fail if peer is not the leader", func(t *testing.T) {
+			planner := NewPlanner(log.NewNoop(), &testClusterManager{}, nil, nil, nil, nil, nil, nil, config.SchedulerConfig{}, now)
+
+			err := planner.CancelJobRun(ctx, runID)
+			assert.Equal(t, models.ErrNotClusterLeader, err)
+		})
+	})
+	t.Run("DrainPeer", func(t *testing.T) {
+		t.Run("should replicate drain of cluster member", func(t *testing.T) {
+			cluster := &testClusterManager{
+				isLeader: true,
+				members:  []serf.Member{newTestMember("node-1", "", serf.StatusAlive)},
+			}
+			planner := NewPlanner(log.NewNoop(), cluster, nil, nil, nil, nil, nil, nil, config.SchedulerConfig{}, now)
+
+			err := planner.DrainPeer(ctx, "node-1", true)
+			assert.Nil(t, err)
+			assert.Len(t, cluster.commands, 1)
+			assert.Equal(t, pb.CommandLogType_COMMAND_LOG_TYPE_DRAIN_PEER, cluster.commands[0].Type)
+
+			drain := &pb.CommandDrainPeer{}
+			assert.Nil(t, proto.Unmarshal(cluster.commands[0].Payload, drain))
+			assert.Equal(t, "node-1", drain.PeerId)
+			assert.True(t, drain.Drain)
+		})
+		t.Run("should fail for unknown peer", func(t *testing.T) {
+			cluster := &testClusterManager{
+				isLeader: true,
+				members:  []serf.Member{newTestMember("node-1", "", serf.StatusAlive)},
+			}
+			planner := NewPlanner(log.NewNoop(), cluster, nil, nil, nil, nil, nil, nil, config.SchedulerConfig{}, now)
+
+			err := planner.DrainPeer(ctx, "node-9", true)
+			assert.Equal(t, "peer node-9 is not a member of cluster", err.Error())
+			assert.Empty(t, cluster.commands)
+		})
+	})
+	t.Run("PauseAllocation", func(t *testing.T) {
+		t.Run("should replicate pause across cluster", func(t *testing.T) {
+			cluster := &testClusterManager{isLeader: true}
+			planner := NewPlanner(log.NewNoop(), cluster, nil, nil, nil, nil, nil, nil, config.SchedulerConfig{}, now)
+
+			err := planner.PauseAllocation(ctx, true)
+			assert.Nil(t, err)
+			assert.Len(t, cluster.commands, 1)
+			assert.Equal(t, pb.CommandLogType_COMMAND_LOG_TYPE_PAUSE_ALLOCATION, cluster.commands[0].Type)
+
+			pause := &pb.CommandPauseAllocation{}
+			assert.Nil(t, proto.Unmarshal(cluster.commands[0].Payload, pause))
+			assert.True(t, pause.Pause)
+		})
+	})
+}
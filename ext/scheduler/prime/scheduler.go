@@ -2,14 +2,29 @@ package prime
 
 import (
 	"context"
+	"strings"
 	"time"
 
 	"github.com/odpf/optimus/store"
+	"github.com/pkg/errors"
 
 	"github.com/odpf/optimus/core/progress"
 	"github.com/odpf/optimus/models"
 )
 
+const (
+	// Name of the built-in scheduler, can be used as scheduler name
+	// in configuration to schedule jobs without any external scheduler
+	Name = "sequential"
+
+	// clearBatchSize is the number of runs fetched at a time while clearing
+	clearBatchSize = 100
+)
+
+// ErrRunInProgress is returned when runs being cleared are still executed
+// by a peer
+var ErrRunInProgress = errors.New("runs in progress can't be cleared")
+
 // RunRepoFactory manages execution instances of a job runs
 type RunRepoFactory interface {
 	New() store.JobRunRepository
@@ -19,24 +34,71 @@ type InstanceRepoFactory interface {
 	New() store.InstanceRepository
 }
 
+type DeploymentRepoFactory interface {
+	New() store.JobDeploymentRepository
+}
+
+type ProjectRepoFactory interface {
+	New() store.ProjectRepository
+}
+
 type Scheduler struct {
 	jobRunRepoFac RunRepoFactory
-	Now           func() time.Time
+
+	// deploymentRepoFac is only set when scheduler is used in batch mode
+	// where runs are created by the planner as per job schedule
+	deploymentRepoFac DeploymentRepoFactory
+	Now               func() time.Time
 }
 
 func (s *Scheduler) GetName() string {
-	return "sequential"
+	return Name
 }
 
 func (s *Scheduler) VerifyJob(ctx context.Context, namespace models.NamespaceSpec, job models.JobSpec) error {
 	return nil
 }
 
+// ListJobs returns all the jobs deployed in namespace, jobs are not compiled
+// to any other representation for this scheduler so contents are always empty
 func (s *Scheduler) ListJobs(ctx context.Context, namespace models.NamespaceSpec, opts models.SchedulerListOptions) ([]models.Job, error) {
-	panic("implement me")
+	var jobNames []string
+	if s.deploymentRepoFac != nil {
+		deployments, err := s.deploymentRepoFac.New().GetByNamespace(ctx, namespace)
+		if err != nil {
+			return nil, err
+		}
+		for _, deployment := range deployments {
+			jobNames = append(jobNames, deployment.Spec.Name)
+		}
+	} else {
+		var err error
+		if jobNames, err = s.jobRunRepoFac.New().GetJobNames(ctx, namespace); err != nil {
+			return nil, err
+		}
+	}
+	var jobs []models.Job
+	for _, jobName := range jobNames {
+		jobs = append(jobs, models.Job{
+			Name: jobName,
+		})
+	}
+	return jobs, nil
 }
 
+// DeployJobs in batch mode keeps the jobs for the planner to create runs as
+// per their schedule, otherwise a run is created right away for each job
 func (s *Scheduler) DeployJobs(ctx context.Context, namespace models.NamespaceSpec, jobs []models.JobSpec, obs progress.Observer) error {
+	if s.deploymentRepoFac != nil {
+		repo := s.deploymentRepoFac.New()
+		for _, j := range jobs {
+			if err := repo.Save(ctx, namespace, j); err != nil {
+				return errors.Wrapf(err, "failed to deploy job %s", j.Name)
+			}
+		}
+		return nil
+	}
+
 	var jobRuns []models.JobRun
 	for _, j := range jobs {
 		jobRuns = append(jobRuns, models.JobRun{
@@ -56,6 +118,15 @@ func (s *Scheduler) DeployJobs(ctx context.Context, namespace models.NamespaceSp
 }
 
 func (s *Scheduler) DeleteJobs(ctx context.Context, namespace models.NamespaceSpec, jobNames []string, obs progress.Observer) error {
+	if s.deploymentRepoFac == nil {
+		return nil
+	}
+	repo := s.deploymentRepoFac.New()
+	for _, jobName := range jobNames {
+		if err := repo.Delete(ctx, namespace, jobName); err != nil {
+			return errors.Wrapf(err, "failed to delete job %s", jobName)
+		}
+	}
 	return nil
 }
 
@@ -64,15 +135,74 @@ func (s *Scheduler) Bootstrap(ctx context.Context, spec models.ProjectSpec) erro
 }
 
 func (s *Scheduler) GetJobStatus(ctx context.Context, projSpec models.ProjectSpec, jobName string) ([]models.JobStatus, error) {
-	panic("implement me")
+	jobRuns, err := s.jobRunRepoFac.New().GetByJobName(ctx, projSpec, jobName)
+	if err != nil {
+		return nil, err
+	}
+	return toJobStatus(jobRuns), nil
 }
 
+// Clear resets all the runs of the job scheduled between start and end date
+// and moves them back to pending so that planner executes them again. Runs
+// which are still in progress are left untouched as they are owned by a peer,
+// ErrRunInProgress listing them is returned after the rest are cleared
 func (s *Scheduler) Clear(ctx context.Context, projSpec models.ProjectSpec, jobName string, startDate, endDate time.Time) error {
+	repo := s.jobRunRepoFac.New()
+	var skipped []string
+	for offset := 0; ; offset += clearBatchSize {
+		jobRuns, err := repo.GetByJobNameAndScheduledAt(ctx, projSpec, jobName, startDate, endDate, offset, clearBatchSize)
+		if err != nil {
+			return err
+		}
+		for _, jobRun := range jobRuns {
+			if jobRun.Status == models.RunStateAccepted || jobRun.Status == models.RunStateRunning {
+				skipped = append(skipped, jobRun.ScheduledAt.Format(time.RFC3339))
+				continue
+			}
+			if err := repo.Clear(ctx, jobRun.ID); err != nil {
+				return errors.Wrapf(err, "failed to clear run scheduled at %s", jobRun.ScheduledAt)
+			}
+		}
+		if len(jobRuns) < clearBatchSize {
+			break
+		}
+	}
+	if len(skipped) > 0 {
+		return errors.Wrapf(ErrRunInProgress, "failed to clear runs scheduled at %s", strings.Join(skipped, ", "))
+	}
 	return nil
 }
 
 func (s *Scheduler) GetJobRunStatus(ctx context.Context, projectSpec models.ProjectSpec, jobName string, startDate time.Time, endDate time.Time, batchSize int) ([]models.JobStatus, error) {
-	panic("implement me")
+	if batchSize <= 0 {
+		return nil, errors.New("batch size should be greater than zero")
+	}
+
+	repo := s.jobRunRepoFac.New()
+	var jobStatus []models.JobStatus
+	for offset := 0; ; offset += batchSize {
+		jobRuns, err := repo.GetByJobNameAndScheduledAt(ctx, projectSpec, jobName, startDate, endDate, offset, batchSize)
+		if err != nil {
+			return nil, err
+		}
+		jobStatus = append(jobStatus, toJobStatus(jobRuns)...)
+		if len(jobRuns) < batchSize {
+			break
+		}
+	}
+	return jobStatus, nil
+}
+
+func toJobStatus(jobRuns []models.JobRun) []models.JobStatus {
+	var jobStatus []models.JobStatus
+	for _, jobRun := range jobRuns {
+		jobStatus = append(jobStatus, models.JobStatus{
+			ScheduledAt: jobRun.ScheduledAt,
+			State:       jobRun.Status,
+			Instances:   jobRun.Instances,
+		})
+	}
+	return jobStatus
 }
 
 func NewScheduler(jobRunRepoFac RunRepoFactory, nowFn func() time.Time) *Scheduler {
@@ -81,3 +211,13 @@ func NewScheduler(jobRunRepoFac RunRepoFactory, nowFn func() time.Time) *Schedul
 		Now:           nowFn,
 	}
 }
+
+// NewBatchScheduler creates a scheduler which doesn't execute jobs on deployment
+// but keeps them for the planner to create runs as per their schedule
+func NewBatchScheduler(jobRunRepoFac RunRepoFactory, deploymentRepoFac DeploymentRepoFactory, nowFn func() time.Time) *Scheduler {
+	return &Scheduler{
+		jobRunRepoFac:     jobRunRepoFac,
+		deploymentRepoFac: deploymentRepoFac,
+		Now:               nowFn,
+	}
+}
@@ -0,0 +1,248 @@
+package prime_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/odpf/optimus/ext/scheduler/prime"
+	"github.com/odpf/optimus/mock"
+	"github.com/odpf/optimus/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestScheduler(t *testing.T) {
+	ctx := context.Background()
+	now := func() time.Time { return time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC) }
+	projectSpec := models.ProjectSpec{
+		ID:   uuid.Must(uuid.NewRandom()),
+		Name: "project",
+	}
+	namespaceSpec := models.NamespaceSpec{
+		ID:          uuid.Must(uuid.NewRandom()),
+		Name:        "namespace",
+		ProjectSpec: projectSpec,
+	}
+	jobName := "job"
+	newJobRun := func(scheduledAt time.Time, status models.JobRunState) models.JobRun {
+		return models.JobRun{
+			ID:          uuid.Must(uuid.NewRandom()),
+			Spec:        models.JobSpec{Name: jobName},
+			Status:      status,
+			ScheduledAt: scheduledAt,
+		}
+	}
+
+	t.Run("ListJobs", func(t *testing.T) {
+		t.Run("should list all jobs having runs in namespace", func(t *testing.T) {
+			jobRunRepo := new(mock.JobRunRepository)
+			jobRunRepo.On("GetJobNames", ctx, namespaceSpec).Return([]string{"job-1", "job-2"}, nil)
+			defer jobRunRepo.AssertExpectations(t)
+			jobRunRepoFac := new(mock.JobRunRepoFactory)
+			jobRunRepoFac.On("New").Return(jobRunRepo)
+			defer jobRunRepoFac.AssertExpectations(t)
+
+			scheduler := prime.NewScheduler(jobRunRepoFac, now)
+			jobs, err := scheduler.ListJobs(ctx, namespaceSpec, models.SchedulerListOptions{OnlyName: true})
+			assert.Nil(t, err)
+			assert.Equal(t, []models.Job{{Name: "job-1"}, {Name: "job-2"}}, jobs)
+		})
+		t.Run("should return error if failed to fetch job names", func(t *testing.T) {
+			jobRunRepo := new(mock.JobRunRepository)
+			jobRunRepo.On("GetJobNames", ctx, namespaceSpec).Return([]string{}, errors.New("random error"))
+			defer jobRunRepo.AssertExpectations(t)
+			jobRunRepoFac := new(mock.JobRunRepoFactory)
+			jobRunRepoFac.On("New").Return(jobRunRepo)
+			defer jobRunRepoFac.AssertExpectations(t)
+
+			scheduler := prime.NewScheduler(jobRunRepoFac, now)
+			_, err := scheduler.ListJobs(ctx, namespaceSpec, models.SchedulerListOptions{})
+			assert.Equal(t, "random error", err.Error())
+		})
+	})
+	t.Run("BatchMode", func(t *testing.T) {
+		jobSpec := models.JobSpec{Name: jobName}
+		t.Run("should list deployed jobs of namespace", func(t *testing.T) {
+			deploymentRepo := new(mock.JobDeploymentRepository)
+			deploymentRepo.On("GetByNamespace", ctx, namespaceSpec).Return([]models.JobDeployment{
+				{Namespace: namespaceSpec, Spec: jobSpec},
+			}, nil)
+			defer deploymentRepo.AssertExpectations(t)
+			deploymentRepoFac := new(mock.JobDeploymentRepoFactory)
+			deploymentRepoFac.On("New").Return(deploymentRepo)
+			defer deploymentRepoFac.AssertExpectations(t)
+
+			scheduler := prime.NewBatchScheduler(nil, deploymentRepoFac, now)
+			jobs, err := scheduler.ListJobs(ctx, namespaceSpec, models.SchedulerListOptions{OnlyName: true})
+			assert.Nil(t, err)
+			assert.Equal(t, []models.Job{{Name: jobName}}, jobs)
+		})
+		t.Run("should save deployment of jobs instead of creating runs", func(t *testing.T) {
+			deploymentRepo := new(mock.JobDeploymentRepository)
+			deploymentRepo.On("Save", ctx, namespaceSpec, jobSpec).Return(nil)
+			defer deploymentRepo.AssertExpectations(t)
+			deploymentRepoFac := new(mock.JobDeploymentRepoFactory)
+			deploymentRepoFac.On("New").Return(deploymentRepo)
+			defer deploymentRepoFac.AssertExpectations(t)
+
+			scheduler := prime.NewBatchScheduler(new(mock.JobRunRepoFactory), deploymentRepoFac, now)
+			err := scheduler.DeployJobs(ctx, namespaceSpec, []models.JobSpec{jobSpec}, nil)
+			assert.Nil(t, err)
+		})
+		t.Run("should return error if failed to save deployment", func(t *testing.T) {
+			deploymentRepo := new(mock.JobDeploymentRepository)
+			deploymentRepo.On("Save", ctx, namespaceSpec, jobSpec).Return(errors.New("random error"))
+			defer deploymentRepo.AssertExpectations(t)
+			deploymentRepoFac := new(mock.JobDeploymentRepoFactory)
+			deploymentRepoFac.On("New").Return(deploymentRepo)
+			defer deploymentRepoFac.AssertExpectations(t)
+
+			scheduler := prime.NewBatchScheduler(nil, deploymentRepoFac, now)
+			err := scheduler.DeployJobs(ctx, namespaceSpec, []models.JobSpec{jobSpec}, nil)
+			assert.Equal(t, "failed to deploy job job: random error", err.Error())
+		})
+		t.Run("should delete deployment of jobs", func(t *testing.T) {
+			deploymentRepo := new(mock.JobDeploymentRepository)
+			deploymentRepo.On("Delete", ctx, namespaceSpec, jobName).Return(nil)
+			defer deploymentRepo.AssertExpectations(t)
+			deploymentRepoFac := new(mock.JobDeploymentRepoFactory)
+			deploymentRepoFac.On("New").Return(deploymentRepo)
+			defer deploymentRepoFac.AssertExpectations(t)
+
+			scheduler := prime.NewBatchScheduler(nil, deploymentRepoFac, now)
+			err := scheduler.DeleteJobs(ctx, namespaceSpec, []string{jobName}, nil)
+			assert.Nil(t, err)
+		})
+	})
+	t.Run("GetJobStatus", func(t *testing.T) {
+		t.Run("should return status of all runs of the job", func(t *testing.T) {
+			runs := []models.JobRun{
+				newJobRun(now(), models.RunStateSuccess),
+				newJobRun(now().Add(time.Hour), models.RunStateRunning),
+			}
+			jobRunRepo := new(mock.JobRunRepository)
+			jobRunRepo.On("GetByJobName", ctx, projectSpec, jobName).Return(runs, nil)
+			defer jobRunRepo.AssertExpectations(t)
+			jobRunRepoFac := new(mock.JobRunRepoFactory)
+			jobRunRepoFac.On("New").Return(jobRunRepo)
+			defer jobRunRepoFac.AssertExpectations(t)
+
+			scheduler := prime.NewScheduler(jobRunRepoFac, now)
+			status, err := scheduler.GetJobStatus(ctx, projectSpec, jobName)
+			assert.Nil(t, err)
+			assert.Equal(t, []models.JobStatus{
+				{ScheduledAt: now(), State: models.RunStateSuccess},
+				{ScheduledAt: now().Add(time.Hour), State: models.RunStateRunning},
+			}, status)
+		})
+	})
+	t.Run("Clear", func(t *testing.T) {
+		startDate := now()
+		endDate := now().Add(time.Hour * 24)
+		t.Run("should reset finished runs in range back to pending", func(t *testing.T) {
+			successRun := newJobRun(now(), models.RunStateSuccess)
+			failedRun := newJobRun(now().Add(time.Hour), models.RunStateFailed)
+
+			jobRunRepo := new(mock.JobRunRepository)
+			jobRunRepo.On("GetByJobNameAndScheduledAt", ctx, projectSpec, jobName, startDate, endDate, 0, 100).
+				Return([]models.JobRun{successRun, failedRun}, nil)
+			jobRunRepo.On("Clear", ctx, successRun.ID).Return(nil)
+			jobRunRepo.On("Clear", ctx, failedRun.ID).Return(nil)
+			defer jobRunRepo.AssertExpectations(t)
+			jobRunRepoFac := new(mock.JobRunRepoFactory)
+			jobRunRepoFac.On("New").Return(jobRunRepo)
+			defer jobRunRepoFac.AssertExpectations(t)
+
+			scheduler := prime.NewScheduler(jobRunRepoFac, now)
+			err := scheduler.Clear(ctx, projectSpec, jobName, startDate, endDate)
+			assert.Nil(t, err)
+		})
+		t.Run("should return runs in progress after clearing finished runs", func(t *testing.T) {
+			successRun := newJobRun(now(), models.RunStateSuccess)
+			acceptedRun := newJobRun(now().Add(time.Hour), models.RunStateAccepted)
+			runningRun := newJobRun(now().Add(time.Hour*2), models.RunStateRunning)
+
+			jobRunRepo := new(mock.JobRunRepository)
+			jobRunRepo.On("GetByJobNameAndScheduledAt", ctx, projectSpec, jobName, startDate, endDate, 0, 100).
+				Return([]models.JobRun{successRun, acceptedRun, runningRun}, nil)
+			jobRunRepo.On("Clear", ctx, successRun.ID).Return(nil)
+			defer jobRunRepo.AssertExpectations(t)
+			jobRunRepoFac := new(mock.JobRunRepoFactory)
+			jobRunRepoFac.On("New").Return(jobRunRepo)
+			defer jobRunRepoFac.AssertExpectations(t)
+
+			scheduler := prime.NewScheduler(jobRunRepoFac, now)
+			err := scheduler.Clear(ctx, projectSpec, jobName, startDate, endDate)
+			assert.True(t, errors.Is(err, prime.ErrRunInProgress))
+			assert.Contains(t, err.Error(), acceptedRun.ScheduledAt.Format(time.RFC3339))
+			assert.Contains(t, err.Error(), runningRun.ScheduledAt.Format(time.RFC3339))
+		})
+		t.Run("should return error if failed to clear a run", func(t *testing.T) {
+			successRun := newJobRun(now(), models.RunStateSuccess)
+
+			jobRunRepo := new(mock.JobRunRepository)
+			jobRunRepo.On("GetByJobNameAndScheduledAt", ctx, projectSpec, jobName, startDate, endDate, 0, 100).
+				Return([]models.JobRun{successRun}, nil)
+			jobRunRepo.On("Clear", ctx, successRun.ID).Return(errors.New("random error"))
+			defer jobRunRepo.AssertExpectations(t)
+			jobRunRepoFac := new(mock.JobRunRepoFactory)
+			jobRunRepoFac.On("New").Return(jobRunRepo)
+			defer jobRunRepoFac.AssertExpectations(t)
+
+			scheduler := prime.NewScheduler(jobRunRepoFac, now)
+			err := scheduler.Clear(ctx, projectSpec, jobName, startDate, endDate)
+			assert.NotNil(t, err)
+		})
+	})
+	t.Run("GetJobRunStatus", func(t *testing.T) {
+		startDate := now()
+		endDate := now().Add(time.Hour * 24)
+		t.Run("should fetch runs in batches till all runs are fetched", func(t *testing.T) {
+			firstBatch := []models.JobRun{
+				newJobRun(now(), models.RunStateSuccess),
+				newJobRun(now().Add(time.Hour), models.RunStateFailed),
+			}
+			secondBatch := []models.JobRun{
+				newJobRun(now().Add(time.Hour*2), models.RunStatePending),
+			}
+			jobRunRepo := new(mock.JobRunRepository)
+			jobRunRepo.On("GetByJobNameAndScheduledAt", ctx, projectSpec, jobName, startDate, endDate, 0, 2).
+				Return(firstBatch, nil)
+			jobRunRepo.On("GetByJobNameAndScheduledAt", ctx, projectSpec, jobName, startDate, endDate, 2, 2).
+				Return(secondBatch, nil)
+			defer jobRunRepo.AssertExpectations(t)
+			jobRunRepoFac := new(mock.JobRunRepoFactory)
+			jobRunRepoFac.On("New").Return(jobRunRepo)
+			defer jobRunRepoFac.AssertExpectations(t)
+
+			scheduler := prime.NewScheduler(jobRunRepoFac, now)
+			status, err := scheduler.GetJobRunStatus(ctx, projectSpec, jobName, startDate, endDate, 2)
+			assert.Nil(t, err)
+			assert.Equal(t, []models.JobStatus{
+				{ScheduledAt: now(), State: models.RunStateSuccess},
+				{ScheduledAt: now().Add(time.Hour), State: models.RunStateFailed},
+				{ScheduledAt: now().Add(time.Hour * 2), State: models.RunStatePending},
+			}, status)
+		})
+		t.Run("should return error if failed to fetch a batch", func(t *testing.T) {
+			jobRunRepo := new(mock.JobRunRepository)
+			jobRunRepo.On("GetByJobNameAndScheduledAt", ctx, projectSpec, jobName, startDate, endDate, 0, 10).
+				Return([]models.JobRun{}, errors.New("random error"))
+			defer jobRunRepo.AssertExpectations(t)
+			jobRunRepoFac := new(mock.JobRunRepoFactory)
+			jobRunRepoFac.On("New").Return(jobRunRepo)
+			defer jobRunRepoFac.AssertExpectations(t)
+
+			scheduler := prime.NewScheduler(jobRunRepoFac, now)
+			_, err := scheduler.GetJobRunStatus(ctx, projectSpec, jobName, startDate, endDate, 10)
+			assert.Equal(t, "random error", err.Error())
+		})
+		t.Run("should fail for invalid batch size", func(t *testing.T) {
+			scheduler := prime.NewScheduler(new(mock.JobRunRepoFactory), now)
+			_, err := scheduler.GetJobRunStatus(ctx, projectSpec, jobName, startDate, endDate, 0)
+			assert.NotNil(t, err)
+		})
+	})
+}
@@ -4,12 +4,14 @@ import (
 	"context"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promauto"
 
 	"github.com/hashicorp/go-multierror"
 	"github.com/odpf/optimus/models"
+	"github.com/odpf/optimus/store"
 	"github.com/odpf/salt/log"
 	"github.com/pkg/errors"
 )
@@ -23,26 +25,59 @@ var (
 		Name: "job_event_slamiss",
 		Help: "Event received for SLA miss by scheduler",
 	})
+	jobStartCounter = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "job_event_start",
+		Help: "Event received for job starts by scheduler",
+	})
+	jobSuccessCounter = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "job_event_success",
+		Help: "Event received for job successes by scheduler",
+	})
+	jobRetryCounter = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "job_event_retry",
+		Help: "Event received for job retries by scheduler",
+	})
 )
 
 type eventService struct {
 	// scheme -> notifier
 	notifyChannels map[string]models.Notifier
+	eventRepo      store.JobEventRepository
 	log            log.Logger
+	Now            func() time.Time
 }
 
+// Register stores the event as history of job and notifies the channels
+// configured for it
 func (e *eventService) Register(ctx context.Context, namespace models.NamespaceSpec, jobSpec models.JobSpec,
 	evt models.JobEvent) error {
 	var err error
+	record := models.JobEventRecord{
+		Namespace: namespace,
+		JobName:   jobSpec.Name,
+		Event:     evt,
+		CreatedAt: e.Now(),
+	}
+	if scheduledAt, ok := evt.ScheduledAt(); ok {
+		record.ScheduledAt = scheduledAt
+	}
+	// failing to store history is not reported to the caller, it would retry
+	// and notify the channels again for the same event
+	if insertErr := e.eventRepo.Insert(ctx, record); insertErr != nil {
+		e.log.Error("Error: failed to store event of job", "current error", insertErr)
+	}
+
+	notified := map[string]bool{}
 	for _, notify := range jobSpec.Behavior.Notify {
 		if notify.On == evt.Type {
 			for _, channel := range notify.Channels {
-				chanParts := strings.Split(channel, "://")
+				chanParts := strings.SplitN(channel, "://", 2)
 				scheme := chanParts[0]
 				route := chanParts[1]
 
 				e.log.Debug("notification event for job", "job spec name", jobSpec.Name, "event", fmt.Sprintf("%v", evt))
 				if notifyChannel, ok := e.notifyChannels[scheme]; ok {
+					notified[channel] = true
 					if currErr := notifyChannel.Notify(ctx, models.NotifyAttrs{
 						Namespace: namespace,
 						JobSpec:   jobSpec,
@@ -56,14 +91,62 @@ func (e *eventService) Register(ctx context.Context, namespace models.NamespaceS
 			}
 		}
 	}
-	if evt.Type == models.JobEventTypeFailure {
+	if evt.Type == models.JobEventTypeSuccess {
+		if resolveErr := e.resolveIncidents(ctx, namespace, jobSpec, evt, notified); resolveErr != nil {
+			err = multierror.Append(err, resolveErr)
+		}
+	}
+	switch evt.Type {
+	case models.JobEventTypeFailure:
 		jobFailureCounter.Inc()
-	} else if evt.Type == models.JobEventTypeSLAMiss {
+	case models.JobEventTypeSLAMiss:
 		jobSLAMissCounter.Inc()
+	case models.JobEventTypeStart:
+		jobStartCounter.Inc()
+	case models.JobEventTypeSuccess:
+		jobSuccessCounter.Inc()
+	case models.JobEventTypeRetry:
+		jobRetryCounter.Inc()
 	}
 	return err
 }
 
+// resolveIncidents resolves incidents of job on channels which opened them for
+// other events, channels already notified of success are skipped
+func (e *eventService) resolveIncidents(ctx context.Context, namespace models.NamespaceSpec, jobSpec models.JobSpec,
+	evt models.JobEvent, notified map[string]bool) error {
+	var err error
+	for _, notify := range jobSpec.Behavior.Notify {
+		for _, channel := range notify.Channels {
+			if notified[channel] {
+				continue
+			}
+			chanParts := strings.SplitN(channel, "://", 2)
+			incidentChannel, ok := e.notifyChannels[chanParts[0]].(models.IncidentNotifier)
+			if !ok {
+				continue
+			}
+			notified[channel] = true
+			if currErr := incidentChannel.Resolve(ctx, models.NotifyAttrs{
+				Namespace: namespace,
+				JobSpec:   jobSpec,
+				JobEvent:  evt,
+				Route:     chanParts[1],
+			}); currErr != nil {
+				e.log.Error("Error: failed to resolve incidents of job", "current error", currErr)
+				err = multierror.Append(err, errors.Wrapf(currErr, "notifyChannel.Resolve: %s", channel))
+			}
+		}
+	}
+	return err
+}
+
+// List returns the events registered for job in namespace, latest first
+func (e *eventService) List(ctx context.Context, namespace models.NamespaceSpec, jobName string,
+	filter models.JobEventFilter) ([]models.JobEventRecord, error) {
+	return e.eventRepo.List(ctx, namespace, jobName, filter)
+}
+
 func (e *eventService) Close() error {
 	var err error
 	for _, notify := range e.notifyChannels {
@@ -74,9 +157,13 @@ func (e *eventService) Close() error {
 	return err
 }
 
-func NewEventService(lg log.Logger, notifyChan map[string]models.Notifier) *eventService {
+func NewEventService(lg log.Logger, notifyChan map[string]models.Notifier, eventRepo store.JobEventRepository) *eventService {
 	return &eventService{
 		log:            lg,
 		notifyChannels: notifyChan,
+		eventRepo:      eventRepo,
+		Now: func() time.Time {
+			return time.Now().UTC()
+		},
 	}
 }
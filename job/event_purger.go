@@ -0,0 +1,71 @@
+package job
+
+import (
+	"context"
+	"time"
+
+	"github.com/odpf/optimus/store"
+	"github.com/odpf/salt/log"
+	"github.com/robfig/cron/v3"
+)
+
+const (
+	eventPurgeInterval = "@every 1h"
+	eventPurgeTimeout  = 5 * time.Minute
+)
+
+// EventPurger periodically removes events of jobs registered before
+// the retention period
+type EventPurger struct {
+	l         log.Logger
+	eventRepo store.JobEventRepository
+	retention time.Duration
+	scheduler *cron.Cron
+
+	Now func() time.Time
+}
+
+// Purge removes events older than retention period
+func (p *EventPurger) Purge(ctx context.Context) error {
+	deleted, err := p.eventRepo.DeleteOlderThan(ctx, p.Now().Add(-p.retention))
+	if err != nil {
+		return err
+	}
+	p.l.Debug("purged events of jobs", "count", deleted, "retention", p.retention.String())
+	return nil
+}
+
+func (p *EventPurger) purge() {
+	ctx, cancel := context.WithTimeout(context.Background(), eventPurgeTimeout)
+	defer cancel()
+	if err := p.Purge(ctx); err != nil {
+		p.l.Error("failed to purge events of jobs", "error", err)
+	}
+}
+
+// Close waits for a running purge to finish
+func (p *EventPurger) Close() error {
+	<-p.scheduler.Stop().Done()
+	return nil
+}
+
+// NewEventPurger starts purging events of jobs older than retention
+// every hour
+func NewEventPurger(l log.Logger, eventRepo store.JobEventRepository, retention time.Duration) (*EventPurger, error) {
+	purger := &EventPurger{
+		l:         l,
+		eventRepo: eventRepo,
+		retention: retention,
+		scheduler: cron.New(cron.WithChain(
+			cron.SkipIfStillRunning(cron.DefaultLogger),
+		)),
+		Now: func() time.Time {
+			return time.Now().UTC()
+		},
+	}
+	if _, err := purger.scheduler.AddFunc(eventPurgeInterval, purger.purge); err != nil {
+		return nil, err
+	}
+	purger.scheduler.Start()
+	return purger, nil
+}
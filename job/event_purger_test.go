@@ -0,0 +1,45 @@
+package job_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/odpf/optimus/job"
+	"github.com/odpf/optimus/mock"
+	"github.com/odpf/salt/log"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEventPurger(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2021, 10, 31, 12, 0, 0, 0, time.UTC)
+
+	t.Run("should remove events older than retention period", func(t *testing.T) {
+		eventRepo := new(mock.JobEventRepository)
+		eventRepo.On("DeleteOlderThan", ctx, time.Date(2021, 10, 1, 12, 0, 0, 0, time.UTC)).Return(int64(3), nil)
+		defer eventRepo.AssertExpectations(t)
+
+		purger, err := job.NewEventPurger(log.NewNoop(), eventRepo, 30*24*time.Hour)
+		assert.Nil(t, err)
+		defer purger.Close()
+		purger.Now = func() time.Time { return now }
+
+		assert.Nil(t, purger.Purge(ctx))
+	})
+	t.Run("should fail if events can't be removed", func(t *testing.T) {
+		eventRepo := new(mock.JobEventRepository)
+		eventRepo.On("DeleteOlderThan", ctx, now.Add(-time.Hour)).Return(int64(0), errors.New("connection refused"))
+		defer eventRepo.AssertExpectations(t)
+
+		purger, err := job.NewEventPurger(log.NewNoop(), eventRepo, time.Hour)
+		assert.Nil(t, err)
+		defer purger.Close()
+		purger.Now = func() time.Time { return now }
+
+		err = purger.Purge(ctx)
+		assert.NotNil(t, err)
+		assert.Contains(t, err.Error(), "connection refused")
+	})
+}
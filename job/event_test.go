@@ -3,6 +3,7 @@ package job_test
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/odpf/optimus/job"
@@ -11,6 +12,7 @@ import (
 	"github.com/odpf/salt/log"
 	"github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
+	mocklib "github.com/stretchr/testify/mock"
 	"google.golang.org/protobuf/types/known/structpb"
 )
 
@@ -59,9 +61,13 @@ func TestEventService(t *testing.T) {
 		}).Return(nil)
 		defer notifier.AssertExpectations(t)
 
+		eventRepo := new(mock.JobEventRepository)
+		eventRepo.On("Insert", context.Background(), mocklib.Anything).Return(nil)
+		defer eventRepo.AssertExpectations(t)
+
 		evtService := job.NewEventService(log, map[string]models.Notifier{
 			"slacker": notifier,
-		})
+		}, eventRepo)
 		err := evtService.Register(context.Background(), namespaceSpec, jobSpec, je)
 		assert.Nil(t, err)
 	})
@@ -97,9 +103,13 @@ func TestEventService(t *testing.T) {
 		notifier := new(mock.Notifier)
 		defer notifier.AssertExpectations(t)
 
+		eventRepo := new(mock.JobEventRepository)
+		eventRepo.On("Insert", context.Background(), mocklib.Anything).Return(nil)
+		defer eventRepo.AssertExpectations(t)
+
 		evtService := job.NewEventService(log, map[string]models.Notifier{
 			"slacker": notifier,
-		})
+		}, eventRepo)
 		err := evtService.Register(context.Background(), namespaceSpec, jobSpec, je)
 		assert.Nil(t, err)
 	})
@@ -141,10 +151,215 @@ func TestEventService(t *testing.T) {
 		}).Return(errors.New("failed to notify"))
 		defer notifier.AssertExpectations(t)
 
+		eventRepo := new(mock.JobEventRepository)
+		eventRepo.On("Insert", context.Background(), mocklib.Anything).Return(nil)
+		defer eventRepo.AssertExpectations(t)
+
 		evtService := job.NewEventService(log, map[string]models.Notifier{
 			"slacker": notifier,
-		})
+		}, eventRepo)
 		err := evtService.Register(context.Background(), namespaceSpec, jobSpec, je)
 		assert.Error(t, err, "failed to notify")
 	})
+	t.Run("should notify only the routes configured for type of event", func(t *testing.T) {
+		namespaceSpec := models.NamespaceSpec{
+			ID:   uuid.Must(uuid.NewRandom()),
+			Name: "game_jam",
+			ProjectSpec: models.ProjectSpec{
+				ID:   uuid.Must(uuid.NewRandom()),
+				Name: "a-data-project",
+			},
+		}
+		jobSpec := models.JobSpec{
+			Name: "transform-tables",
+			Behavior: models.JobSpecBehavior{
+				Notify: []models.JobSpecNotifier{
+					{
+						On:       models.JobEventTypeFailure,
+						Channels: []string{"slacker://@devs"},
+					},
+					{
+						On:       models.JobEventTypeRetry,
+						Channels: []string{"hook://hooks.example.io/retries", "hook://http://hooks.example.io/retries"},
+					},
+					{
+						On:       models.JobEventTypeStart,
+						Channels: []string{"slacker://#job-runs"},
+					},
+				},
+			},
+		}
+		je := models.JobEvent{
+			Type:  models.JobEventTypeRetry,
+			Value: eventValues.Fields,
+		}
+
+		slackNotifier := new(mock.Notifier)
+		defer slackNotifier.AssertExpectations(t)
+		hookNotifier := new(mock.Notifier)
+		hookNotifier.On("Notify", context.Background(), models.NotifyAttrs{
+			Namespace: namespaceSpec,
+			JobSpec:   jobSpec,
+			JobEvent:  je,
+			Route:     "hooks.example.io/retries",
+		}).Return(nil)
+		hookNotifier.On("Notify", context.Background(), models.NotifyAttrs{
+			Namespace: namespaceSpec,
+			JobSpec:   jobSpec,
+			JobEvent:  je,
+			Route:     "http://hooks.example.io/retries",
+		}).Return(nil)
+		defer hookNotifier.AssertExpectations(t)
+
+		eventRepo := new(mock.JobEventRepository)
+		eventRepo.On("Insert", context.Background(), mocklib.Anything).Return(nil)
+		defer eventRepo.AssertExpectations(t)
+
+		evtService := job.NewEventService(log, map[string]models.Notifier{
+			"slacker": slackNotifier,
+			"hook":    hookNotifier,
+		}, eventRepo)
+		err := evtService.Register(context.Background(), namespaceSpec, jobSpec, je)
+		assert.Nil(t, err)
+	})
+	t.Run("should resolve incidents of job on success of job", func(t *testing.T) {
+		projectSpec := models.ProjectSpec{
+			ID:   uuid.Must(uuid.NewRandom()),
+			Name: "a-data-project",
+		}
+
+		namespaceSpec := models.NamespaceSpec{
+			ID:          uuid.Must(uuid.NewRandom()),
+			Name:        "game_jam",
+			ProjectSpec: projectSpec,
+		}
+		jobSpec := models.JobSpec{
+			Name: "transform-tables",
+			Behavior: models.JobSpecBehavior{
+				Notify: []models.JobSpecNotifier{
+					{
+						On: models.JobEventTypeFailure,
+						Channels: []string{
+							"slacker://@devs",
+							"pager://data-platform",
+						},
+					},
+					{
+						On: models.JobEventTypeSLAMiss,
+						Channels: []string{
+							"pager://data-platform",
+						},
+					},
+				},
+			},
+		}
+		je := models.JobEvent{
+			Type:  models.JobEventTypeSuccess,
+			Value: eventValues.GetFields(),
+		}
+
+		notifier := new(mock.Notifier)
+		defer notifier.AssertExpectations(t)
+
+		incidentNotifier := new(mock.IncidentNotifier)
+		incidentNotifier.On("Resolve", context.Background(), models.NotifyAttrs{
+			Namespace: namespaceSpec,
+			JobSpec:   jobSpec,
+			JobEvent:  je,
+			Route:     "data-platform",
+		}).Return(nil).Once()
+		defer incidentNotifier.AssertExpectations(t)
+
+		eventRepo := new(mock.JobEventRepository)
+		eventRepo.On("Insert", context.Background(), mocklib.Anything).Return(nil)
+		defer eventRepo.AssertExpectations(t)
+
+		evtService := job.NewEventService(log, map[string]models.Notifier{
+			"slacker": notifier,
+			"pager":   incidentNotifier,
+		}, eventRepo)
+		err := evtService.Register(context.Background(), namespaceSpec, jobSpec, je)
+		assert.Nil(t, err)
+	})
+	t.Run("should store event with scheduled time of its run", func(t *testing.T) {
+		namespaceSpec := models.NamespaceSpec{
+			ID:   uuid.Must(uuid.NewRandom()),
+			Name: "game_jam",
+			ProjectSpec: models.ProjectSpec{
+				ID:   uuid.Must(uuid.NewRandom()),
+				Name: "a-data-project",
+			},
+		}
+		jobSpec := models.JobSpec{
+			Name: "transform-tables",
+		}
+		je := models.JobEvent{
+			Type: models.JobEventTypeFailure,
+			Value: map[string]*structpb.Value{
+				"scheduled_at": structpb.NewStringValue("2021-10-01T00:00:00Z"),
+			},
+		}
+		now := time.Date(2021, 10, 1, 1, 0, 0, 0, time.UTC)
+
+		eventRepo := new(mock.JobEventRepository)
+		eventRepo.On("Insert", context.Background(), models.JobEventRecord{
+			Namespace:   namespaceSpec,
+			JobName:     jobSpec.Name,
+			Event:       je,
+			ScheduledAt: time.Date(2021, 10, 1, 0, 0, 0, 0, time.UTC),
+			CreatedAt:   now,
+		}).Return(nil)
+		defer eventRepo.AssertExpectations(t)
+
+		evtService := job.NewEventService(log, map[string]models.Notifier{}, eventRepo)
+		evtService.Now = func() time.Time {
+			return now
+		}
+		err := evtService.Register(context.Background(), namespaceSpec, jobSpec, je)
+		assert.Nil(t, err)
+	})
+	t.Run("should notify registered notifiers without failing if failed to store event", func(t *testing.T) {
+		namespaceSpec := models.NamespaceSpec{
+			ID:   uuid.Must(uuid.NewRandom()),
+			Name: "game_jam",
+			ProjectSpec: models.ProjectSpec{
+				ID:   uuid.Must(uuid.NewRandom()),
+				Name: "a-data-project",
+			},
+		}
+		jobSpec := models.JobSpec{
+			Name: "transform-tables",
+			Behavior: models.JobSpecBehavior{
+				Notify: []models.JobSpecNotifier{
+					{
+						On:       models.JobEventTypeFailure,
+						Channels: []string{"slacker://@devs"},
+					},
+				},
+			},
+		}
+		je := models.JobEvent{
+			Type:  models.JobEventTypeFailure,
+			Value: eventValues.Fields,
+		}
+
+		notifier := new(mock.Notifier)
+		notifier.On("Notify", context.Background(), models.NotifyAttrs{
+			Namespace: namespaceSpec,
+			JobSpec:   jobSpec,
+			JobEvent:  je,
+			Route:     "@devs",
+		}).Return(nil)
+		defer notifier.AssertExpectations(t)
+
+		eventRepo := new(mock.JobEventRepository)
+		eventRepo.On("Insert", context.Background(), mocklib.Anything).Return(errors.New("connection refused"))
+		defer eventRepo.AssertExpectations(t)
+
+		evtService := job.NewEventService(log, map[string]models.Notifier{
+			"slacker": notifier,
+		}, eventRepo)
+		err := evtService.Register(context.Background(), namespaceSpec, jobSpec, je)
+		assert.Nil(t, err)
+	})
 }
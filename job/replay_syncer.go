@@ -94,6 +94,13 @@ func (s Syncer) syncTimedOutReplay(ctx context.Context, replaySpecRepo store.Rep
 }
 
 func (s Syncer) syncRunningReplay(ctx context.Context, projectSpec models.ProjectSpec, replaySpec models.ReplaySpec, replaySpecRepo store.ReplaySpecRepository) error {
+	// listed projects don't carry secrets of external secret backends which
+	// are needed to reach the scheduler
+	projectSpec, err := s.projectRepoFactory.New().GetByName(ctx, projectSpec.Name)
+	if err != nil {
+		return err
+	}
+
 	stateSummary, err := s.checkInstanceState(ctx, projectSpec, replaySpec)
 	if err != nil {
 		return err
@@ -127,6 +127,7 @@ func TestReplaySyncer(t *testing.T) {
 		t.Run("should mark state of running replay to success if all instances are success", func(t *testing.T) {
 			projectRepository := new(mock.ProjectRepository)
 			projectRepository.On("GetAll", ctx).Return(projectSpecs, nil)
+			projectRepository.On("GetByName", ctx, projectSpecs[0].Name).Return(projectSpecs[0], nil)
 			defer projectRepository.AssertExpectations(t)
 
 			projectRepoFactory := new(mock.ProjectRepoFactory)
@@ -170,6 +171,7 @@ func TestReplaySyncer(t *testing.T) {
 		t.Run("should mark state of running replay to failed if no longer running instance and one of instances is failed", func(t *testing.T) {
 			projectRepository := new(mock.ProjectRepository)
 			projectRepository.On("GetAll", ctx).Return(projectSpecs, nil)
+			projectRepository.On("GetByName", ctx, projectSpecs[0].Name).Return(projectSpecs[0], nil)
 			defer projectRepository.AssertExpectations(t)
 
 			projectRepoFactory := new(mock.ProjectRepoFactory)
@@ -213,6 +215,7 @@ func TestReplaySyncer(t *testing.T) {
 		t.Run("should not update replay status if instances are still running", func(t *testing.T) {
 			projectRepository := new(mock.ProjectRepository)
 			projectRepository.On("GetAll", ctx).Return(projectSpecs, nil)
+			projectRepository.On("GetByName", ctx, projectSpecs[0].Name).Return(projectSpecs[0], nil)
 			defer projectRepository.AssertExpectations(t)
 
 			projectRepoFactory := new(mock.ProjectRepoFactory)
@@ -290,6 +293,7 @@ func TestReplaySyncer(t *testing.T) {
 		t.Run("should return error when unable to get dag run status from batchScheduler", func(t *testing.T) {
 			projectRepository := new(mock.ProjectRepository)
 			projectRepository.On("GetAll", ctx).Return(projectSpecs, nil)
+			projectRepository.On("GetByName", ctx, projectSpecs[0].Name).Return(projectSpecs[0], nil)
 			defer projectRepository.AssertExpectations(t)
 
 			projectRepoFactory := new(mock.ProjectRepoFactory)
@@ -467,6 +467,63 @@ func (srv *Service) GetDownstream(ctx context.Context, projectSpec models.Projec
 	return jobSpecs, nil
 }
 
+// GetExternalDownstream returns jobs of projectSpec outside of the scope which depend on jobs in scope,
+// scope is either whole scopeProject or only its scopeNamespace if set.
+// Only dependencies persisted with job specs are looked up, dependencies inferred from
+// assets are resolved by plugins on deployment and are not considered.
+func (srv *Service) GetExternalDownstream(ctx context.Context, projectSpec models.ProjectSpec, scopeProject models.ProjectSpec,
+	scopeNamespace string) ([]models.JobSpec, error) {
+	jobSpecs, err := srv.projectJobSpecRepoFactory.New(projectSpec).GetAll(ctx)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to retrieve jobs")
+	}
+	scopeJobNamespaceMap, err := srv.prepareNamespaceJobSpecMap(ctx, scopeProject)
+	if err != nil {
+		return nil, err
+	}
+	jobNamespaceMap := scopeJobNamespaceMap
+	if projectSpec.Name != scopeProject.Name {
+		if jobNamespaceMap, err = srv.prepareNamespaceJobSpecMap(ctx, projectSpec); err != nil {
+			return nil, err
+		}
+	}
+	inScope := func(projectName, jobName string, namespaceMap map[string]string) bool {
+		return projectName == scopeProject.Name && (scopeNamespace == "" || namespaceMap[jobName] == scopeNamespace)
+	}
+
+	var downstream []models.JobSpec
+	for _, jobSpec := range jobSpecs {
+		if inScope(projectSpec.Name, jobSpec.Name, jobNamespaceMap) {
+			continue
+		}
+		for depName, dep := range jobSpec.Dependencies {
+			depProjectName, depJobName, ok := persistedDependency(projectSpec.Name, depName, dep)
+			if ok && inScope(depProjectName, depJobName, scopeJobNamespaceMap) {
+				downstream = append(downstream, jobSpec)
+				break
+			}
+		}
+	}
+	return downstream, nil
+}
+
+// persistedDependency returns project and job name of a dependency as it is
+// stored with job spec, intra dependencies are keyed by job name and inter
+// dependencies by 'project_name/job_name'
+func persistedDependency(projectName, depName string, dep models.JobSpecDependency) (string, string, bool) {
+	switch dep.Type {
+	case models.JobSpecDependencyTypeIntra:
+		return projectName, depName, true
+	case models.JobSpecDependencyTypeInter:
+		depParts := strings.SplitN(depName, "/", 2)
+		if len(depParts) != 2 {
+			return "", "", false
+		}
+		return depParts[0], depParts[1], true
+	}
+	return "", "", false
+}
+
 func (srv *Service) prepareJobSpecMap(ctx context.Context, projectSpec models.ProjectSpec) (map[string]models.JobSpec, error) {
 	projectJobSpecRepo := srv.projectJobSpecRepoFactory.New(projectSpec)
 
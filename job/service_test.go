@@ -1109,4 +1109,78 @@ func TestService(t *testing.T) {
 			assert.Nil(jobSpecsResult)
 		})
 	})
+	t.Run("GetExternalDownstream", func(t *testing.T) {
+		t.Run("should return job specs outside of namespace depending on its jobs", func(t *testing.T) {
+			projSpec := models.ProjectSpec{
+				Name: "proj",
+			}
+			jobSpec1 := models.JobSpec{Name: "dag1-no-deps", Dependencies: map[string]models.JobSpecDependency{}}
+			jobSpec2 := models.JobSpec{Name: "dag2-deps-on-dag1", Dependencies: map[string]models.JobSpecDependency{
+				jobSpec1.Name: {Type: models.JobSpecDependencyTypeIntra},
+			}}
+			jobSpec3 := models.JobSpec{Name: "dag3-deps-on-dag1", Dependencies: map[string]models.JobSpecDependency{
+				"proj/" + jobSpec1.Name: {Type: models.JobSpecDependencyTypeInter},
+			}}
+			jobSpecs := []models.JobSpec{jobSpec1, jobSpec2, jobSpec3}
+
+			projectJobSpecRepo := new(mock.ProjectJobSpecRepository)
+			projectJobSpecRepo.On("GetAll", ctx).Return(jobSpecs, nil)
+			projectJobSpecRepo.On("GetJobNamespaces", ctx).Return(map[string][]string{
+				"a": {jobSpec1.Name, jobSpec3.Name},
+				"b": {jobSpec2.Name},
+			}, nil)
+			defer projectJobSpecRepo.AssertExpectations(t)
+
+			projJobSpecRepoFac := new(mock.ProjectJobSpecRepoFactory)
+			defer projJobSpecRepoFac.AssertExpectations(t)
+			projJobSpecRepoFac.On("New", projSpec).Return(projectJobSpecRepo)
+
+			svc := job.NewService(nil, nil, nil, dumpAssets, nil, nil, projJobSpecRepoFac, nil)
+			jobSpecsResult, err := svc.GetExternalDownstream(ctx, projSpec, projSpec, "a")
+			assert.Nil(t, err)
+			assert.Equal(t, []models.JobSpec{jobSpec2}, jobSpecsResult)
+
+			jobSpecsResult, err = svc.GetExternalDownstream(ctx, projSpec, projSpec, "")
+			assert.Nil(t, err)
+			assert.Nil(t, jobSpecsResult)
+		})
+		t.Run("should return job specs of other project depending on jobs of project", func(t *testing.T) {
+			projSpec := models.ProjectSpec{
+				Name: "proj",
+			}
+			otherProjSpec := models.ProjectSpec{
+				Name: "other-proj",
+			}
+			jobSpec1 := models.JobSpec{Name: "dag1-no-deps", Dependencies: map[string]models.JobSpecDependency{}}
+			jobSpec2 := models.JobSpec{Name: "dag2-deps-on-dag1", Dependencies: map[string]models.JobSpecDependency{
+				"proj/" + jobSpec1.Name: {Type: models.JobSpecDependencyTypeInter},
+			}}
+			jobSpec3 := models.JobSpec{Name: "dag3-deps-on-other-dag1", Dependencies: map[string]models.JobSpecDependency{
+				jobSpec1.Name: {Type: models.JobSpecDependencyTypeIntra},
+			}}
+
+			projectJobSpecRepo := new(mock.ProjectJobSpecRepository)
+			projectJobSpecRepo.On("GetJobNamespaces", ctx).Return(map[string][]string{
+				"a": {jobSpec1.Name},
+			}, nil)
+			defer projectJobSpecRepo.AssertExpectations(t)
+
+			otherProjectJobSpecRepo := new(mock.ProjectJobSpecRepository)
+			otherProjectJobSpecRepo.On("GetAll", ctx).Return([]models.JobSpec{jobSpec2, jobSpec3}, nil)
+			otherProjectJobSpecRepo.On("GetJobNamespaces", ctx).Return(map[string][]string{
+				"a": {jobSpec2.Name, jobSpec3.Name},
+			}, nil)
+			defer otherProjectJobSpecRepo.AssertExpectations(t)
+
+			projJobSpecRepoFac := new(mock.ProjectJobSpecRepoFactory)
+			defer projJobSpecRepoFac.AssertExpectations(t)
+			projJobSpecRepoFac.On("New", projSpec).Return(projectJobSpecRepo)
+			projJobSpecRepoFac.On("New", otherProjSpec).Return(otherProjectJobSpecRepo)
+
+			svc := job.NewService(nil, nil, nil, dumpAssets, nil, nil, projJobSpecRepoFac, nil)
+			jobSpecsResult, err := svc.GetExternalDownstream(ctx, otherProjSpec, projSpec, "a")
+			assert.Nil(t, err)
+			assert.Equal(t, []models.JobSpec{jobSpec2}, jobSpecsResult)
+		})
+	})
 }
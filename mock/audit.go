@@ -0,0 +1,35 @@
+package mock
+
+import (
+	"context"
+
+	"github.com/odpf/optimus/models"
+	"github.com/stretchr/testify/mock"
+)
+
+type AuditRepository struct {
+	mock.Mock
+}
+
+func (repo *AuditRepository) Insert(ctx context.Context, entry models.AuditEntry) error {
+	return repo.Called(ctx, entry).Error(0)
+}
+
+func (repo *AuditRepository) List(ctx context.Context, filter models.AuditFilter) ([]models.AuditEntry, error) {
+	args := repo.Called(ctx, filter)
+	return args.Get(0).([]models.AuditEntry), args.Error(1)
+}
+
+type AdminService struct {
+	mock.Mock
+}
+
+func (svc *AdminService) ListAuditEntries(ctx context.Context, filter models.AuditFilter) ([]models.AuditEntry, error) {
+	args := svc.Called(ctx, filter)
+	return args.Get(0).([]models.AuditEntry), args.Error(1)
+}
+
+func (svc *AdminService) ReencryptSecrets(ctx context.Context, batchSize int) (int, string, error) {
+	args := svc.Called(ctx, batchSize)
+	return args.Int(0), args.String(1), args.Error(2)
+}
@@ -45,6 +45,11 @@ func (r *JobRunRepository) UpdateStatus(ctx context.Context, u uuid.UUID, state
 	return args.Error(0)
 }
 
+func (r *JobRunRepository) UpdateWaitReason(ctx context.Context, u uuid.UUID, reason string) error {
+	args := r.Called(ctx, u, reason)
+	return args.Error(0)
+}
+
 func (r *JobRunRepository) GetByStatus(ctx context.Context, state ...models.JobRunState) ([]models.JobRun, error) {
 	args := r.Called(ctx, state)
 	return args.Get(0).([]models.JobRun), args.Error(1)
@@ -70,6 +75,22 @@ func (r *JobRunRepository) Clear(ctx context.Context, runID uuid.UUID) error {
 	return args.Error(0)
 }
 
+func (r *JobRunRepository) GetByJobName(ctx context.Context, projectSpec models.ProjectSpec, jobName string) ([]models.JobRun, error) {
+	args := r.Called(ctx, projectSpec, jobName)
+	return args.Get(0).([]models.JobRun), args.Error(1)
+}
+
+func (r *JobRunRepository) GetByJobNameAndScheduledAt(ctx context.Context, projectSpec models.ProjectSpec, jobName string,
+	startDate, endDate time.Time, offset, limit int) ([]models.JobRun, error) {
+	args := r.Called(ctx, projectSpec, jobName, startDate, endDate, offset, limit)
+	return args.Get(0).([]models.JobRun), args.Error(1)
+}
+
+func (r *JobRunRepository) GetJobNames(ctx context.Context, namespaceSpec models.NamespaceSpec) ([]string, error) {
+	args := r.Called(ctx, namespaceSpec)
+	return args.Get(0).([]string), args.Error(1)
+}
+
 func (r *JobRunRepository) ClearInstance(ctx context.Context, runID uuid.UUID, instanceType models.InstanceType, instanceName string) error {
 	args := r.Called(ctx, runID, instanceType, instanceName)
 	return args.Error(0)
@@ -89,20 +110,25 @@ type InstanceSpecRepository struct {
 	mock.Mock
 }
 
-func (repo *InstanceSpecRepository) Save(ctx context.Context, t models.InstanceSpec) error {
-	return repo.Called(ctx, t).Error(0)
+func (repo *InstanceSpecRepository) Save(ctx context.Context, run models.JobRun, spec models.InstanceSpec) error {
+	return repo.Called(ctx, run, spec).Error(0)
+}
+
+func (repo *InstanceSpecRepository) UpdateStatus(ctx context.Context, id uuid.UUID, status models.JobRunState) error {
+	return repo.Called(ctx, id, status).Error(0)
+}
+
+func (repo *InstanceSpecRepository) UpdateExecution(ctx context.Context, id uuid.UUID, spec models.InstanceSpec) error {
+	return repo.Called(ctx, id, spec).Error(0)
 }
 
-func (repo *InstanceSpecRepository) GetByScheduledAt(ctx context.Context, st time.Time) (models.InstanceSpec, error) {
-	args := repo.Called(ctx, st)
-	if args.Get(0) != nil {
-		return args.Get(0).(models.InstanceSpec), args.Error(1)
-	}
-	return models.InstanceSpec{}, args.Error(1)
+func (repo *InstanceSpecRepository) GetByName(ctx context.Context, runID uuid.UUID, instanceName, instanceType string) (models.InstanceSpec, error) {
+	args := repo.Called(ctx, runID, instanceName, instanceType)
+	return args.Get(0).(models.InstanceSpec), args.Error(1)
 }
 
-func (repo *InstanceSpecRepository) Clear(ctx context.Context, st time.Time) error {
-	return repo.Called(ctx, st).Error(0)
+func (repo *InstanceSpecRepository) DeleteByJobRun(ctx context.Context, id uuid.UUID) error {
+	return repo.Called(ctx, id).Error(0)
 }
 
 type RunService struct {
@@ -2,6 +2,7 @@ package mock
 
 import (
 	"context"
+	"time"
 
 	"github.com/google/uuid"
 
@@ -208,6 +209,11 @@ func (j *JobService) GetDownstream(ctx context.Context, projectSpec models.Proje
 	return args.Get(0).([]models.JobSpec), args.Error(1)
 }
 
+func (j *JobService) GetExternalDownstream(ctx context.Context, projectSpec models.ProjectSpec, scopeProject models.ProjectSpec, scopeNamespace string) ([]models.JobSpec, error) {
+	args := j.Called(ctx, projectSpec, scopeProject, scopeNamespace)
+	return args.Get(0).([]models.JobSpec), args.Error(1)
+}
+
 type DependencyResolver struct {
 	mock.Mock
 }
@@ -235,6 +241,29 @@ func (e *EventService) Register(ctx context.Context, spec models.NamespaceSpec,
 	return e.Called(ctx, spec, spec2, event).Error(0)
 }
 
+func (e *EventService) List(ctx context.Context, namespace models.NamespaceSpec, jobName string, filter models.JobEventFilter) ([]models.JobEventRecord, error) {
+	args := e.Called(ctx, namespace, jobName, filter)
+	return args.Get(0).([]models.JobEventRecord), args.Error(1)
+}
+
+func (repo *JobEventRepository) DeleteOlderThan(ctx context.Context, before time.Time) (int64, error) {
+	args := repo.Called(ctx, before)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+type JobEventRepository struct {
+	mock.Mock
+}
+
+func (repo *JobEventRepository) Insert(ctx context.Context, record models.JobEventRecord) error {
+	return repo.Called(ctx, record).Error(0)
+}
+
+func (repo *JobEventRepository) List(ctx context.Context, namespace models.NamespaceSpec, jobName string, filter models.JobEventFilter) ([]models.JobEventRecord, error) {
+	args := repo.Called(ctx, namespace, jobName, filter)
+	return args.Get(0).([]models.JobEventRecord), args.Error(1)
+}
+
 type Notifier struct {
 	mock.Mock
 }
@@ -246,3 +275,11 @@ func (n *Notifier) Close() error {
 func (n *Notifier) Notify(ctx context.Context, attr models.NotifyAttrs) error {
 	return n.Called(ctx, attr).Error(0)
 }
+
+type IncidentNotifier struct {
+	Notifier
+}
+
+func (n *IncidentNotifier) Resolve(ctx context.Context, attr models.NotifyAttrs) error {
+	return n.Called(ctx, attr).Error(0)
+}
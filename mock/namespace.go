@@ -26,6 +26,15 @@ func (pr *NamespaceRepository) GetAll(ctx context.Context) ([]models.NamespaceSp
 	return args.Get(0).([]models.NamespaceSpec), args.Error(1)
 }
 
+func (pr *NamespaceRepository) GetDeletionPlan(ctx context.Context, name string) (models.DeletionPlan, error) {
+	args := pr.Called(ctx, name)
+	return args.Get(0).(models.DeletionPlan), args.Error(1)
+}
+
+func (pr *NamespaceRepository) Delete(ctx context.Context, name string) error {
+	return pr.Called(ctx, name).Error(0)
+}
+
 type NamespaceRepoFactory struct {
 	mock.Mock
 }
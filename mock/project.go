@@ -27,6 +27,15 @@ func (pr *ProjectRepository) GetAll(ctx context.Context) ([]models.ProjectSpec,
 	return args.Get(0).([]models.ProjectSpec), args.Error(1)
 }
 
+func (pr *ProjectRepository) GetDeletionPlan(ctx context.Context, name string) (models.DeletionPlan, error) {
+	args := pr.Called(ctx, name)
+	return args.Get(0).(models.DeletionPlan), args.Error(1)
+}
+
+func (pr *ProjectRepository) Delete(ctx context.Context, name string) error {
+	return pr.Called(ctx, name).Error(0)
+}
+
 type ProjectRepoFactory struct {
 	mock.Mock
 }
@@ -67,6 +76,15 @@ func (pr *ProjectSecretRepository) GetAll(ctx context.Context) ([]models.Project
 	return args.Get(0).([]models.ProjectSecretItem), args.Error(1)
 }
 
+func (pr *ProjectSecretRepository) GetSecrets(ctx context.Context) ([]models.SecretItemInfo, error) {
+	args := pr.Called(ctx)
+	return args.Get(0).([]models.SecretItemInfo), args.Error(1)
+}
+
+func (pr *ProjectSecretRepository) Delete(ctx context.Context, name string) error {
+	return pr.Called(ctx, name).Error(0)
+}
+
 type PipelineLogObserver struct {
 	mock.Mock
 }
@@ -4,7 +4,9 @@ import (
 	"context"
 	"time"
 
+	"github.com/google/uuid"
 	"github.com/odpf/optimus/core/progress"
+	"github.com/odpf/optimus/store"
 
 	"github.com/odpf/optimus/models"
 	"github.com/stretchr/testify/mock"
@@ -57,3 +59,59 @@ func (ms *Scheduler) GetJobRunStatus(ctx context.Context, projectSpec models.Pro
 	args := ms.Called(ctx, projectSpec, jobName, startDate, endDate, batchSize)
 	return args.Get(0).([]models.JobStatus), args.Error(1)
 }
+
+type JobDeploymentRepoFactory struct {
+	mock.Mock
+}
+
+func (repo *JobDeploymentRepoFactory) New() store.JobDeploymentRepository {
+	args := repo.Called()
+	return args.Get(0).(store.JobDeploymentRepository)
+}
+
+type JobDeploymentRepository struct {
+	mock.Mock
+}
+
+func (repo *JobDeploymentRepository) Save(ctx context.Context, namespace models.NamespaceSpec, spec models.JobSpec) error {
+	return repo.Called(ctx, namespace, spec).Error(0)
+}
+
+func (repo *JobDeploymentRepository) Delete(ctx context.Context, namespace models.NamespaceSpec, jobName string) error {
+	return repo.Called(ctx, namespace, jobName).Error(0)
+}
+
+func (repo *JobDeploymentRepository) GetByNamespace(ctx context.Context, namespace models.NamespaceSpec) ([]models.JobDeployment, error) {
+	args := repo.Called(ctx, namespace)
+	return args.Get(0).([]models.JobDeployment), args.Error(1)
+}
+
+func (repo *JobDeploymentRepository) GetAll(ctx context.Context) ([]models.JobDeployment, error) {
+	args := repo.Called(ctx)
+	return args.Get(0).([]models.JobDeployment), args.Error(1)
+}
+
+func (repo *JobDeploymentRepository) UpdateLastScheduledAt(ctx context.Context, id uuid.UUID, lastScheduledAt time.Time) error {
+	return repo.Called(ctx, id, lastScheduledAt).Error(0)
+}
+
+type ClusterService struct {
+	mock.Mock
+}
+
+func (srv *ClusterService) GetState(ctx context.Context) (models.ClusterState, error) {
+	args := srv.Called(ctx)
+	return args.Get(0).(models.ClusterState), args.Error(1)
+}
+
+func (srv *ClusterService) CancelJobRun(ctx context.Context, runID uuid.UUID) error {
+	return srv.Called(ctx, runID).Error(0)
+}
+
+func (srv *ClusterService) DrainPeer(ctx context.Context, peerID string, drain bool) error {
+	return srv.Called(ctx, peerID, drain).Error(0)
+}
+
+func (srv *ClusterService) PauseAllocation(ctx context.Context, pause bool) error {
+	return srv.Called(ctx, pause).Error(0)
+}
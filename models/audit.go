@@ -0,0 +1,37 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// AuditEntry records a call made to optimus service which changed its state
+type AuditEntry struct {
+	ID uuid.UUID
+
+	// Actor is the identity of caller
+	Actor string
+	// Method is the full name of rpc called
+	Method string
+
+	ProjectName   string
+	NamespaceName string
+	// Resource is the target of call, like name of job or secret
+	Resource string
+
+	// Status is the code of response, OK if the call succeeded
+	Status  string
+	Message string
+
+	CreatedAt time.Time
+}
+
+// AuditFilter narrows down audit entries, zero values are ignored
+type AuditFilter struct {
+	ProjectName string
+	Actor       string
+	Since       time.Time
+	Until       time.Time
+	Limit       int
+}
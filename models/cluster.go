@@ -0,0 +1,54 @@
+package models
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var (
+	// ErrNotClusterLeader is returned when a cluster command is sent to a
+	// peer which is not leading the cluster
+	ErrNotClusterLeader = errors.New("peer is not the cluster leader")
+)
+
+// ClusterState is the view of built-in scheduler cluster as replicated
+// across all the peers
+type ClusterState struct {
+	Leader           string
+	AllocationPaused bool
+	Members          []ClusterMember
+}
+
+type ClusterMember struct {
+	Name    string
+	Address string
+	Status  string
+
+	// Drained peers don't get any new job runs allocated
+	Drained     bool
+	Allocations []ClusterAllocation
+}
+
+type ClusterAllocation struct {
+	RunID       string
+	Status      string
+	AllocatedAt time.Time
+}
+
+// ClusterService manages peers of the built-in scheduler cluster, commands
+// are replicated to all the peers and should only be issued to the leader
+type ClusterService interface {
+	GetState(context.Context) (ClusterState, error)
+
+	// CancelJobRun fails the run and stops its execution on allocated peer
+	CancelJobRun(context.Context, uuid.UUID) error
+
+	// DrainPeer stops or resumes allocation of new job runs to the peer
+	DrainPeer(ctx context.Context, peerID string, drain bool) error
+
+	// PauseAllocation stops or resumes allocation of new job runs to all peers
+	PauseAllocation(ctx context.Context, pause bool) error
+}
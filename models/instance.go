@@ -73,6 +73,9 @@ type JobRun struct {
 	Instances   []InstanceSpec
 	ScheduledAt time.Time
 	ExecutedAt  time.Time
+
+	// WaitReason explains why a pending run is not yet picked for execution
+	WaitReason string
 }
 
 func (j *JobRun) GetInstance(instanceName string, instanceType InstanceType) (InstanceSpec, error) {
@@ -113,6 +116,23 @@ type InstanceSpec struct {
 	Status JobRunState
 	Data   []InstanceSpecData
 
+	// Attempt is the number of times this instance has been executed for
+	// the job run, starting from 1
+	Attempt int
+
+	// ExitCode, StartedAt, FinishedAt and Reason are only recorded for
+	// instances executed by the built-in scheduler
+	ExitCode   int
+	StartedAt  time.Time
+	FinishedAt time.Time
+	// Reason explains why the instance failed
+	Reason string
+
+	// PreviousExitCode and PreviousReason are kept from the failed attempt
+	// which is retried by this instance
+	PreviousExitCode int
+	PreviousReason   string
+
 	ExecutedAt time.Time
 	UpdatedAt  time.Time
 }
@@ -39,6 +39,9 @@ const (
 
 	JobEventTypeSLAMiss JobEventType = "sla_miss"
 	JobEventTypeFailure JobEventType = "failure"
+	JobEventTypeSuccess JobEventType = "job_success"
+	JobEventTypeStart   JobEventType = "job_start"
+	JobEventTypeRetry   JobEventType = "retry"
 )
 
 // JobSpec represents a job
@@ -335,6 +338,9 @@ type JobService interface {
 	GetByDestination(ctx context.Context, projectSpec ProjectSpec, destination string) (JobSpec, error)
 	// GetDownstream fetches downstream jobspecs
 	GetDownstream(ctx context.Context, projectSpec ProjectSpec, jobName string) ([]JobSpec, error)
+	// GetExternalDownstream fetches jobspecs of projectSpec which depend on jobs of scopeProject and
+	// are outside of it, only jobs of scopeNamespace are considered in scopeProject if it is set
+	GetExternalDownstream(ctx context.Context, projectSpec ProjectSpec, scopeProject ProjectSpec, scopeNamespace string) ([]JobSpec, error)
 }
 
 // JobCompiler takes template file of a scheduler and after applying
@@ -359,6 +365,44 @@ type JobEvent struct {
 	Value map[string]*structpb.Value
 }
 
+// ScheduledAt finds the scheduled time of run event belongs to, events of
+// sla miss carry it for each of the breached items instead
+func (e JobEvent) ScheduledAt() (time.Time, bool) {
+	value := e.Value["scheduled_at"].GetStringValue()
+	if value == "" {
+		for _, sla := range e.Value["slas"].GetListValue().GetValues() {
+			if value = sla.GetStructValue().GetFields()["scheduled_at"].GetStringValue(); value != "" {
+				break
+			}
+		}
+	}
+	scheduledAt, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return time.Time{}, false
+	}
+	return scheduledAt, true
+}
+
+// JobEventRecord is an event registered for a job, kept as history of the job
+type JobEventRecord struct {
+	ID        uuid.UUID
+	Namespace NamespaceSpec
+	JobName   string
+	Event     JobEvent
+
+	// ScheduledAt is zero if event doesn't belong to a run
+	ScheduledAt time.Time
+	CreatedAt   time.Time
+}
+
+// JobEventFilter narrows down registered events of a job, zero values are ignored
+type JobEventFilter struct {
+	Types []JobEventType
+	Since time.Time
+	Until time.Time
+	Limit int
+}
+
 type NotifyAttrs struct {
 	Namespace NamespaceSpec
 
@@ -373,6 +417,13 @@ type Notifier interface {
 	Notify(ctx context.Context, attr NotifyAttrs) error
 }
 
+// IncidentNotifier keeps incidents opened on notifying events until they
+// are resolved by a later success of the job
+type IncidentNotifier interface {
+	Notifier
+	Resolve(ctx context.Context, attr NotifyAttrs) error
+}
+
 // JobSpecMetadata contains metadata for a job spec
 type JobSpecMetadata struct {
 	Resource JobSpecResource
@@ -7,6 +7,7 @@ import (
 	"github.com/odpf/optimus/models"
 
 	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/types/known/structpb"
 )
 
 func TestJob(t *testing.T) {
@@ -146,4 +147,34 @@ func TestJob(t *testing.T) {
 			}
 		})
 	})
+	t.Run("JobEventScheduledAt", func(t *testing.T) {
+		t.Run("should parse scheduled time of run", func(t *testing.T) {
+			evt := models.JobEvent{
+				Type: models.JobEventTypeFailure,
+				Value: map[string]*structpb.Value{
+					"scheduled_at": structpb.NewStringValue("2021-10-01T00:00:00Z"),
+				},
+			}
+			scheduledAt, ok := evt.ScheduledAt()
+			assert.True(t, ok)
+			assert.Equal(t, time.Date(2021, 10, 1, 0, 0, 0, 0, time.UTC), scheduledAt)
+		})
+		t.Run("should use scheduled time of first breached item of sla miss", func(t *testing.T) {
+			slas, _ := structpb.NewList([]interface{}{
+				map[string]interface{}{"task_id": "bq2bq", "scheduled_at": "2021-10-02T00:00:00Z"},
+				map[string]interface{}{"task_id": "bq2bq", "scheduled_at": "2021-10-03T00:00:00Z"},
+			})
+			evt := models.JobEvent{
+				Type:  models.JobEventTypeSLAMiss,
+				Value: map[string]*structpb.Value{"slas": structpb.NewListValue(slas)},
+			}
+			scheduledAt, ok := evt.ScheduledAt()
+			assert.True(t, ok)
+			assert.Equal(t, time.Date(2021, 10, 2, 0, 0, 0, 0, time.UTC), scheduledAt)
+		})
+		t.Run("should return false if event doesn't belong to a run", func(t *testing.T) {
+			_, ok := models.JobEvent{Type: models.JobEventTypeSuccess}.ScheduledAt()
+			assert.False(t, ok)
+		})
+	})
 }
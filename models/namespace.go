@@ -16,3 +16,35 @@ type NamespaceSpec struct {
 }
 
 const AllNamespace = "*"
+
+// EffectiveConfig returns config of project overridden with config of
+// namespace when present, this is the config available to jobs of namespace
+func (n NamespaceSpec) EffectiveConfig() map[string]string {
+	configMap := map[string]string{}
+	for key, val := range n.ProjectSpec.Config {
+		configMap[key] = val
+	}
+	for key, val := range n.Config {
+		configMap[key] = val
+	}
+	return configMap
+}
+
+// DeletionPlan lists everything removed on deleting a project or namespace
+type DeletionPlan struct {
+	Namespaces []NamespaceDeletionPlan
+
+	// Secrets of the namespaces, as well as of the project if it is deleted
+	Secrets []string
+
+	// number of replays of jobs and backups of resources in namespaces
+	Replays int
+	Backups int
+}
+
+// NamespaceDeletionPlan lists jobs and resources removed with a namespace
+type NamespaceDeletionPlan struct {
+	Name      string
+	Jobs      []string
+	Resources []string
+}
@@ -0,0 +1,50 @@
+package models_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/odpf/optimus/models"
+)
+
+func TestNamespaceSpec(t *testing.T) {
+	t.Run("EffectiveConfig", func(t *testing.T) {
+		t.Run("should override config of project with config of namespace", func(t *testing.T) {
+			namespaceSpec := models.NamespaceSpec{
+				Name: "namespace",
+				Config: map[string]string{
+					"BUCKET":  "gs://namespace_folder",
+					"DATASET": "playground",
+				},
+				ProjectSpec: models.ProjectSpec{
+					Name: "project",
+					Config: map[string]string{
+						"BUCKET":      "gs://project_folder",
+						"ENVIRONMENT": "integration",
+					},
+				},
+			}
+			assert.Equal(t, map[string]string{
+				"BUCKET":      "gs://namespace_folder",
+				"DATASET":     "playground",
+				"ENVIRONMENT": "integration",
+			}, namespaceSpec.EffectiveConfig())
+			assert.Equal(t, "gs://project_folder", namespaceSpec.ProjectSpec.Config["BUCKET"])
+		})
+		t.Run("should return config of project if namespace has none", func(t *testing.T) {
+			namespaceSpec := models.NamespaceSpec{
+				Name: "namespace",
+				ProjectSpec: models.ProjectSpec{
+					Name: "project",
+					Config: map[string]string{
+						"BUCKET": "gs://project_folder",
+					},
+				},
+			}
+			assert.Equal(t, map[string]string{
+				"BUCKET": "gs://project_folder",
+			}, namespaceSpec.EffectiveConfig())
+		})
+	})
+}
@@ -2,9 +2,13 @@ package models
 
 import (
 	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
 	"fmt"
 	"io"
 	"strings"
+	"time"
 
 	"github.com/pkg/errors"
 
@@ -22,11 +26,35 @@ const (
 	// Secret used to authenticate with scheduler provided at ProjectSchedulerHost
 	ProjectSchedulerAuth = "SCHEDULER_AUTH"
 
+	// Backend used for storing secrets of project, one of SecretBackendPostgres
+	// or SecretBackendVault, secrets are stored in optimus db if not set
+	ProjectSecretBackend = "SECRET_BACKEND"
+	// Address of the external secret backend, e.g. vault host
+	ProjectSecretBackendHost = "SECRET_BACKEND_HOST"
+	// Mount of kv secrets engine in external secret backend, e.g. secret
+	ProjectSecretBackendMount = "SECRET_BACKEND_MOUNT"
+	// Path under mount where secrets of project are stored
+	ProjectSecretBackendPath = "SECRET_BACKEND_PATH"
+	// Secret used to authenticate with external secret backend, it is always
+	// stored in optimus db
+	ProjectSecretBackendAuth = "SECRET_BACKEND_AUTH"
+
+	SecretBackendPostgres = "postgres"
+	SecretBackendVault    = "vault"
+
 	SecretTypeSystemDefined SecretType = "system"
 	SecretTypeUserDefined   SecretType = "user"
 
 	// Secret name with this prefix indicates managed by system
 	SecretTypeSystemDefinedPrefix = "_OPTIMUS_"
+
+	// DefaultApplicationKeyID identifies the application key when no
+	// identifier is configured
+	DefaultApplicationKeyID = "default"
+
+	// SecretRedacted replaces secret values wherever they could be exposed
+	// e.g. logs or rendered assets
+	SecretRedacted = "*redacted*"
 )
 
 var (
@@ -66,7 +94,7 @@ func (s ProjectSpec) String() string {
 type ProjectSecrets []ProjectSecretItem
 
 func (s ProjectSecrets) String() string {
-	return "*redacted*"
+	return SecretRedacted
 }
 
 func (s ProjectSecrets) GetByName(name string) (string, bool) {
@@ -78,6 +106,17 @@ func (s ProjectSecrets) GetByName(name string) (string, bool) {
 	return "", false
 }
 
+// Redact replaces values of all the secrets present in text
+func (s ProjectSecrets) Redact(text string) string {
+	for _, v := range s {
+		if v.Value == "" {
+			continue
+		}
+		text = strings.ReplaceAll(text, v.Value, SecretRedacted)
+	}
+	return text
+}
+
 type ProjectSecretItem struct {
 	ID uuid.UUID
 
@@ -85,6 +124,25 @@ type ProjectSecretItem struct {
 	Value string
 
 	Type SecretType
+
+	// NamespaceID is nil if the secret is shared across namespaces
+	NamespaceID uuid.UUID
+}
+
+// SecretItemInfo is the metadata of a project secret, it never carries
+// the value of secret
+type SecretItemInfo struct {
+	ID   uuid.UUID
+	Name string
+
+	// Digest is base64 encoded HMAC-SHA256 of secret value keyed with
+	// the application key the secret was stored with
+	Digest string
+
+	// Namespace is empty if the secret is shared across namespaces
+	Namespace string
+	Type      SecretType
+	UpdatedAt time.Time
 }
 
 type SecretType string
@@ -93,21 +151,84 @@ func (s SecretType) String() string {
 	return string(s)
 }
 
+// ApplicationKey encrypts secrets with its primary key, old keys are
+// kept to decrypt secrets encrypted before the key was rotated
 type ApplicationKey struct {
+	id  string
 	key *[32]byte
+
+	oldKeys map[string]*[32]byte
 }
 
 func NewApplicationSecret(k string) (ApplicationKey, error) {
+	return NewApplicationKeyring(DefaultApplicationKeyID, k, nil)
+}
+
+// NewApplicationKeyring creates an application key with k as primary key
+// identified by id, oldKeys are used only for decryption
+func NewApplicationKeyring(id, k string, oldKeys map[string]string) (ApplicationKey, error) {
+	if id == "" {
+		id = DefaultApplicationKeyID
+	}
+	key, err := toApplicationKey(k)
+	if err != nil {
+		return ApplicationKey{}, err
+	}
 	secret := ApplicationKey{
-		key: &[32]byte{},
+		id:      id,
+		key:     key,
+		oldKeys: map[string]*[32]byte{},
+	}
+	for oldID, oldKey := range oldKeys {
+		if oldID == id {
+			return ApplicationKey{}, errors.Errorf("old key can't have same identifier as primary key: %s", id)
+		}
+		if secret.oldKeys[oldID], err = toApplicationKey(oldKey); err != nil {
+			return ApplicationKey{}, errors.Wrapf(err, "invalid old key %s", oldID)
+		}
 	}
+	return secret, nil
+}
+
+func toApplicationKey(k string) (*[32]byte, error) {
+	key := &[32]byte{}
 	if len(k) < 32 {
-		return secret, errors.New("random hash should be 32 chars in length")
+		return key, errors.New("random hash should be 32 chars in length")
 	}
-	_, err := io.ReadFull(bytes.NewBufferString(k), secret.key[:])
-	return secret, err
+	_, err := io.ReadFull(bytes.NewBufferString(k), key[:])
+	return key, err
 }
 
+// GetKey returns the primary key used for encryption
 func (s *ApplicationKey) GetKey() *[32]byte {
 	return s.key
 }
+
+// GetKeyID returns identifier of the primary key
+func (s *ApplicationKey) GetKeyID() string {
+	return s.id
+}
+
+// Digest returns base64 encoded HMAC-SHA256 of value keyed with the key
+// identified by keyID, it identifies a value without exposing a plain hash
+// of it which could be reversed for guessable secrets
+func (s *ApplicationKey) Digest(keyID, value string) (string, error) {
+	key, err := s.GetKeyByID(keyID)
+	if err != nil {
+		return "", err
+	}
+	mac := hmac.New(sha256.New, key[:])
+	mac.Write([]byte(value))
+	return base64.StdEncoding.EncodeToString(mac.Sum(nil)), nil
+}
+
+// GetKeyByID returns the key used for encrypting with identifier id
+func (s *ApplicationKey) GetKeyByID(id string) (*[32]byte, error) {
+	if id == s.id {
+		return s.key, nil
+	}
+	if key, ok := s.oldKeys[id]; ok {
+		return key, nil
+	}
+	return nil, errors.Errorf("application key %s not found", id)
+}
@@ -38,6 +38,19 @@ func TestProjectSpec(t *testing.T) {
 				}
 			})
 		}
+		t.Run("should redact values of all secrets from text", func(t *testing.T) {
+			secrets := models.ProjectSecrets{
+				{
+					Name:  "name",
+					Value: "value",
+				},
+				{
+					Name:  "empty",
+					Value: "",
+				},
+			}
+			assert.Equal(t, "connecting with *redacted*", secrets.Redact("connecting with value"))
+		})
 	})
 	t.Run("ApplicationHash", func(t *testing.T) {
 		rawSecret := "super secret string"
@@ -72,6 +85,59 @@ func TestProjectSpec(t *testing.T) {
 			_, err = cryptopasta.Decrypt(cipher, dec.GetKey())
 			assert.NotNil(t, err)
 		})
+		t.Run("should decrypt text with old key after rotation", func(t *testing.T) {
+			enc, err := models.NewApplicationSecret("test-hashtest-hashtest-hashzzzzz")
+			assert.Nil(t, err)
+			assert.Equal(t, models.DefaultApplicationKeyID, enc.GetKeyID())
+
+			// encrypt secret
+			cipher, err := cryptopasta.Encrypt([]byte(rawSecret), enc.GetKey())
+			assert.Nil(t, err)
+
+			rotated, err := models.NewApplicationKeyring("v2", "zest-hashtest-hashtest-hashzzzzz",
+				map[string]string{models.DefaultApplicationKeyID: "test-hashtest-hashtest-hashzzzzz"})
+			assert.Nil(t, err)
+			assert.Equal(t, "v2", rotated.GetKeyID())
+
+			// decrypt secret
+			key, err := rotated.GetKeyByID(enc.GetKeyID())
+			assert.Nil(t, err)
+			value, err := cryptopasta.Decrypt(cipher, key)
+			assert.Nil(t, err)
+			assert.Equal(t, rawSecret, string(value))
+
+			_, err = rotated.GetKeyByID("v1")
+			assert.NotNil(t, err)
+		})
+		t.Run("should fail to create keyring if old key has identifier of primary key", func(t *testing.T) {
+			_, err := models.NewApplicationKeyring("v2", "zest-hashtest-hashtest-hashzzzzz",
+				map[string]string{"v2": "test-hashtest-hashtest-hashzzzzz"})
+			assert.NotNil(t, err)
+		})
+		t.Run("should digest value keyed with key of given identifier", func(t *testing.T) {
+			hash, err := models.NewApplicationKeyring("v1", "test-hashtest-hashtest-hashzzzzz", nil)
+			assert.Nil(t, err)
+			rotated, err := models.NewApplicationKeyring("v2", "zest-hashtest-hashtest-hashzzzzz",
+				map[string]string{"v1": "test-hashtest-hashtest-hashzzzzz"})
+			assert.Nil(t, err)
+
+			digest, err := hash.Digest("v1", rawSecret)
+			assert.Nil(t, err)
+			otherDigest, err := hash.Digest("v1", "other secret")
+			assert.Nil(t, err)
+			assert.NotEqual(t, digest, otherDigest)
+
+			// digest stays same after rotation as long as old key is kept
+			rotatedDigest, err := rotated.Digest("v1", rawSecret)
+			assert.Nil(t, err)
+			assert.Equal(t, digest, rotatedDigest)
+			primaryDigest, err := rotated.Digest("v2", rawSecret)
+			assert.Nil(t, err)
+			assert.NotEqual(t, digest, primaryDigest)
+
+			_, err = hash.Digest("v2", rawSecret)
+			assert.NotNil(t, err)
+		})
 		t.Run("should fail to encrypt if hash size is not atleast 32", func(t *testing.T) {
 			_, err := models.NewApplicationSecret("test-hashtestzs")
 			assert.NotNil(t, err)
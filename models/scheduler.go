@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/google/uuid"
 	"github.com/odpf/optimus/core/progress"
 )
 
@@ -51,6 +52,21 @@ type SchedulerListOptions struct {
 type JobStatus struct {
 	ScheduledAt time.Time
 	State       JobRunState
+
+	// Instances are only available for jobs executed by built-in scheduler
+	Instances []InstanceSpec
+}
+
+// JobDeployment is a job deployed to the built-in scheduler, runs are
+// created from it as per the job schedule
+type JobDeployment struct {
+	ID        uuid.UUID
+	Namespace NamespaceSpec
+	Spec      JobSpec
+
+	// LastScheduledAt is the schedule time of the latest run created
+	// for this deployment
+	LastScheduledAt time.Time
 }
 
 // progress events
@@ -108,6 +124,11 @@ type ExecutorStartRequest struct {
 
 	Job       JobSpec
 	Namespace NamespaceSpec
+
+	// JobRun and Instance identifies which task or hook of the job
+	// needs to be executed
+	JobRun   JobRun
+	Instance InstanceSpec
 }
 
 type ExecutorStopRequest struct {
@@ -3,8 +3,10 @@ package run
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"time"
 
+	"github.com/google/uuid"
 	"github.com/odpf/optimus/models"
 	"github.com/pkg/errors"
 )
@@ -23,6 +25,10 @@ var (
 	// IgnoreTemplateRenderExtension used as extension on a file will skip template
 	// rendering of it
 	IgnoreTemplateRenderExtension = []string{".gtpl", ".j2", ".tmpl", ".tpl"}
+
+	// secretReferencePattern finds secrets used in templates of both engines,
+	// e.g. {{ .secret.NAME }} or {{ secret.NAME }}
+	secretReferencePattern = regexp.MustCompile(`\bsecret\.([A-Za-z0-9_]+)`)
 )
 
 // ContextManager fetches all config data for a given instanceSpec and compiles all
@@ -31,7 +37,7 @@ var (
 // Raw task assets may not be executable in there default state and needs to be
 // transformed before they can work as inputs. Input could be through
 // environment variables or as a file.
-// It exposes .proj, .inst, .task template variable names containing configs that can be
+// It exposes .proj, .inst, .task, .secret template variable names containing configs that can be
 // used in job specification
 type ContextManager struct {
 	namespace models.NamespaceSpec
@@ -53,6 +59,7 @@ func (fm *ContextManager) Generate(instanceSpec models.InstanceSpec) (
 	projectInstanceContext := MergeInterfaceMapToInterface(instanceEnvMap, projectPrefixedConfig)
 	projectInstanceContext["proj"] = projRawConfig
 	projectInstanceContext["inst"] = instanceEnvMap
+	projectInstanceContext["secret"] = fm.getSecretMap()
 
 	// prepare configs
 	envMap, err = fm.generateEnvs(instanceSpec.Name, instanceSpec.Type, projectInstanceContext)
@@ -93,14 +100,10 @@ func (fm *ContextManager) projectEnvs() (map[string]interface{}, map[string]inte
 	// prefix project configs to avoid conflicts with project/instance configs
 	projectPrefixedConfig := map[string]interface{}{}
 	projRawConfig := map[string]interface{}{}
-	for key, val := range fm.getProjectConfigMap() {
-		projectPrefixedConfig[fmt.Sprintf("%s%s", ProjectConfigPrefix, key)] = val
-		projRawConfig[key] = val
-	}
 
 	// use namespace configs for templating. also, override project config with
 	// namespace's configs when present
-	for key, val := range fm.getNamespaceConfigMap() {
+	for key, val := range fm.namespace.EffectiveConfig() {
 		projectPrefixedConfig[fmt.Sprintf("%s%s", ProjectConfigPrefix, key)] = val
 		projRawConfig[key] = val
 	}
@@ -156,20 +159,21 @@ func (fm *ContextManager) compileTemplates(templateValueMap, templateContext map
 	return templateValueMap, nil
 }
 
-func (fm *ContextManager) getProjectConfigMap() map[string]string {
-	configMap := map[string]string{}
-	for key, val := range fm.namespace.ProjectSpec.Config {
-		configMap[key] = val
+// getSecretMap prepares secrets of project for templating, secrets of
+// namespace override the ones shared across namespaces with same name
+func (fm *ContextManager) getSecretMap() map[string]string {
+	secretMap := map[string]string{}
+	for _, secret := range fm.namespace.ProjectSpec.Secret {
+		if secret.NamespaceID == uuid.Nil {
+			secretMap[secret.Name] = secret.Value
+		}
 	}
-	return configMap
-}
-
-func (fm *ContextManager) getNamespaceConfigMap() map[string]string {
-	configMap := map[string]string{}
-	for key, val := range fm.namespace.Config {
-		configMap[key] = val
+	for _, secret := range fm.namespace.ProjectSpec.Secret {
+		if secret.NamespaceID != uuid.Nil && secret.NamespaceID == fm.namespace.ID {
+			secretMap[secret.Name] = secret.Value
+		}
 	}
-	return configMap
+	return secretMap
 }
 
 func (fm *ContextManager) getInstanceData(instanceSpec models.InstanceSpec) (map[string]interface{}, map[string]string) {
@@ -306,6 +310,7 @@ func DumpAssets(jobSpec models.JobSpec, scheduledAt time.Time, engine models.Tem
 		ConfigKeyDend:          jobSpec.Task.Window.GetEnd(scheduledAt).Format(models.InstanceScheduledAtTimeLayout),
 		ConfigKeyExecutionTime: scheduledAt.Format(models.InstanceScheduledAtTimeLayout),
 		ConfigKeyDestination:   jobDestination,
+		"secret":               redactedSecretMap(assetsToDump),
 	})
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to compile templates")
@@ -313,3 +318,15 @@ func DumpAssets(jobSpec models.JobSpec, scheduledAt time.Time, engine models.Tem
 
 	return templates, nil
 }
+
+// redactedSecretMap maps all the secrets referenced in templates to a redacted
+// value, actual values are only available when an instance is registered
+func redactedSecretMap(templates map[string]string) map[string]string {
+	secretMap := map[string]string{}
+	for _, content := range templates {
+		for _, match := range secretReferencePattern.FindAllStringSubmatch(content, -1) {
+			secretMap[match[1]] = models.SecretRedacted
+		}
+	}
+	return secretMap
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/odpf/optimus/models"
 	"github.com/odpf/optimus/run"
 	"github.com/stretchr/testify/assert"
+	mocklib "github.com/stretchr/testify/mock"
 )
 
 func TestContextManager(t *testing.T) {
@@ -319,6 +320,111 @@ func TestContextManager(t *testing.T) {
 				fileMap["query.sql"],
 			)
 		})
+		t.Run("should return compiled instanceSpec config with secrets of namespace overriding secrets of project", func(t *testing.T) {
+			namespaceID := uuid.Must(uuid.NewRandom())
+			projectSpec := models.ProjectSpec{
+				ID:   uuid.Must(uuid.NewRandom()),
+				Name: "humara-projectSpec",
+				Secret: models.ProjectSecrets{
+					{
+						Name:  "DB_PASSWORD",
+						Value: "project-password",
+					},
+					{
+						Name:  "API_TOKEN",
+						Value: "project-token",
+					},
+					{
+						Name:        "API_TOKEN",
+						Value:       "namespace-token",
+						NamespaceID: namespaceID,
+					},
+					{
+						Name:        "DB_PASSWORD",
+						Value:       "other-namespace-password",
+						NamespaceID: uuid.Must(uuid.NewRandom()),
+					},
+				},
+			}
+			namespaceSpec := models.NamespaceSpec{
+				ID:          namespaceID,
+				Name:        "namespace-1",
+				Config:      map[string]string{},
+				ProjectSpec: projectSpec,
+			}
+
+			execUnit := new(mock.BasePlugin)
+			cliMod := new(mock.CLIMod)
+
+			jobSpec := models.JobSpec{
+				Name: "foo",
+				Task: models.JobSpecTask{
+					Unit: &models.Plugin{Base: execUnit, CLIMod: cliMod},
+					Window: models.JobSpecTaskWindow{
+						Size:       time.Hour,
+						Offset:     0,
+						TruncateTo: "d",
+					},
+					Config: models.JobSpecConfigs{
+						{
+							Name:  "PASSWORD",
+							Value: "{{.secret.DB_PASSWORD}}",
+						},
+					},
+				},
+				Hooks: []models.JobSpecHook{
+					{
+						Config: models.JobSpecConfigs{
+							{
+								Name:  "TOKEN",
+								Value: "{{.secret.API_TOKEN}}",
+							},
+						},
+						Unit: &models.Plugin{Base: execUnit},
+					},
+				},
+				Assets: *models.JobAssets{}.New(
+					[]models.JobSpecAsset{
+						{
+							Name:  "query.sql",
+							Value: "select * from table",
+						},
+					},
+				),
+			}
+			jobRun := models.JobRun{
+				Spec:        jobSpec,
+				Trigger:     models.TriggerSchedule,
+				Status:      models.RunStateAccepted,
+				ScheduledAt: time.Date(2020, 11, 11, 0, 0, 0, 0, time.UTC),
+			}
+			instanceSpec := models.InstanceSpec{
+				Name:   "transporter",
+				Type:   models.InstanceTypeHook,
+				Status: models.RunStateRunning,
+			}
+
+			execUnit.On("PluginInfo").Return(&models.PluginInfoResponse{
+				Name: "transporter",
+			}, nil)
+			cliMod.On("CompileAssets", mocklib.Anything, models.CompileAssetsRequest{
+				Window:           jobSpec.Task.Window,
+				Config:           models.PluginConfigs{}.FromJobSpec(jobSpec.Task.Config),
+				Assets:           models.PluginAssets{}.FromJobSpec(jobSpec.Assets),
+				InstanceSchedule: jobRun.ScheduledAt,
+			}).Return(&models.CompileAssetsResponse{Assets: models.PluginAssets{
+				models.PluginAsset{
+					Name:  "query.sql",
+					Value: "select * from table",
+				},
+			}}, nil)
+
+			envMap, _, err := run.NewContextManager(namespaceSpec, jobRun, run.NewGoEngine()).Generate(instanceSpec)
+			assert.Nil(t, err)
+
+			assert.Equal(t, "project-password", envMap["TASK__PASSWORD"])
+			assert.Equal(t, "namespace-token", envMap["TOKEN"])
+		})
 		t.Run("should return compiled instanceSpec config with overridden config provided in NamespaceSpec", func(t *testing.T) {
 			projectName := "humara-projectSpec"
 			projectSpec := models.ProjectSpec{
@@ -457,3 +563,31 @@ func TestContextManager(t *testing.T) {
 		})
 	})
 }
+
+func TestDumpAssets(t *testing.T) {
+	t.Run("should redact values of secrets used in assets", func(t *testing.T) {
+		jobSpec := models.JobSpec{
+			Name: "foo",
+			Task: models.JobSpecTask{
+				Unit: &models.Plugin{},
+				Window: models.JobSpecTaskWindow{
+					Size:       time.Hour,
+					Offset:     0,
+					TruncateTo: "d",
+				},
+			},
+			Assets: *models.JobAssets{}.New(
+				[]models.JobSpecAsset{
+					{
+						Name:  "query.sql",
+						Value: "select * from table WHERE password = '{{.secret.DB_PASSWORD}}'",
+					},
+				},
+			),
+		}
+
+		assets, err := run.DumpAssets(jobSpec, time.Date(2020, 11, 11, 0, 0, 0, 0, time.UTC), run.NewGoEngine(), false)
+		assert.Nil(t, err)
+		assert.Equal(t, "select * from table WHERE password = '*redacted*'", assets["query.sql"])
+	})
+}
@@ -45,6 +45,10 @@ func TestJinjaCompiler(t *testing.T) {
 					`{% set vv = 2 %} {{vv}}`,
 					" 2",
 				},
+				{
+					"password = \"{{ secret.DB_PASSWORD }}\"",
+					"password = \"secret-password\"",
+				},
 				{
 					`{% list vv = 2 3 4 6 %}
 {%- for v in vv -%}{{ v }},{%- endfor -%}`,
@@ -57,6 +61,9 @@ func TestJinjaCompiler(t *testing.T) {
 					"DSTART":         "2021-02-10T10:00:00+00:00",
 					"DEND":           "2021-02-11T10:00:00+00:00",
 					"EXECUTION_TIME": "empty val",
+					"secret": map[string]string{
+						"DB_PASSWORD": "secret-password",
+					},
 					"Date": func(timeStr string) (string, error) {
 						t, err := time.Parse(models.InstanceScheduledAtTimeLayout, timeStr)
 						if err != nil {
@@ -82,11 +82,13 @@ func (s *Service) Register(ctx context.Context, namespace models.NamespaceSpec,
 	jobRunRepo := s.repoFac.New()
 
 	// clear old run
+	var previous models.InstanceSpec
 	for _, instance := range jobRun.Instances {
 		if instance.Name == instanceName && instance.Type == instanceType {
 			if err := jobRunRepo.ClearInstance(ctx, jobRun.ID, instance.Type, instance.Name); err != nil && !errors.Is(err, store.ErrResourceNotFound) {
 				return models.InstanceSpec{}, errors.Wrapf(err, "Register: failed to clear instance of job %s", jobRun)
 			}
+			previous = instance
 			break
 		}
 	}
@@ -95,6 +97,12 @@ func (s *Service) Register(ctx context.Context, namespace models.NamespaceSpec,
 	if err != nil {
 		return models.InstanceSpec{}, errors.Wrap(err, "Register: failed to prepare instance")
 	}
+	instanceToSave.Attempt = previous.Attempt + 1
+	if previous.Status == models.RunStateFailed {
+		// keep why the last attempt failed as its row is replaced
+		instanceToSave.PreviousExitCode = previous.ExitCode
+		instanceToSave.PreviousReason = previous.Reason
+	}
 	if err := jobRunRepo.AddInstance(ctx, namespace, jobRun, instanceToSave); err != nil {
 		return models.InstanceSpec{}, err
 	}
@@ -75,6 +75,7 @@ func TestService(t *testing.T) {
 				Type:       models.InstanceTypeTask,
 				ExecutedAt: mockedTimeNow,
 				Status:     models.RunStateRunning,
+				Attempt:    1,
 				Data: []models.InstanceSpecData{
 					{
 						Name:  run.ConfigKeyExecutionTime,
@@ -122,6 +123,7 @@ func TestService(t *testing.T) {
 				Type:       models.InstanceTypeTask,
 				ExecutedAt: mockedTimeNow,
 				Status:     models.RunStateRunning,
+				Attempt:    2,
 				Data: []models.InstanceSpecData{
 					{
 						Name:  run.ConfigKeyExecutionTime,
@@ -165,18 +167,53 @@ func TestService(t *testing.T) {
 			existingRun.Spec.Task = jobRun.Spec.Task
 			existingRun.Instances = append(existingRun.Instances, instanceSpec)
 			existingRun.Instances[0].ExecutedAt = mockedTimeNow
+			existingRun.Instances[0].Attempt = 1
 			runRepo.On("AddInstance", ctx, namespaceSpec, existingRun, instanceSpec).Return(nil)
 
 			returnedInstanceSpec, err := runService.Register(ctx, namespaceSpec, existingRun, models.InstanceTypeTask, "bq")
 			assert.Nil(t, err)
 			assert.Equal(t, instanceSpec, returnedInstanceSpec)
 		})
+		t.Run("for retried transformation, should keep exit code and reason of failed attempt", func(t *testing.T) {
+			failedInstance := models.InstanceSpec{
+				Name:     "bq",
+				Type:     models.InstanceTypeTask,
+				Status:   models.RunStateFailed,
+				Attempt:  1,
+				ExitCode: 2,
+				Reason:   "exited with code 2",
+			}
+			failedRun := jobRun
+			failedRun.Instances = []models.InstanceSpec{failedInstance}
+
+			runRepo := new(mock.JobRunRepository)
+			runRepo.On("ClearInstance", ctx, jobRun.ID, models.InstanceTypeTask, "bq").Return(nil)
+			var savedInstance models.InstanceSpec
+			runRepo.On("AddInstance", ctx, namespaceSpec, failedRun, mock2.AnythingOfType("models.InstanceSpec")).
+				Run(func(args mock2.Arguments) {
+					savedInstance = args.Get(3).(models.InstanceSpec)
+				}).Return(nil)
+			runRepo.On("GetByID", ctx, jobRun.ID).Return(failedRun, namespaceSpec, nil)
+			defer runRepo.AssertExpectations(t)
+
+			jobRunSpecRep := new(mock.JobRunRepoFactory)
+			jobRunSpecRep.On("New").Return(runRepo, nil)
+
+			runService := run.NewService(jobRunSpecRep, nil, nil)
+			_, err := runService.Register(ctx, namespaceSpec, failedRun, models.InstanceTypeTask, "bq")
+			assert.Nil(t, err)
+			assert.Equal(t, 2, savedInstance.Attempt)
+			assert.Equal(t, models.RunStateRunning, savedInstance.Status)
+			assert.Equal(t, 2, savedInstance.PreviousExitCode)
+			assert.Equal(t, "exited with code 2", savedInstance.PreviousReason)
+		})
 		t.Run("for hook, should save specs if not present and return data", func(t *testing.T) {
 			instanceSpec := models.InstanceSpec{
 				Name:       "bq",
 				Type:       models.InstanceTypeHook,
 				ExecutedAt: mockedTimeNow,
 				Status:     models.RunStateRunning,
+				Attempt:    1,
 				Data: []models.InstanceSpecData{
 					{
 						Name:  run.ConfigKeyExecutionTime,
@@ -224,6 +261,7 @@ func TestService(t *testing.T) {
 				Type:       models.InstanceTypeHook,
 				ExecutedAt: mockedTimeNow,
 				Status:     models.RunStateRunning,
+				Attempt:    2,
 				Data: []models.InstanceSpecData{
 					{
 						Name:  run.ConfigKeyExecutionTime,
@@ -253,12 +291,14 @@ func TestService(t *testing.T) {
 			var existingJobRun models.JobRun
 			Copy(&existingJobRun, &jobRun)
 			existingJobRun.Instances = append(jobRun.Instances, instanceSpec)
+			existingJobRun.Instances[0].Attempt = 1
 			existingJobRun.Spec.Task.Unit = jobRun.Spec.Task.Unit
 			runRepo.On("ClearInstance", ctx, jobRun.ID, instanceSpec.Type, instanceSpec.Name).Return(nil)
 
 			var newJobRun models.JobRun
 			Copy(&newJobRun, &existingJobRun)
 			newJobRun.Spec.Task.Unit = jobRun.Spec.Task.Unit
+			newJobRun.Instances = []models.InstanceSpec{instanceSpec}
 			runRepo.On("AddInstance", ctx, namespaceSpec, existingJobRun, instanceSpec).Return(nil)
 			runRepo.On("GetByID", ctx, jobRun.ID).Return(newJobRun, namespaceSpec, nil)
 			defer runRepo.AssertExpectations(t)
@@ -278,6 +318,7 @@ func TestService(t *testing.T) {
 				Type:       models.InstanceTypeTask,
 				ExecutedAt: mockedTimeNow,
 				Status:     models.RunStateRunning,
+				Attempt:    1,
 				Data: []models.InstanceSpecData{
 					{
 						Name:  run.ConfigKeyExecutionTime,
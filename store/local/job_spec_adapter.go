@@ -65,7 +65,7 @@ type JobBehaviorRetry struct {
 }
 
 type JobNotifier struct {
-	On       string `yaml:"on" json:"on" validate:"regexp=^(sla_miss|failure|)$"`
+	On       string `yaml:"on" json:"on" validate:"regexp=^(sla_miss|failure|job_start|job_success|retry|)$"`
 	Config   map[string]string
 	Channels []string
 }
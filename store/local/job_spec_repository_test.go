@@ -193,6 +193,19 @@ func TestJobSpecRepository(t *testing.T) {
 			err := repo.SaveAt(testSpec, "")
 			assert.NotNil(t, err)
 		})
+		t.Run("should accept notify on job start, success and retry", func(t *testing.T) {
+			appFS := afero.NewMemMapFs()
+			repo := local.NewJobSpecRepository(appFS, adapter)
+			testSpec := spec2
+			for _, on := range []models.JobEventType{models.JobEventTypeStart, models.JobEventTypeSuccess, models.JobEventTypeRetry} {
+				testSpec.Behavior.Notify = append(testSpec.Behavior.Notify, models.JobSpecNotifier{
+					On:       on,
+					Channels: []string{"slack://#optimus-devs"},
+				})
+			}
+			err := repo.SaveAt(testSpec, "")
+			assert.Nil(t, err)
+		})
 		t.Run("should update the file with hooks in the same spec ${ROOT}/${name}.yaml", func(t *testing.T) {
 			appFS := afero.NewMemMapFs()
 
@@ -423,6 +423,7 @@ type JobRun struct {
 
 type JobRunData struct {
 	ExecutedAt time.Time
+	WaitReason string `json:",omitempty"`
 }
 
 func (adapt JobSpecAdapter) FromJobRun(jr models.JobRun, nsSpec models.NamespaceSpec) (JobRun, error) {
@@ -437,6 +438,7 @@ func (adapt JobSpecAdapter) FromJobRun(jr models.JobRun, nsSpec models.Namespace
 
 	dataBytes, err := json.Marshal(JobRunData{
 		ExecutedAt: jr.ExecutedAt,
+		WaitReason: jr.WaitReason,
 	})
 	if err != nil {
 		return JobRun{}, err
@@ -530,5 +532,6 @@ func (adapt JobSpecAdapter) ToJobRun(jr JobRun) (models.JobRun, models.Namespace
 		ScheduledAt: jr.ScheduledAt,
 		Instances:   instanceSpecs,
 		ExecutedAt:  adaptedData.ExecutedAt,
+		WaitReason:  adaptedData.WaitReason,
 	}, adaptNamespace, nil
 }
@@ -0,0 +1,99 @@
+package postgres
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/odpf/optimus/models"
+	"gorm.io/gorm"
+)
+
+type Audit struct {
+	ID uuid.UUID `gorm:"primary_key;type:uuid;default:uuid_generate_v4()"`
+
+	Actor  string `gorm:"not null"`
+	Method string `gorm:"not null"`
+
+	ProjectName   string
+	NamespaceName string
+	Resource      string
+
+	Status  string `gorm:"not null"`
+	Message string
+
+	CreatedAt time.Time `gorm:"not null" json:"created_at"`
+}
+
+func (a Audit) FromSpec(entry models.AuditEntry) Audit {
+	return Audit{
+		ID:            entry.ID,
+		Actor:         entry.Actor,
+		Method:        entry.Method,
+		ProjectName:   entry.ProjectName,
+		NamespaceName: entry.NamespaceName,
+		Resource:      entry.Resource,
+		Status:        entry.Status,
+		Message:       entry.Message,
+		CreatedAt:     entry.CreatedAt,
+	}
+}
+
+func (a Audit) ToSpec() models.AuditEntry {
+	return models.AuditEntry{
+		ID:            a.ID,
+		Actor:         a.Actor,
+		Method:        a.Method,
+		ProjectName:   a.ProjectName,
+		NamespaceName: a.NamespaceName,
+		Resource:      a.Resource,
+		Status:        a.Status,
+		Message:       a.Message,
+		CreatedAt:     a.CreatedAt,
+	}
+}
+
+type auditRepository struct {
+	db *gorm.DB
+}
+
+func (repo *auditRepository) Insert(ctx context.Context, entry models.AuditEntry) error {
+	resource := Audit{}.FromSpec(entry)
+	return repo.db.WithContext(ctx).Create(&resource).Error
+}
+
+// List returns audit entries matching filter, latest first
+func (repo *auditRepository) List(ctx context.Context, filter models.AuditFilter) ([]models.AuditEntry, error) {
+	query := repo.db.WithContext(ctx)
+	if filter.ProjectName != "" {
+		query = query.Where("project_name = ?", filter.ProjectName)
+	}
+	if filter.Actor != "" {
+		query = query.Where("actor = ?", filter.Actor)
+	}
+	if !filter.Since.IsZero() {
+		query = query.Where("created_at >= ?", filter.Since)
+	}
+	if !filter.Until.IsZero() {
+		query = query.Where("created_at < ?", filter.Until)
+	}
+	if filter.Limit > 0 {
+		query = query.Limit(filter.Limit)
+	}
+
+	var resources []Audit
+	if err := query.Order("created_at DESC").Find(&resources).Error; err != nil {
+		return nil, err
+	}
+	var entries []models.AuditEntry
+	for _, res := range resources {
+		entries = append(entries, res.ToSpec())
+	}
+	return entries, nil
+}
+
+func NewAuditRepository(db *gorm.DB) *auditRepository {
+	return &auditRepository{
+		db: db,
+	}
+}
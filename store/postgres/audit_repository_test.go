@@ -0,0 +1,109 @@
+// +build !unit_test
+
+package postgres
+
+import (
+	"context"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/odpf/optimus/models"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+)
+
+func TestAuditRepository(t *testing.T) {
+	ctx := context.Background()
+	DBSetup := func() *gorm.DB {
+		dbURL, ok := os.LookupEnv("TEST_OPTIMUS_DB_URL")
+		if !ok {
+			panic("unable to find TEST_OPTIMUS_DB_URL env var")
+		}
+		dbConn, err := Connect(dbURL, 1, 1, os.Stdout)
+		if err != nil {
+			panic(err)
+		}
+		m, err := NewHTTPFSMigrator(dbURL)
+		if err != nil {
+			panic(err)
+		}
+		if err := m.Drop(); err != nil {
+			panic(err)
+		}
+		if err := Migrate(dbURL); err != nil {
+			panic(err)
+		}
+		return dbConn
+	}
+
+	now := time.Now().UTC().Truncate(time.Second)
+	entries := []models.AuditEntry{
+		{
+			Actor:         "ci",
+			Method:        "/odpf.optimus.core.v1beta1.RuntimeService/DeployJobSpecification",
+			ProjectName:   "a-data-project",
+			NamespaceName: "dev-team-1",
+			Resource:      "job-1,job-2",
+			Status:        "OK",
+			CreatedAt:     now.Add(-2 * time.Hour),
+		},
+		{
+			Actor:         "user@example.io",
+			Method:        "/odpf.optimus.core.v1beta1.RuntimeService/RegisterSecret",
+			ProjectName:   "a-data-project",
+			NamespaceName: "dev-team-1",
+			Resource:      "secret-1",
+			Status:        "AlreadyExists",
+			Message:       "secret already exist",
+			CreatedAt:     now.Add(-time.Hour),
+		},
+		{
+			Actor:       "user@example.io",
+			Method:      "/odpf.optimus.core.v1beta1.RuntimeService/DeleteSecret",
+			ProjectName: "b-data-project",
+			Resource:    "secret-1",
+			Status:      "OK",
+			CreatedAt:   now,
+		},
+	}
+
+	t.Run("List", func(t *testing.T) {
+		db := DBSetup()
+		repo := NewAuditRepository(db)
+		for _, entry := range entries {
+			assert.Nil(t, repo.Insert(ctx, entry))
+		}
+
+		t.Run("should return all entries latest first", func(t *testing.T) {
+			result, err := repo.List(ctx, models.AuditFilter{})
+			assert.Nil(t, err)
+			assert.Equal(t, 3, len(result))
+			assert.Equal(t, "/odpf.optimus.core.v1beta1.RuntimeService/DeleteSecret", result[0].Method)
+			assert.Equal(t, "secret already exist", result[1].Message)
+			assert.Equal(t, "job-1,job-2", result[2].Resource)
+		})
+		t.Run("should filter entries by project, actor and time range", func(t *testing.T) {
+			result, err := repo.List(ctx, models.AuditFilter{ProjectName: "a-data-project"})
+			assert.Nil(t, err)
+			assert.Equal(t, 2, len(result))
+
+			result, err = repo.List(ctx, models.AuditFilter{ProjectName: "a-data-project", Actor: "ci"})
+			assert.Nil(t, err)
+			assert.Equal(t, 1, len(result))
+			assert.Equal(t, "ci", result[0].Actor)
+
+			result, err = repo.List(ctx, models.AuditFilter{
+				Since: now.Add(-90 * time.Minute),
+				Until: now,
+			})
+			assert.Nil(t, err)
+			assert.Equal(t, 1, len(result))
+			assert.Equal(t, "secret-1", result[0].Resource)
+
+			result, err = repo.List(ctx, models.AuditFilter{Limit: 1})
+			assert.Nil(t, err)
+			assert.Equal(t, 1, len(result))
+		})
+	})
+}
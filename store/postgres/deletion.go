@@ -0,0 +1,129 @@
+package postgres
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/odpf/optimus/models"
+	"gorm.io/gorm"
+)
+
+// deletionScope is the set of namespaces removed together, along with
+// their project when project is set
+type deletionScope struct {
+	namespaces []Namespace
+	project    *Project
+}
+
+func (s deletionScope) namespaceIDs() []uuid.UUID {
+	ids := make([]uuid.UUID, len(s.namespaces))
+	for i, namespace := range s.namespaces {
+		ids[i] = namespace.ID
+	}
+	return ids
+}
+
+// plan lists everything in scope which is not already soft deleted, secrets
+// kept outside of db by secret backend of project are listed by the caller
+func (s deletionScope) plan(ctx context.Context, db *gorm.DB) (models.DeletionPlan, error) {
+	db = db.WithContext(ctx)
+	ids := s.namespaceIDs()
+	plan := models.DeletionPlan{
+		Namespaces: make([]models.NamespaceDeletionPlan, len(s.namespaces)),
+	}
+	planIndex := map[uuid.UUID]int{}
+	for i, namespace := range s.namespaces {
+		plan.Namespaces[i].Name = namespace.Name
+		planIndex[namespace.ID] = i
+	}
+
+	var jobs []Job
+	if err := db.Select("name", "namespace_id").Where("namespace_id IN ?", ids).Order("name").
+		Find(&jobs).Error; err != nil {
+		return models.DeletionPlan{}, err
+	}
+	for _, job := range jobs {
+		plan.Namespaces[planIndex[job.NamespaceID]].Jobs = append(plan.Namespaces[planIndex[job.NamespaceID]].Jobs, job.Name)
+	}
+
+	var resources []Resource
+	if err := db.Select("name", "namespace_id").Where("namespace_id IN ?", ids).Order("name").
+		Find(&resources).Error; err != nil {
+		return models.DeletionPlan{}, err
+	}
+	for _, resource := range resources {
+		plan.Namespaces[planIndex[resource.NamespaceID]].Resources = append(plan.Namespaces[planIndex[resource.NamespaceID]].Resources, resource.Name)
+	}
+
+	if err := s.secrets(db).Model(&Secret{}).Order("name").Pluck("name", &plan.Secrets).Error; err != nil {
+		return models.DeletionPlan{}, err
+	}
+
+	var replays, backups int64
+	if err := db.Model(&Replay{}).Where("job_id IN (?)", s.jobIDs(db)).Count(&replays).Error; err != nil {
+		return models.DeletionPlan{}, err
+	}
+	if err := db.Model(&Backup{}).Where("resource_id IN (?)", s.resourceIDs(db)).Count(&backups).Error; err != nil {
+		return models.DeletionPlan{}, err
+	}
+	plan.Replays, plan.Backups = int(replays), int(backups)
+	return plan, nil
+}
+
+// delete permanently removes everything in scope in a single transaction,
+// soft deleted records are removed as well so that names can be reused
+func (s deletionScope) delete(ctx context.Context, db *gorm.DB) error {
+	ids := s.namespaceIDs()
+	return db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("job_id IN (?)", s.jobIDs(tx)).Delete(&Replay{}).Error; err != nil {
+			return err
+		}
+		// instances of runs are removed by cascade
+		if err := tx.Where("job_id IN (?) OR namespace_id IN ?", s.jobIDs(tx), ids).Delete(&JobRun{}).Error; err != nil {
+			return err
+		}
+		if err := tx.Where("resource_id IN (?)", s.resourceIDs(tx)).Delete(&Backup{}).Error; err != nil {
+			return err
+		}
+		if err := tx.Where("namespace_id IN ?", ids).Delete(&JobDeployment{}).Error; err != nil {
+			return err
+		}
+		if err := tx.Where("namespace_id IN ?", ids).Delete(&JobEvent{}).Error; err != nil {
+			return err
+		}
+		if err := s.secrets(tx).Unscoped().Delete(&Secret{}).Error; err != nil {
+			return err
+		}
+		if err := tx.Unscoped().Where("namespace_id IN ?", ids).Delete(&Resource{}).Error; err != nil {
+			return err
+		}
+		if err := tx.Unscoped().Where("namespace_id IN ?", ids).Delete(&Job{}).Error; err != nil {
+			return err
+		}
+		if err := tx.Unscoped().Where("id IN ?", ids).Delete(&Namespace{}).Error; err != nil {
+			return err
+		}
+		if s.project != nil {
+			return tx.Unscoped().Where("id = ?", s.project.ID).Delete(&Project{}).Error
+		}
+		return nil
+	})
+}
+
+func (s deletionScope) jobIDs(db *gorm.DB) *gorm.DB {
+	return db.Session(&gorm.Session{NewDB: true}).Unscoped().Model(&Job{}).Select("id").
+		Where("namespace_id IN ?", s.namespaceIDs())
+}
+
+func (s deletionScope) resourceIDs(db *gorm.DB) *gorm.DB {
+	return db.Session(&gorm.Session{NewDB: true}).Unscoped().Model(&Resource{}).Select("id").
+		Where("namespace_id IN ?", s.namespaceIDs())
+}
+
+// secrets of project are in scope only if the project is
+func (s deletionScope) secrets(db *gorm.DB) *gorm.DB {
+	if s.project != nil {
+		return db.Where("project_id = ?", s.project.ID)
+	}
+	return db.Where("namespace_id IN ?", s.namespaceIDs())
+}
@@ -26,6 +26,15 @@ type Instance struct {
 	ExecutedAt *time.Time
 	Status     string
 	Data       datatypes.JSON
+	Attempt    int
+
+	ExitCode   *int
+	StartedAt  *time.Time
+	FinishedAt *time.Time
+	Reason     string
+
+	PreviousExitCode *int
+	PreviousReason   string
 
 	CreatedAt time.Time
 	UpdatedAt time.Time
@@ -43,6 +52,21 @@ func (j Instance) ToSpec() (models.InstanceSpec, error) {
 	if j.ExecutedAt != nil {
 		execAt = *j.ExecutedAt
 	}
+	var exitCode int
+	if j.ExitCode != nil {
+		exitCode = *j.ExitCode
+	}
+	var startedAt, finishedAt time.Time
+	if j.StartedAt != nil {
+		startedAt = *j.StartedAt
+	}
+	if j.FinishedAt != nil {
+		finishedAt = *j.FinishedAt
+	}
+	var previousExitCode int
+	if j.PreviousExitCode != nil {
+		previousExitCode = *j.PreviousExitCode
+	}
 
 	return models.InstanceSpec{
 		ID:         j.ID,
@@ -51,7 +75,15 @@ func (j Instance) ToSpec() (models.InstanceSpec, error) {
 		ExecutedAt: execAt,
 		Status:     models.JobRunState(j.Status),
 		Data:       data,
+		Attempt:    j.Attempt,
+		ExitCode:   exitCode,
+		StartedAt:  startedAt,
+		FinishedAt: finishedAt,
+		Reason:     j.Reason,
 		UpdatedAt:  j.UpdatedAt,
+
+		PreviousExitCode: previousExitCode,
+		PreviousReason:   j.PreviousReason,
 	}, nil
 }
 
@@ -65,6 +97,10 @@ func (j Instance) FromSpec(spec models.InstanceSpec, jobRunID uuid.UUID) (Instan
 	if !spec.ExecutedAt.IsZero() {
 		execAt = &spec.ExecutedAt
 	}
+	var previousExitCode *int
+	if spec.PreviousReason != "" || spec.PreviousExitCode != 0 {
+		previousExitCode = &spec.PreviousExitCode
+	}
 	return Instance{
 		ID:         spec.ID,
 		JobRunID:   jobRunID,
@@ -73,6 +109,10 @@ func (j Instance) FromSpec(spec models.InstanceSpec, jobRunID uuid.UUID) (Instan
 		ExecutedAt: execAt,
 		Status:     spec.Status.String(),
 		Data:       dataJSON,
+		Attempt:    spec.Attempt,
+
+		PreviousExitCode: previousExitCode,
+		PreviousReason:   spec.PreviousReason,
 	}, nil
 }
 
@@ -116,6 +156,25 @@ func (repo *InstanceRepository) UpdateStatus(ctx context.Context, id uuid.UUID,
 	return repo.db.WithContext(ctx).Omit("JobRun").Save(&r).Error
 }
 
+// UpdateExecution updates the state of instance along with the details of
+// its execution
+func (repo *InstanceRepository) UpdateExecution(ctx context.Context, id uuid.UUID, spec models.InstanceSpec) error {
+	var r Instance
+	if err := repo.db.WithContext(ctx).Where("id = ?", id).Find(&r).Error; err != nil {
+		return err
+	}
+	r.Status = spec.Status.String()
+	r.Reason = spec.Reason
+	if !spec.StartedAt.IsZero() {
+		r.StartedAt = &spec.StartedAt
+	}
+	if !spec.FinishedAt.IsZero() {
+		r.FinishedAt = &spec.FinishedAt
+		r.ExitCode = &spec.ExitCode
+	}
+	return repo.db.WithContext(ctx).Omit("JobRun").Save(&r).Error
+}
+
 func (repo *InstanceRepository) GetByName(ctx context.Context, runID uuid.UUID, instanceName, instanceType string) (models.InstanceSpec, error) {
 	var r Instance
 	if err := repo.db.WithContext(ctx).Preload("JobRun").Where("job_run_id = ? AND instance_name = ? AND instance_type = ?", runID, instanceName, instanceType).First(&r).Error; err != nil {
@@ -226,4 +226,33 @@ func TestInstanceRepository(t *testing.T) {
 		assert.Nil(t, err)
 		assert.Equal(t, models.RunStateFailed, checkModel.Status)
 	})
+	t.Run("UpdateExecution", func(t *testing.T) {
+		db := DBSetup()
+		sqlDB, _ := db.DB()
+		defer sqlDB.Close()
+
+		testModels := []models.InstanceSpec{}
+		testModels = append(testModels, testSpecs...)
+
+		repo := NewInstanceRepository(db, adapter)
+		err := repo.Save(ctx, jobRuns[0], testModels[0])
+		assert.Nil(t, err)
+
+		finishedInstance := testModels[0]
+		finishedInstance.Status = models.RunStateFailed
+		finishedInstance.ExitCode = 2
+		finishedInstance.StartedAt = time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+		finishedInstance.FinishedAt = time.Date(2021, 1, 1, 1, 0, 0, 0, time.UTC)
+		finishedInstance.Reason = "exited with code 2"
+		err = repo.UpdateExecution(ctx, testModels[0].ID, finishedInstance)
+		assert.Nil(t, err)
+
+		checkModel, err := repo.GetByID(ctx, testModels[0].ID)
+		assert.Nil(t, err)
+		assert.Equal(t, models.RunStateFailed, checkModel.Status)
+		assert.Equal(t, 2, checkModel.ExitCode)
+		assert.True(t, finishedInstance.StartedAt.Equal(checkModel.StartedAt))
+		assert.True(t, finishedInstance.FinishedAt.Equal(checkModel.FinishedAt))
+		assert.Equal(t, "exited with code 2", checkModel.Reason)
+	})
 }
@@ -0,0 +1,127 @@
+package postgres
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/odpf/optimus/models"
+	"github.com/pkg/errors"
+	"gorm.io/datatypes"
+	"gorm.io/gorm"
+)
+
+type JobDeployment struct {
+	ID uuid.UUID `gorm:"primary_key;type:uuid;default:uuid_generate_v4()"`
+
+	NamespaceID uuid.UUID
+	Namespace   Namespace `gorm:"foreignKey:NamespaceID"`
+
+	JobName string
+	Spec    datatypes.JSON `gorm:"column:specification;"`
+
+	LastScheduledAt *time.Time
+
+	CreatedAt time.Time `gorm:"not null" json:"created_at"`
+	UpdatedAt time.Time `gorm:"not null" json:"updated_at"`
+}
+
+type JobDeploymentRepository struct {
+	db      *gorm.DB
+	adapter *JobSpecAdapter
+}
+
+func (repo *JobDeploymentRepository) Save(ctx context.Context, namespace models.NamespaceSpec, spec models.JobSpec) error {
+	adaptedJobSpec, err := repo.adapter.FromJobSpec(spec)
+	if err != nil {
+		return err
+	}
+	specBytes, err := json.Marshal(adaptedJobSpec)
+	if err != nil {
+		return err
+	}
+
+	var existing JobDeployment
+	err = repo.db.WithContext(ctx).Where("namespace_id = ? AND job_name = ?", namespace.ID, spec.Name).First(&existing).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return repo.db.WithContext(ctx).Omit("Namespace").Create(&JobDeployment{
+			NamespaceID: namespace.ID,
+			JobName:     spec.Name,
+			Spec:        specBytes,
+		}).Error
+	} else if err != nil {
+		return errors.Wrap(err, "unable to find job deployment")
+	}
+	return repo.db.WithContext(ctx).Model(&existing).Omit("Namespace").Updates(JobDeployment{Spec: specBytes}).Error
+}
+
+func (repo *JobDeploymentRepository) Delete(ctx context.Context, namespace models.NamespaceSpec, jobName string) error {
+	return repo.db.WithContext(ctx).Where("namespace_id = ? AND job_name = ?", namespace.ID, jobName).
+		Delete(&JobDeployment{}).Error
+}
+
+func (repo *JobDeploymentRepository) GetByNamespace(ctx context.Context, namespace models.NamespaceSpec) ([]models.JobDeployment, error) {
+	var deployments []JobDeployment
+	if err := repo.db.WithContext(ctx).Preload("Namespace").Preload("Namespace.Project").
+		Where("namespace_id = ?", namespace.ID).Find(&deployments).Error; err != nil {
+		return nil, err
+	}
+	return repo.toDeployments(deployments)
+}
+
+func (repo *JobDeploymentRepository) GetAll(ctx context.Context) ([]models.JobDeployment, error) {
+	var deployments []JobDeployment
+	if err := repo.db.WithContext(ctx).Preload("Namespace").Preload("Namespace.Project").
+		Find(&deployments).Error; err != nil {
+		return nil, err
+	}
+	return repo.toDeployments(deployments)
+}
+
+func (repo *JobDeploymentRepository) UpdateLastScheduledAt(ctx context.Context, id uuid.UUID, lastScheduledAt time.Time) error {
+	return repo.db.WithContext(ctx).Model(&JobDeployment{ID: id}).
+		Updates(JobDeployment{LastScheduledAt: &lastScheduledAt}).Error
+}
+
+func (repo *JobDeploymentRepository) toDeployments(deployments []JobDeployment) ([]models.JobDeployment, error) {
+	var specs []models.JobDeployment
+	for _, deployment := range deployments {
+		adaptedSpec := Job{}
+		if err := json.Unmarshal(deployment.Spec, &adaptedSpec); err != nil {
+			return nil, err
+		}
+		jobSpec, err := repo.adapter.ToSpec(adaptedSpec)
+		if err != nil {
+			return nil, err
+		}
+
+		adaptProject, err := deployment.Namespace.Project.ToSpec()
+		if err != nil {
+			return nil, err
+		}
+		adaptNamespace, err := deployment.Namespace.ToSpec(adaptProject)
+		if err != nil {
+			return nil, err
+		}
+
+		var lastScheduledAt time.Time
+		if deployment.LastScheduledAt != nil {
+			lastScheduledAt = *deployment.LastScheduledAt
+		}
+		specs = append(specs, models.JobDeployment{
+			ID:              deployment.ID,
+			Namespace:       adaptNamespace,
+			Spec:            jobSpec,
+			LastScheduledAt: lastScheduledAt,
+		})
+	}
+	return specs, nil
+}
+
+func NewJobDeploymentRepository(db *gorm.DB, adapter *JobSpecAdapter) *JobDeploymentRepository {
+	return &JobDeploymentRepository{
+		db:      db,
+		adapter: adapter,
+	}
+}
@@ -0,0 +1,136 @@
+// +build !unit_test
+
+package postgres
+
+import (
+	"context"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/odpf/optimus/mock"
+	"github.com/odpf/optimus/models"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+)
+
+func TestJobDeploymentRepository(t *testing.T) {
+	ctx := context.Background()
+	projectSpec := models.ProjectSpec{
+		ID:   uuid.Must(uuid.NewRandom()),
+		Name: "t-optimus-id",
+		Config: map[string]string{
+			"bucket": "gs://some_folder",
+		},
+	}
+	namespaceSpec := models.NamespaceSpec{
+		ID:          uuid.Must(uuid.NewRandom()),
+		Name:        "dev-team-1",
+		ProjectSpec: projectSpec,
+	}
+
+	gTask := "g-task"
+	execUnit := new(mock.BasePlugin)
+	execUnit.On("PluginInfo").Return(&models.PluginInfoResponse{
+		Name: gTask,
+	}, nil)
+	pluginRepo := new(mock.SupportedPluginRepo)
+	pluginRepo.On("GetByName", gTask).Return(&models.Plugin{Base: execUnit}, nil)
+	adapter := NewAdapter(pluginRepo)
+
+	jobSpec := models.JobSpec{
+		ID:   uuid.Must(uuid.NewRandom()),
+		Name: "g-optimus-id",
+		Task: models.JobSpecTask{
+			Unit: &models.Plugin{Base: execUnit},
+			Config: []models.JobSpecConfigItem{
+				{
+					Name: "do", Value: "this",
+				},
+			},
+		},
+		Schedule: models.JobSpecSchedule{
+			StartDate: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+			Interval:  "@daily",
+		},
+	}
+
+	DBSetup := func() *gorm.DB {
+		dbURL, ok := os.LookupEnv("TEST_OPTIMUS_DB_URL")
+		if !ok {
+			panic("unable to find TEST_OPTIMUS_DB_URL env var")
+		}
+		dbConn, err := Connect(dbURL, 1, 1, os.Stdout)
+		if err != nil {
+			panic(err)
+		}
+		m, err := NewHTTPFSMigrator(dbURL)
+		if err != nil {
+			panic(err)
+		}
+		if err := m.Drop(); err != nil {
+			panic(err)
+		}
+		if err := Migrate(dbURL); err != nil {
+			panic(err)
+		}
+
+		hash, _ := models.NewApplicationSecret("32charshtesthashtesthashtesthash")
+		prepo := NewProjectRepository(dbConn, hash)
+		assert.Nil(t, prepo.Save(ctx, projectSpec))
+		assert.Nil(t, NewNamespaceRepository(dbConn, projectSpec, hash).Save(ctx, namespaceSpec))
+		return dbConn
+	}
+
+	t.Run("Save", func(t *testing.T) {
+		t.Run("should insert deployment if not exists and update otherwise", func(t *testing.T) {
+			db := DBSetup()
+			repo := NewJobDeploymentRepository(db, adapter)
+
+			assert.Nil(t, repo.Save(ctx, namespaceSpec, jobSpec))
+			deployments, err := repo.GetByNamespace(ctx, namespaceSpec)
+			assert.Nil(t, err)
+			assert.Equal(t, 1, len(deployments))
+			assert.Equal(t, jobSpec.Name, deployments[0].Spec.Name)
+			assert.Equal(t, namespaceSpec.Name, deployments[0].Namespace.Name)
+			assert.Equal(t, "@daily", deployments[0].Spec.Schedule.Interval)
+
+			updatedSpec := jobSpec
+			updatedSpec.Schedule.Interval = "@hourly"
+			assert.Nil(t, repo.Save(ctx, namespaceSpec, updatedSpec))
+			deployments, err = repo.GetAll(ctx)
+			assert.Nil(t, err)
+			assert.Equal(t, 1, len(deployments))
+			assert.Equal(t, "@hourly", deployments[0].Spec.Schedule.Interval)
+		})
+	})
+	t.Run("UpdateLastScheduledAt", func(t *testing.T) {
+		t.Run("should record last scheduled time of deployment", func(t *testing.T) {
+			db := DBSetup()
+			repo := NewJobDeploymentRepository(db, adapter)
+			assert.Nil(t, repo.Save(ctx, namespaceSpec, jobSpec))
+			deployments, err := repo.GetAll(ctx)
+			assert.Nil(t, err)
+			assert.True(t, deployments[0].LastScheduledAt.IsZero())
+
+			lastScheduledAt := time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC)
+			assert.Nil(t, repo.UpdateLastScheduledAt(ctx, deployments[0].ID, lastScheduledAt))
+			deployments, err = repo.GetAll(ctx)
+			assert.Nil(t, err)
+			assert.True(t, lastScheduledAt.Equal(deployments[0].LastScheduledAt))
+		})
+	})
+	t.Run("Delete", func(t *testing.T) {
+		t.Run("should remove deployment of job", func(t *testing.T) {
+			db := DBSetup()
+			repo := NewJobDeploymentRepository(db, adapter)
+			assert.Nil(t, repo.Save(ctx, namespaceSpec, jobSpec))
+			assert.Nil(t, repo.Delete(ctx, namespaceSpec, jobSpec.Name))
+
+			deployments, err := repo.GetByNamespace(ctx, namespaceSpec)
+			assert.Nil(t, err)
+			assert.Equal(t, 0, len(deployments))
+		})
+	})
+}
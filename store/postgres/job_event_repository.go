@@ -0,0 +1,129 @@
+package postgres
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/odpf/optimus/models"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/structpb"
+	"gorm.io/datatypes"
+	"gorm.io/gorm"
+)
+
+type JobEvent struct {
+	ID uuid.UUID `gorm:"primary_key;type:uuid;default:uuid_generate_v4()"`
+
+	NamespaceID uuid.UUID `gorm:"not null"`
+	JobName     string    `gorm:"not null"`
+
+	Type        string `gorm:"not null"`
+	ScheduledAt *time.Time
+	Payload     datatypes.JSON
+
+	CreatedAt time.Time `gorm:"not null" json:"created_at"`
+}
+
+func (e JobEvent) FromSpec(record models.JobEventRecord) (JobEvent, error) {
+	payload, err := protojson.Marshal(&structpb.Struct{Fields: record.Event.Value})
+	if err != nil {
+		return JobEvent{}, err
+	}
+	var scheduledAt *time.Time
+	if !record.ScheduledAt.IsZero() {
+		scheduledAt = &record.ScheduledAt
+	}
+	return JobEvent{
+		ID:          record.ID,
+		NamespaceID: record.Namespace.ID,
+		JobName:     record.JobName,
+		Type:        string(record.Event.Type),
+		ScheduledAt: scheduledAt,
+		Payload:     payload,
+		CreatedAt:   record.CreatedAt,
+	}, nil
+}
+
+func (e JobEvent) ToSpec(namespace models.NamespaceSpec) (models.JobEventRecord, error) {
+	payload := &structpb.Struct{}
+	if len(e.Payload) > 0 {
+		if err := protojson.Unmarshal(e.Payload, payload); err != nil {
+			return models.JobEventRecord{}, err
+		}
+	}
+	var scheduledAt time.Time
+	if e.ScheduledAt != nil {
+		scheduledAt = *e.ScheduledAt
+	}
+	return models.JobEventRecord{
+		ID:        e.ID,
+		Namespace: namespace,
+		JobName:   e.JobName,
+		Event: models.JobEvent{
+			Type:  models.JobEventType(e.Type),
+			Value: payload.GetFields(),
+		},
+		ScheduledAt: scheduledAt,
+		CreatedAt:   e.CreatedAt,
+	}, nil
+}
+
+type jobEventRepository struct {
+	db *gorm.DB
+}
+
+func (repo *jobEventRepository) Insert(ctx context.Context, record models.JobEventRecord) error {
+	resource, err := JobEvent{}.FromSpec(record)
+	if err != nil {
+		return err
+	}
+	return repo.db.WithContext(ctx).Create(&resource).Error
+}
+
+// List returns events of job in namespace matching filter, latest first
+func (repo *jobEventRepository) List(ctx context.Context, namespace models.NamespaceSpec, jobName string,
+	filter models.JobEventFilter) ([]models.JobEventRecord, error) {
+	query := repo.db.WithContext(ctx).Where("namespace_id = ? AND job_name = ?", namespace.ID, jobName)
+	if len(filter.Types) > 0 {
+		var types []string
+		for _, eventType := range filter.Types {
+			types = append(types, string(eventType))
+		}
+		query = query.Where("type IN ?", types)
+	}
+	if !filter.Since.IsZero() {
+		query = query.Where("created_at >= ?", filter.Since)
+	}
+	if !filter.Until.IsZero() {
+		query = query.Where("created_at < ?", filter.Until)
+	}
+	if filter.Limit > 0 {
+		query = query.Limit(filter.Limit)
+	}
+
+	var resources []JobEvent
+	if err := query.Order("created_at DESC").Find(&resources).Error; err != nil {
+		return nil, err
+	}
+	var records []models.JobEventRecord
+	for _, res := range resources {
+		record, err := res.ToSpec(namespace)
+		if err != nil {
+			return nil, err
+		}
+		records = append(records, record)
+	}
+	return records, nil
+}
+
+func (repo *jobEventRepository) DeleteOlderThan(ctx context.Context, before time.Time) (int64, error) {
+	result := repo.db.WithContext(ctx).Where("created_at < ?", before).Delete(&JobEvent{})
+	return result.RowsAffected, result.Error
+}
+
+func NewJobEventRepository(db *gorm.DB) *jobEventRepository {
+	return &jobEventRepository{
+		db: db,
+	}
+}
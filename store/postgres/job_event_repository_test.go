@@ -0,0 +1,167 @@
+// +build !unit_test
+
+package postgres
+
+import (
+	"context"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/odpf/optimus/models"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/types/known/structpb"
+	"gorm.io/gorm"
+)
+
+func TestJobEventRepository(t *testing.T) {
+	ctx := context.Background()
+	projectSpec := models.ProjectSpec{
+		ID:   uuid.Must(uuid.NewRandom()),
+		Name: "t-optimus-id",
+	}
+	namespaceSpec := models.NamespaceSpec{
+		ID:          uuid.Must(uuid.NewRandom()),
+		Name:        "dev-team-1",
+		ProjectSpec: projectSpec,
+	}
+
+	DBSetup := func() *gorm.DB {
+		dbURL, ok := os.LookupEnv("TEST_OPTIMUS_DB_URL")
+		if !ok {
+			panic("unable to find TEST_OPTIMUS_DB_URL env var")
+		}
+		dbConn, err := Connect(dbURL, 1, 1, os.Stdout)
+		if err != nil {
+			panic(err)
+		}
+		m, err := NewHTTPFSMigrator(dbURL)
+		if err != nil {
+			panic(err)
+		}
+		if err := m.Drop(); err != nil {
+			panic(err)
+		}
+		if err := Migrate(dbURL); err != nil {
+			panic(err)
+		}
+
+		hash, _ := models.NewApplicationSecret("32charshtesthashtesthashtesthash")
+		prepo := NewProjectRepository(dbConn, hash)
+		assert.Nil(t, prepo.Save(ctx, projectSpec))
+		assert.Nil(t, NewNamespaceRepository(dbConn, projectSpec, hash).Save(ctx, namespaceSpec))
+		return dbConn
+	}
+
+	now := time.Now().UTC().Truncate(time.Second)
+	scheduledAt := time.Date(2021, 10, 1, 0, 0, 0, 0, time.UTC)
+	records := []models.JobEventRecord{
+		{
+			Namespace: namespaceSpec,
+			JobName:   "job-1",
+			Event: models.JobEvent{
+				Type: models.JobEventTypeFailure,
+				Value: map[string]*structpb.Value{
+					"task_id":      structpb.NewStringValue("bq2bq"),
+					"scheduled_at": structpb.NewStringValue("2021-10-01T00:00:00Z"),
+				},
+			},
+			ScheduledAt: scheduledAt,
+			CreatedAt:   now.Add(-2 * time.Hour),
+		},
+		{
+			Namespace: namespaceSpec,
+			JobName:   "job-1",
+			Event: models.JobEvent{
+				Type: models.JobEventTypeRetry,
+				Value: map[string]*structpb.Value{
+					"attempt": structpb.NewNumberValue(2),
+				},
+			},
+			CreatedAt: now.Add(-time.Hour),
+		},
+		{
+			Namespace: namespaceSpec,
+			JobName:   "job-1",
+			Event: models.JobEvent{
+				Type: models.JobEventTypeFailure,
+			},
+			CreatedAt: now,
+		},
+		{
+			Namespace: namespaceSpec,
+			JobName:   "job-2",
+			Event: models.JobEvent{
+				Type: models.JobEventTypeFailure,
+			},
+			CreatedAt: now,
+		},
+	}
+
+	t.Run("List", func(t *testing.T) {
+		db := DBSetup()
+		repo := NewJobEventRepository(db)
+		for _, record := range records {
+			assert.Nil(t, repo.Insert(ctx, record))
+		}
+
+		t.Run("should return all events of job latest first", func(t *testing.T) {
+			result, err := repo.List(ctx, namespaceSpec, "job-1", models.JobEventFilter{})
+			assert.Nil(t, err)
+			assert.Equal(t, 3, len(result))
+			assert.Equal(t, now, result[0].CreatedAt.UTC())
+			assert.True(t, result[0].ScheduledAt.IsZero())
+			assert.Equal(t, models.JobEventTypeRetry, result[1].Event.Type)
+			assert.Equal(t, float64(2), result[1].Event.Value["attempt"].GetNumberValue())
+			assert.Equal(t, "bq2bq", result[2].Event.Value["task_id"].GetStringValue())
+			assert.Equal(t, scheduledAt, result[2].ScheduledAt.UTC())
+			assert.Equal(t, namespaceSpec.Name, result[2].Namespace.Name)
+		})
+		t.Run("should filter events by type and time range", func(t *testing.T) {
+			result, err := repo.List(ctx, namespaceSpec, "job-1", models.JobEventFilter{
+				Types: []models.JobEventType{models.JobEventTypeFailure},
+			})
+			assert.Nil(t, err)
+			assert.Equal(t, 2, len(result))
+
+			result, err = repo.List(ctx, namespaceSpec, "job-1", models.JobEventFilter{
+				Since: now.Add(-90 * time.Minute),
+				Until: now,
+			})
+			assert.Nil(t, err)
+			assert.Equal(t, 1, len(result))
+			assert.Equal(t, models.JobEventTypeRetry, result[0].Event.Type)
+
+			result, err = repo.List(ctx, namespaceSpec, "job-1", models.JobEventFilter{Limit: 1})
+			assert.Nil(t, err)
+			assert.Equal(t, 1, len(result))
+		})
+		t.Run("should return no events of unknown job", func(t *testing.T) {
+			result, err := repo.List(ctx, namespaceSpec, "job-3", models.JobEventFilter{})
+			assert.Nil(t, err)
+			assert.Equal(t, 0, len(result))
+		})
+	})
+	t.Run("DeleteOlderThan", func(t *testing.T) {
+		t.Run("should remove events of all jobs registered before given time", func(t *testing.T) {
+			db := DBSetup()
+			repo := NewJobEventRepository(db)
+			for _, record := range records {
+				assert.Nil(t, repo.Insert(ctx, record))
+			}
+
+			deleted, err := repo.DeleteOlderThan(ctx, now.Add(-30*time.Minute))
+			assert.Nil(t, err)
+			assert.Equal(t, int64(2), deleted)
+
+			result, err := repo.List(ctx, namespaceSpec, "job-1", models.JobEventFilter{})
+			assert.Nil(t, err)
+			assert.Equal(t, 1, len(result))
+			assert.Equal(t, now, result[0].CreatedAt.UTC())
+			result, err = repo.List(ctx, namespaceSpec, "job-2", models.JobEventFilter{})
+			assert.Nil(t, err)
+			assert.Equal(t, 1, len(result))
+		})
+	})
+}
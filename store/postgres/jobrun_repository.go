@@ -2,6 +2,7 @@ package postgres
 
 import (
 	"context"
+	"encoding/json"
 	"time"
 
 	"github.com/google/uuid"
@@ -48,7 +49,7 @@ func (repo *JobRunRepository) Save(ctx context.Context, namespace models.Namespa
 
 func (repo *JobRunRepository) GetByID(ctx context.Context, id uuid.UUID) (models.JobRun, models.NamespaceSpec, error) {
 	var r JobRun
-	if err := repo.db.WithContext(ctx).Preload("Namespace").Where("id = ?", id).First(&r).Error; err != nil {
+	if err := repo.db.WithContext(ctx).Preload("Namespace").Preload("Namespace.Project").Where("id = ?", id).First(&r).Error; err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return models.JobRun{}, models.NamespaceSpec{}, store.ErrResourceNotFound
 		}
@@ -130,6 +131,27 @@ func (repo *JobRunRepository) UpdateStatus(ctx context.Context, id uuid.UUID, st
 	return repo.db.Omit("Namespace").Save(jr).Error
 }
 
+func (repo *JobRunRepository) UpdateWaitReason(ctx context.Context, id uuid.UUID, reason string) error {
+	var jr JobRun
+	if err := repo.db.WithContext(ctx).Where("id = ?", id).Find(&jr).Error; err != nil {
+		return err
+	}
+	data := JobRunData{
+		ExecutedAt: jr.ScheduledAt,
+	}
+	if len(jr.Data) != 0 {
+		if err := json.Unmarshal(jr.Data, &data); err != nil {
+			return err
+		}
+	}
+	data.WaitReason = reason
+	dataBytes, err := json.Marshal(data)
+	if err != nil {
+		return err
+	}
+	return repo.db.WithContext(ctx).Model(&JobRun{ID: id}).Updates(JobRun{Data: dataBytes}).Error
+}
+
 func (repo *JobRunRepository) GetByStatus(ctx context.Context, statuses ...models.JobRunState) ([]models.JobRun, error) {
 	var specs []models.JobRun
 	var runs []JobRun
@@ -176,6 +198,61 @@ func (repo *JobRunRepository) GetByTrigger(ctx context.Context, trigger models.J
 	return specs, nil
 }
 
+func (repo *JobRunRepository) GetByJobName(ctx context.Context, projectSpec models.ProjectSpec, jobName string) ([]models.JobRun, error) {
+	var runs []JobRun
+	if err := repo.jobNameQuery(ctx, projectSpec, jobName).Order("scheduled_at").Find(&runs).Error; err != nil {
+		return nil, err
+	}
+	return repo.toJobRuns(ctx, runs)
+}
+
+func (repo *JobRunRepository) GetByJobNameAndScheduledAt(ctx context.Context, projectSpec models.ProjectSpec, jobName string,
+	startDate, endDate time.Time, offset, limit int) ([]models.JobRun, error) {
+	var runs []JobRun
+	query := repo.jobNameQuery(ctx, projectSpec, jobName).
+		Where("scheduled_at >= ? AND scheduled_at <= ?", startDate, endDate).
+		Order("scheduled_at").Offset(offset)
+	if limit > 0 {
+		query = query.Limit(limit)
+	}
+	if err := query.Find(&runs).Error; err != nil {
+		return nil, err
+	}
+	return repo.toJobRuns(ctx, runs)
+}
+
+func (repo *JobRunRepository) GetJobNames(ctx context.Context, namespaceSpec models.NamespaceSpec) ([]string, error) {
+	var jobNames []string
+	if err := repo.db.WithContext(ctx).Raw("SELECT DISTINCT specification->>'name' FROM job_run WHERE namespace_id = ?",
+		namespaceSpec.ID).Scan(&jobNames).Error; err != nil {
+		return nil, err
+	}
+	return jobNames, nil
+}
+
+// jobNameQuery filters runs of a job using the name stored in its specification,
+// runs are not always linked to a saved job
+func (repo *JobRunRepository) jobNameQuery(ctx context.Context, projectSpec models.ProjectSpec, jobName string) *gorm.DB {
+	return repo.db.WithContext(ctx).
+		Joins("JOIN namespace ON job_run.namespace_id = namespace.id").
+		Where("namespace.project_id = ? AND job_run.specification->>'name' = ?", projectSpec.ID, jobName)
+}
+
+func (repo *JobRunRepository) toJobRuns(ctx context.Context, runs []JobRun) ([]models.JobRun, error) {
+	var specs []models.JobRun
+	for _, run := range runs {
+		if instances, err := repo.instanceRepo.GetByJobRun(ctx, run.ID); err == nil {
+			run.Instances = instances
+		}
+		adapt, _, err := repo.adapter.ToJobRun(run)
+		if err != nil {
+			return specs, err
+		}
+		specs = append(specs, adapt)
+	}
+	return specs, nil
+}
+
 func NewJobRunRepository(db *gorm.DB, adapter *JobSpecAdapter) *JobRunRepository {
 	return &JobRunRepository{
 		db:           db,
@@ -325,4 +325,94 @@ func TestJobRunRepository(t *testing.T) {
 		assert.Equal(t, 0, len(jr.Instances))
 		assert.Equal(t, models.RunStatePending, jr.Status)
 	})
+	t.Run("UpdateWaitReason", func(t *testing.T) {
+		db := DBSetup()
+		sqlDB, _ := db.DB()
+		defer sqlDB.Close()
+
+		repo := NewJobRunRepository(db, adapter)
+		assert.Nil(t, repo.Insert(ctx, namespaceSpec, testSpecs[0]))
+
+		assert.Nil(t, repo.UpdateWaitReason(ctx, testSpecs[0].ID, "waiting for upstream"))
+		jr, _, err := repo.GetByID(ctx, testSpecs[0].ID)
+		assert.Nil(t, err)
+		assert.Equal(t, "waiting for upstream", jr.WaitReason)
+		assert.Equal(t, testSpecs[0].ExecutedAt.Unix(), jr.ExecutedAt.Unix())
+
+		assert.Nil(t, repo.UpdateWaitReason(ctx, testSpecs[0].ID, ""))
+		jr, _, err = repo.GetByID(ctx, testSpecs[0].ID)
+		assert.Nil(t, err)
+		assert.Equal(t, "", jr.WaitReason)
+	})
+	t.Run("GetByJobName", func(t *testing.T) {
+		db := DBSetup()
+		sqlDB, _ := db.DB()
+		defer sqlDB.Close()
+
+		hash, _ := models.NewApplicationSecret("32charshtesthashtesthashtesthash")
+		assert.Nil(t, NewNamespaceRepository(db, projectSpec, hash).Save(ctx, namespaceSpec))
+
+		repo := NewJobRunRepository(db, adapter)
+		assert.Nil(t, repo.Insert(ctx, namespaceSpec, testSpecs[1]))
+		assert.Nil(t, repo.Insert(ctx, namespaceSpec, models.JobRun{
+			ID:          uuid.Must(uuid.NewRandom()),
+			Spec:        jobConfigs[2],
+			Trigger:     models.TriggerManual,
+			Status:      models.RunStateRunning,
+			ScheduledAt: time.Date(2020, 11, 11, 0, 0, 0, 0, time.UTC),
+		}))
+
+		runs, err := repo.GetByJobName(ctx, projectSpec, jobConfigs[0].Name)
+		assert.Nil(t, err)
+		assert.Equal(t, 1, len(runs))
+		assert.Equal(t, testSpecs[1].ID, runs[0].ID)
+	})
+	t.Run("GetByJobNameAndScheduledAt", func(t *testing.T) {
+		db := DBSetup()
+		sqlDB, _ := db.DB()
+		defer sqlDB.Close()
+
+		hash, _ := models.NewApplicationSecret("32charshtesthashtesthashtesthash")
+		assert.Nil(t, NewNamespaceRepository(db, projectSpec, hash).Save(ctx, namespaceSpec))
+
+		repo := NewJobRunRepository(db, adapter)
+		var runIDs []uuid.UUID
+		for day := 1; day <= 4; day++ {
+			run := models.JobRun{
+				ID:          uuid.Must(uuid.NewRandom()),
+				Spec:        jobConfigs[0],
+				Trigger:     models.TriggerManual,
+				Status:      models.RunStateSuccess,
+				ScheduledAt: time.Date(2020, 11, day, 0, 0, 0, 0, time.UTC),
+			}
+			assert.Nil(t, repo.Insert(ctx, namespaceSpec, run))
+			runIDs = append(runIDs, run.ID)
+		}
+
+		startDate := time.Date(2020, 11, 2, 0, 0, 0, 0, time.UTC)
+		endDate := time.Date(2020, 11, 4, 0, 0, 0, 0, time.UTC)
+		runs, err := repo.GetByJobNameAndScheduledAt(ctx, projectSpec, jobConfigs[0].Name, startDate, endDate, 0, 2)
+		assert.Nil(t, err)
+		assert.Equal(t, 2, len(runs))
+		assert.Equal(t, runIDs[1], runs[0].ID)
+		assert.Equal(t, runIDs[2], runs[1].ID)
+
+		runs, err = repo.GetByJobNameAndScheduledAt(ctx, projectSpec, jobConfigs[0].Name, startDate, endDate, 2, 2)
+		assert.Nil(t, err)
+		assert.Equal(t, 1, len(runs))
+		assert.Equal(t, runIDs[3], runs[0].ID)
+	})
+	t.Run("GetJobNames", func(t *testing.T) {
+		db := DBSetup()
+		sqlDB, _ := db.DB()
+		defer sqlDB.Close()
+
+		repo := NewJobRunRepository(db, adapter)
+		assert.Nil(t, repo.Insert(ctx, namespaceSpec, testSpecs[0]))
+		assert.Nil(t, repo.Insert(ctx, namespaceSpec, testSpecs[1]))
+
+		jobNames, err := repo.GetJobNames(ctx, namespaceSpec)
+		assert.Nil(t, err)
+		assert.Equal(t, []string{jobConfigs[0].Name}, jobNames)
+	})
 }
@@ -0,0 +1,12 @@
+CREATE TABLE IF NOT EXISTS job_deployment (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    namespace_id UUID NOT NULL REFERENCES namespace (id),
+    job_name VARCHAR(220) NOT NULL,
+    specification JSONB NOT NULL,
+
+    last_scheduled_at TIMESTAMP WITH TIME ZONE,
+
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    UNIQUE (namespace_id, job_name)
+);
@@ -0,0 +1 @@
+ALTER TABLE instance DROP IF EXISTS attempt;
\ No newline at end of file
@@ -0,0 +1 @@
+ALTER TABLE instance ADD IF NOT EXISTS attempt INT NOT NULL DEFAULT 1;
\ No newline at end of file
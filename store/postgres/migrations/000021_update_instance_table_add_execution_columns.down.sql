@@ -0,0 +1,4 @@
+ALTER TABLE instance DROP IF EXISTS exit_code;
+ALTER TABLE instance DROP IF EXISTS started_at;
+ALTER TABLE instance DROP IF EXISTS finished_at;
+ALTER TABLE instance DROP IF EXISTS reason;
\ No newline at end of file
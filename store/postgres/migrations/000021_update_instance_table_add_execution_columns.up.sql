@@ -0,0 +1,4 @@
+ALTER TABLE instance ADD IF NOT EXISTS exit_code INT;
+ALTER TABLE instance ADD IF NOT EXISTS started_at TIMESTAMP WITH TIME ZONE;
+ALTER TABLE instance ADD IF NOT EXISTS finished_at TIMESTAMP WITH TIME ZONE;
+ALTER TABLE instance ADD IF NOT EXISTS reason TEXT;
\ No newline at end of file
@@ -0,0 +1 @@
+ALTER TABLE secret DROP IF EXISTS key_id;
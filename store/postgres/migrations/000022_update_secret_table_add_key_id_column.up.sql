@@ -0,0 +1 @@
+ALTER TABLE secret ADD IF NOT EXISTS key_id VARCHAR(100) NOT NULL DEFAULT 'default';
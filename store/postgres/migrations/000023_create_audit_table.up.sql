@@ -0,0 +1,15 @@
+CREATE TABLE IF NOT EXISTS audit (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    actor VARCHAR(220) NOT NULL,
+    method VARCHAR(220) NOT NULL,
+    project_name VARCHAR(100),
+    namespace_name VARCHAR(100),
+    resource TEXT,
+    status VARCHAR(50) NOT NULL,
+    message TEXT,
+
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL
+);
+CREATE INDEX IF NOT EXISTS audit_created_at_idx ON audit (created_at);
+CREATE INDEX IF NOT EXISTS audit_project_name_idx ON audit (project_name);
+CREATE INDEX IF NOT EXISTS audit_actor_idx ON audit (actor);
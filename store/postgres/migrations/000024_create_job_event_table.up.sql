@@ -0,0 +1,12 @@
+CREATE TABLE IF NOT EXISTS job_event (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    namespace_id UUID NOT NULL REFERENCES namespace (id),
+    job_name VARCHAR(220) NOT NULL,
+    type VARCHAR(50) NOT NULL,
+    scheduled_at TIMESTAMP WITH TIME ZONE,
+    payload JSONB,
+
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL
+);
+CREATE INDEX IF NOT EXISTS job_event_namespace_id_job_name_idx ON job_event (namespace_id, job_name);
+CREATE INDEX IF NOT EXISTS job_event_created_at_idx ON job_event (created_at);
@@ -0,0 +1,2 @@
+ALTER TABLE instance DROP IF EXISTS previous_exit_code;
+ALTER TABLE instance DROP IF EXISTS previous_reason;
\ No newline at end of file
@@ -0,0 +1,2 @@
+ALTER TABLE instance ADD IF NOT EXISTS previous_exit_code INT;
+ALTER TABLE instance ADD IF NOT EXISTS previous_reason TEXT;
\ No newline at end of file
@@ -152,6 +152,34 @@ func (repo *namespaceRepository) GetAll(ctx context.Context) ([]models.Namespace
 	return specs, nil
 }
 
+func (repo *namespaceRepository) GetDeletionPlan(ctx context.Context, name string) (models.DeletionPlan, error) {
+	scope, err := repo.deletionScope(ctx, name)
+	if err != nil {
+		return models.DeletionPlan{}, err
+	}
+	return scope.plan(ctx, repo.db)
+}
+
+// Delete removes the namespace permanently along with everything in it
+func (repo *namespaceRepository) Delete(ctx context.Context, name string) error {
+	scope, err := repo.deletionScope(ctx, name)
+	if err != nil {
+		return err
+	}
+	return scope.delete(ctx, repo.db)
+}
+
+func (repo *namespaceRepository) deletionScope(ctx context.Context, name string) (deletionScope, error) {
+	var r Namespace
+	if err := repo.db.WithContext(ctx).Where("name = ? AND project_id = ?", name, repo.project.ID).First(&r).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return deletionScope{}, store.ErrResourceNotFound
+		}
+		return deletionScope{}, err
+	}
+	return deletionScope{namespaces: []Namespace{r}}, nil
+}
+
 func NewNamespaceRepository(db *gorm.DB, project models.ProjectSpec, hash models.ApplicationKey) *namespaceRepository {
 	return &namespaceRepository{
 		db:      db,
@@ -255,4 +255,48 @@ func TestNamespaceRepository(t *testing.T) {
 		assert.Nil(t, err)
 		assert.Equal(t, 2, len(checkModel))
 	})
+
+	t.Run("Delete", func(t *testing.T) {
+		db := DBSetup()
+		sqlDB, _ := db.DB()
+		defer sqlDB.Close()
+
+		projRepo := NewProjectRepository(db, hash)
+		err := projRepo.Save(ctx, projectSpec)
+		assert.Nil(t, err)
+
+		repo := NewNamespaceRepository(db, projectSpec, hash)
+		err = repo.Insert(ctx, namespaceSpecs[0])
+		assert.Nil(t, err)
+		err = repo.Insert(ctx, namespaceSpecs[2])
+		assert.Nil(t, err)
+
+		secretRepo := NewSecretRepository(db, projectSpec, namespaceSpecs[0], hash)
+		err = secretRepo.Insert(ctx, secrets[0])
+		assert.Nil(t, err)
+		secretRepo = NewSecretRepository(db, projectSpec, namespaceSpecs[2], hash)
+		err = secretRepo.Insert(ctx, secrets[1])
+		assert.Nil(t, err)
+
+		plan, err := repo.GetDeletionPlan(ctx, namespaceSpecs[0].Name)
+		assert.Nil(t, err)
+		assert.Equal(t, 1, len(plan.Namespaces))
+		assert.Equal(t, "g-optimus", plan.Namespaces[0].Name)
+		assert.Equal(t, []string{"g-optimus"}, plan.Secrets)
+
+		err = repo.Delete(ctx, namespaceSpecs[0].Name)
+		assert.Nil(t, err)
+
+		_, err = repo.GetByName(ctx, namespaceSpecs[0].Name)
+		assert.Equal(t, store.ErrResourceNotFound, err)
+		_, err = secretRepo.GetByName(ctx, secrets[0].Name)
+		assert.Equal(t, store.ErrResourceNotFound, err)
+
+		checkModel, err := repo.GetByName(ctx, namespaceSpecs[2].Name)
+		assert.Nil(t, err)
+		assert.Equal(t, 1, len(checkModel.ProjectSpec.Secret))
+
+		err = repo.Delete(ctx, namespaceSpecs[0].Name)
+		assert.Equal(t, store.ErrResourceNotFound, err)
+	})
 }
@@ -143,6 +143,40 @@ func (repo *ProjectRepository) GetAll(ctx context.Context) ([]models.ProjectSpec
 	return specs, nil
 }
 
+func (repo *ProjectRepository) GetDeletionPlan(ctx context.Context, name string) (models.DeletionPlan, error) {
+	scope, err := repo.deletionScope(ctx, name)
+	if err != nil {
+		return models.DeletionPlan{}, err
+	}
+	return scope.plan(ctx, repo.db)
+}
+
+// Delete removes the project permanently along with all of its namespaces
+func (repo *ProjectRepository) Delete(ctx context.Context, name string) error {
+	scope, err := repo.deletionScope(ctx, name)
+	if err != nil {
+		return err
+	}
+	return scope.delete(ctx, repo.db)
+}
+
+func (repo *ProjectRepository) deletionScope(ctx context.Context, name string) (deletionScope, error) {
+	var r Project
+	if err := repo.db.WithContext(ctx).Where("name = ?", name).First(&r).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return deletionScope{}, store.ErrResourceNotFound
+		}
+		return deletionScope{}, err
+	}
+	// soft deleted namespaces are removed as well as they refer to project
+	var namespaces []Namespace
+	if err := repo.db.WithContext(ctx).Unscoped().Where("project_id = ?", r.ID).Order("name").
+		Find(&namespaces).Error; err != nil {
+		return deletionScope{}, err
+	}
+	return deletionScope{namespaces: namespaces, project: &r}, nil
+}
+
 func NewProjectRepository(db *gorm.DB, hash models.ApplicationKey) *ProjectRepository {
 	return &ProjectRepository{
 		db:   db,
@@ -243,4 +243,51 @@ func TestProjectRepository(t *testing.T) {
 		sec, _ = checkModels[1].Secret.GetByName("t2")
 		assert.Equal(t, "v2", sec)
 	})
+	t.Run("Delete", func(t *testing.T) {
+		db := DBSetup()
+		sqlDB, _ := db.DB()
+		defer sqlDB.Close()
+		var testModels []models.ProjectSpec
+		testModels = append(testModels, testConfigs...)
+
+		repo := NewProjectRepository(db, hash)
+
+		assert.Nil(t, repo.Insert(ctx, testModels[2]))
+		assert.Nil(t, repo.Insert(ctx, testModels[3]))
+
+		namespaceSpec := models.NamespaceSpec{
+			ID:   uuid.Must(uuid.NewRandom()),
+			Name: "t-namespace",
+		}
+		assert.Nil(t, NewNamespaceRepository(db, testModels[2], hash).Insert(ctx, namespaceSpec))
+
+		err := NewSecretRepository(db, testModels[2], models.NamespaceSpec{}, hash).Save(ctx, models.ProjectSecretItem{
+			Name:  "t1",
+			Value: "v1",
+		})
+		assert.Nil(t, err)
+		err = NewSecretRepository(db, testModels[2], namespaceSpec, hash).Save(ctx, models.ProjectSecretItem{
+			Name:  "t2",
+			Value: "v2",
+		})
+		assert.Nil(t, err)
+
+		plan, err := repo.GetDeletionPlan(ctx, testModels[2].Name)
+		assert.Nil(t, err)
+		assert.Equal(t, []models.NamespaceDeletionPlan{{Name: "t-namespace"}}, plan.Namespaces)
+		assert.Equal(t, []string{"t1", "t2"}, plan.Secrets)
+
+		err = repo.Delete(ctx, testModels[2].Name)
+		assert.Nil(t, err)
+
+		_, err = repo.GetByName(ctx, testModels[2].Name)
+		assert.Equal(t, store.ErrResourceNotFound, err)
+		checkModels, err := repo.GetAll(ctx)
+		assert.Nil(t, err)
+		assert.Equal(t, 1, len(checkModels))
+		assert.Equal(t, "t-optimus-2", checkModels[0].Name)
+
+		err = repo.Delete(ctx, testModels[2].Name)
+		assert.Equal(t, store.ErrResourceNotFound, err)
+	})
 }
@@ -29,6 +29,9 @@ type Secret struct {
 
 	Type string
 
+	// KeyID identifies the application key used to encrypt value
+	KeyID string
+
 	CreatedAt time.Time `gorm:"not null" json:"created_at"`
 	UpdatedAt time.Time `gorm:"not null" json:"updated_at"`
 	DeletedAt gorm.DeletedAt
@@ -36,15 +39,11 @@ type Secret struct {
 
 func (p Secret) FromSpec(spec models.ProjectSecretItem, proj models.ProjectSpec, namespace models.NamespaceSpec,
 	hash models.ApplicationKey) (Secret, error) {
-	// encrypt secret
-	cipher, err := cryptopasta.Encrypt([]byte(spec.Value), hash.GetKey())
+	base64cipher, err := encryptSecret(spec.Value, hash)
 	if err != nil {
 		return Secret{}, err
 	}
 
-	// base64 for storing safely in db
-	base64cipher := base64.StdEncoding.EncodeToString(cipher)
-
 	secretType := models.SecretTypeUserDefined
 	if strings.HasPrefix(spec.Name, models.SecretTypeSystemDefinedPrefix) {
 		secretType = models.SecretTypeSystemDefined
@@ -57,6 +56,7 @@ func (p Secret) FromSpec(spec models.ProjectSecretItem, proj models.ProjectSpec,
 		ProjectID:   proj.ID,
 		NamespaceID: namespace.ID,
 		Type:        secretType.String(),
+		KeyID:       hash.GetKeyID(),
 	}, nil
 }
 
@@ -67,8 +67,12 @@ func (p Secret) ToSpec(hash models.ApplicationKey) (models.ProjectSecretItem, er
 		return models.ProjectSecretItem{}, err
 	}
 
-	// decrypt secret
-	cleartext, err := cryptopasta.Decrypt(encrypted, hash.GetKey())
+	// decrypt secret with the key it was encrypted with
+	key, err := hash.GetKeyByID(p.KeyID)
+	if err != nil {
+		return models.ProjectSecretItem{}, err
+	}
+	cleartext, err := cryptopasta.Decrypt(encrypted, key)
 	if err != nil {
 		return models.ProjectSecretItem{}, err
 	}
@@ -79,13 +83,24 @@ func (p Secret) ToSpec(hash models.ApplicationKey) (models.ProjectSecretItem, er
 	}
 
 	return models.ProjectSecretItem{
-		ID:    p.ID,
-		Name:  p.Name,
-		Value: string(cleartext),
-		Type:  secretType,
+		ID:          p.ID,
+		Name:        p.Name,
+		Value:       string(cleartext),
+		Type:        secretType,
+		NamespaceID: p.NamespaceID,
 	}, nil
 }
 
+// encryptSecret encrypts value with primary application key and encodes
+// it in base64 for storing safely in db
+func encryptSecret(value string, hash models.ApplicationKey) (string, error) {
+	cipher, err := cryptopasta.Encrypt([]byte(value), hash.GetKey())
+	if err != nil {
+		return "", err
+	}
+	return base64.StdEncoding.EncodeToString(cipher), nil
+}
+
 type secretRepository struct {
 	db        *gorm.DB
 	project   models.ProjectSpec
@@ -158,7 +173,7 @@ func (repo *secretRepository) GetByID(ctx context.Context, id uuid.UUID) (models
 func (repo *secretRepository) GetAll(ctx context.Context) ([]models.ProjectSecretItem, error) {
 	var specs []models.ProjectSecretItem
 	var resources []Secret
-	if err := repo.db.WithContext(ctx).Find(&resources).Error; err != nil {
+	if err := repo.db.WithContext(ctx).Where("project_id = ?", repo.project.ID).Find(&resources).Error; err != nil {
 		return specs, err
 	}
 	for _, res := range resources {
@@ -171,6 +186,94 @@ func (repo *secretRepository) GetAll(ctx context.Context) ([]models.ProjectSecre
 	return specs, nil
 }
 
+func (repo *secretRepository) GetSecrets(ctx context.Context) ([]models.SecretItemInfo, error) {
+	var secretItems []models.SecretItemInfo
+	var resources []Secret
+	if err := repo.db.WithContext(ctx).Preload("Namespace").Where("project_id = ?", repo.project.ID).
+		Order("name").Find(&resources).Error; err != nil {
+		return secretItems, err
+	}
+	for _, res := range resources {
+		adapted, err := res.ToSpec(repo.hash)
+		if err != nil {
+			return secretItems, errors.Wrap(err, "failed to adapt secret")
+		}
+		// digest is keyed with the key secret is encrypted with so that it
+		// doesn't change when application key is rotated
+		digest, err := repo.hash.Digest(res.KeyID, adapted.Value)
+		if err != nil {
+			return secretItems, errors.Wrap(err, "failed to digest secret")
+		}
+		secretItems = append(secretItems, models.SecretItemInfo{
+			ID:        adapted.ID,
+			Name:      adapted.Name,
+			Digest:    digest,
+			Namespace: res.Namespace.Name,
+			Type:      adapted.Type,
+			UpdatedAt: res.UpdatedAt,
+		})
+	}
+	return secretItems, nil
+}
+
+// Delete removes the secret permanently so that it can be registered again
+func (repo *secretRepository) Delete(ctx context.Context, name string) error {
+	result := repo.db.WithContext(ctx).Unscoped().Where("name = ? AND project_id = ?", name, repo.project.ID).
+		Delete(&Secret{})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return store.ErrResourceNotFound
+	}
+	return nil
+}
+
+// ReencryptSecrets rewrites secrets of all the projects which are not
+// encrypted with primary application key in batches of batchSize, each
+// batch is committed separately. Returns number of re-encrypted secrets.
+func ReencryptSecrets(ctx context.Context, db *gorm.DB, hash models.ApplicationKey, batchSize int) (int, error) {
+	if batchSize <= 0 {
+		return 0, errors.New("batch size should be greater than 0")
+	}
+	var reencrypted int
+	for {
+		var resources []Secret
+		if err := db.WithContext(ctx).Unscoped().Where("key_id <> ?", hash.GetKeyID()).
+			Order("id").Limit(batchSize).Find(&resources).Error; err != nil {
+			return reencrypted, err
+		}
+		if len(resources) == 0 {
+			return reencrypted, nil
+		}
+
+		if err := db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+			for _, res := range resources {
+				spec, err := res.ToSpec(hash)
+				if err != nil {
+					return errors.Wrapf(err, "failed to decrypt secret %s", res.ID)
+				}
+				base64cipher, err := encryptSecret(spec.Value, hash)
+				if err != nil {
+					return errors.Wrapf(err, "failed to encrypt secret %s", res.ID)
+				}
+				// update columns directly to keep updated_at of secret unchanged
+				if err := tx.Model(&Secret{}).Unscoped().Where("id = ? AND key_id = ?", res.ID, res.KeyID).
+					UpdateColumns(map[string]interface{}{
+						"value":  base64cipher,
+						"key_id": hash.GetKeyID(),
+					}).Error; err != nil {
+					return err
+				}
+			}
+			return nil
+		}); err != nil {
+			return reencrypted, err
+		}
+		reencrypted += len(resources)
+	}
+}
+
 func NewSecretRepository(db *gorm.DB, project models.ProjectSpec, namespace models.NamespaceSpec, hash models.ApplicationKey) *secretRepository {
 	return &secretRepository{
 		db:        db,
@@ -11,6 +11,7 @@ import (
 
 	"github.com/google/uuid"
 	"github.com/odpf/optimus/models"
+	"github.com/odpf/optimus/store"
 	"github.com/stretchr/testify/assert"
 	"gorm.io/gorm"
 )
@@ -244,4 +245,117 @@ func TestSecretRepository(t *testing.T) {
 		assert.Nil(t, err)
 		assert.Equal(t, "g-optimus", checkModel.Name)
 	})
+	t.Run("GetSecrets", func(t *testing.T) {
+		db := DBSetup()
+		sqlDB, _ := db.DB()
+		defer sqlDB.Close()
+
+		projectRepo := NewSecretRepository(db, projectSpec, models.NamespaceSpec{}, hash)
+		err := projectRepo.Insert(ctx, testConfigs[3])
+		assert.Nil(t, err)
+		namespaceRepo := NewSecretRepository(db, projectSpec, namespaceSpec, hash)
+		err = namespaceRepo.Insert(ctx, testConfigs[0])
+		assert.Nil(t, err)
+
+		secretItems, err := projectRepo.GetSecrets(ctx)
+		assert.Nil(t, err)
+		assert.Len(t, secretItems, 2)
+
+		secretsByName := map[string]models.SecretItemInfo{}
+		for _, item := range secretItems {
+			secretsByName[item.Name] = item
+		}
+		systemSecret := secretsByName["_OPTIMUS_sample_secret"]
+		assert.Equal(t, "", systemSecret.Namespace)
+		assert.Equal(t, models.SecretTypeSystemDefined, systemSecret.Type)
+
+		userSecret := secretsByName["g-optimus"]
+		assert.Equal(t, namespaceSpec.Name, userSecret.Namespace)
+		assert.Equal(t, models.SecretTypeUserDefined, userSecret.Type)
+		// hmac-sha256 of "secret" keyed with application key
+		assert.Equal(t, "xmef/iVO+WuB4E2QwoEp3YjcKxsMKhEUke465fp/xtU=", userSecret.Digest)
+		assert.False(t, userSecret.UpdatedAt.IsZero())
+
+		// digest is kept after application key is rotated
+		rotatedHash, err := models.NewApplicationKeyring("rotated", "32charsrotatedhashrotatedhashhas",
+			map[string]string{models.DefaultApplicationKeyID: "32charshtesthashtesthashtesthash"})
+		assert.Nil(t, err)
+		rotatedItems, err := NewSecretRepository(db, projectSpec, models.NamespaceSpec{}, rotatedHash).GetSecrets(ctx)
+		assert.Nil(t, err)
+		assert.Equal(t, secretItems, rotatedItems)
+	})
+	t.Run("Delete", func(t *testing.T) {
+		t.Run("should delete secret so that it can be registered again", func(t *testing.T) {
+			db := DBSetup()
+			sqlDB, _ := db.DB()
+			defer sqlDB.Close()
+
+			repo := NewSecretRepository(db, projectSpec, namespaceSpec, hash)
+			err := repo.Insert(ctx, testConfigs[0])
+			assert.Nil(t, err)
+
+			err = repo.Delete(ctx, testConfigs[0].Name)
+			assert.Nil(t, err)
+
+			_, err = repo.GetByName(ctx, testConfigs[0].Name)
+			assert.Equal(t, store.ErrResourceNotFound, err)
+
+			err = repo.Save(ctx, models.ProjectSecretItem{Name: testConfigs[0].Name, Value: "new-secret"})
+			assert.Nil(t, err)
+		})
+		t.Run("should return not found for unknown secret", func(t *testing.T) {
+			db := DBSetup()
+			sqlDB, _ := db.DB()
+			defer sqlDB.Close()
+
+			repo := NewSecretRepository(db, projectSpec, namespaceSpec, hash)
+			err := repo.Delete(ctx, "unknown")
+			assert.Equal(t, store.ErrResourceNotFound, err)
+		})
+	})
+	t.Run("ReencryptSecrets", func(t *testing.T) {
+		t.Run("should re-encrypt secrets with primary key in batches", func(t *testing.T) {
+			db := DBSetup()
+			sqlDB, _ := db.DB()
+			defer sqlDB.Close()
+
+			repo := NewSecretRepository(db, projectSpec, namespaceSpec, hash)
+			for _, idx := range []int{0, 2, 3} {
+				assert.Nil(t, repo.Insert(ctx, testConfigs[idx]))
+			}
+
+			rotatedHash, err := models.NewApplicationKeyring("rotated", "32charsrotatedhashrotatedhashhas",
+				map[string]string{models.DefaultApplicationKeyID: "32charshtesthashtesthashtesthash"})
+			assert.Nil(t, err)
+
+			reencrypted, err := ReencryptSecrets(ctx, db, rotatedHash, 2)
+			assert.Nil(t, err)
+			assert.Equal(t, 3, reencrypted)
+
+			// secrets should be readable without the old key
+			newHash, err := models.NewApplicationKeyring("rotated", "32charsrotatedhashrotatedhashhas", nil)
+			assert.Nil(t, err)
+			checkModel, err := NewSecretRepository(db, projectSpec, namespaceSpec, newHash).GetByName(ctx, testConfigs[2].Name)
+			assert.Nil(t, err)
+			assert.Equal(t, "super-secret", checkModel.Value)
+
+			reencrypted, err = ReencryptSecrets(ctx, db, rotatedHash, 2)
+			assert.Nil(t, err)
+			assert.Equal(t, 0, reencrypted)
+		})
+		t.Run("should fail if key of secret is not available", func(t *testing.T) {
+			db := DBSetup()
+			sqlDB, _ := db.DB()
+			defer sqlDB.Close()
+
+			repo := NewSecretRepository(db, projectSpec, namespaceSpec, hash)
+			assert.Nil(t, repo.Insert(ctx, testConfigs[0]))
+
+			rotatedHash, err := models.NewApplicationKeyring("rotated", "32charsrotatedhashrotatedhashhas", nil)
+			assert.Nil(t, err)
+
+			_, err = ReencryptSecrets(ctx, db, rotatedHash, 2)
+			assert.NotNil(t, err)
+		})
+	})
 }
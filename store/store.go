@@ -40,6 +40,12 @@ type ProjectRepository interface {
 	Save(context.Context, models.ProjectSpec) error
 	GetByName(context.Context, string) (models.ProjectSpec, error)
 	GetAll(context.Context) ([]models.ProjectSpec, error)
+
+	// GetDeletionPlan lists everything removed on deleting the project
+	GetDeletionPlan(context.Context, string) (models.DeletionPlan, error)
+	// Delete removes the project permanently along with its namespaces, jobs,
+	// resources, secrets, replays, backups and runs
+	Delete(context.Context, string) error
 }
 
 // ProjectSecretRepository stores secrets attached to projects
@@ -48,6 +54,10 @@ type ProjectSecretRepository interface {
 	Update(ctx context.Context, item models.ProjectSecretItem) error
 	GetByName(context.Context, string) (models.ProjectSecretItem, error)
 	GetAll(context.Context) ([]models.ProjectSecretItem, error)
+
+	// GetSecrets returns metadata of all the secrets in project
+	GetSecrets(context.Context) ([]models.SecretItemInfo, error)
+	Delete(context.Context, string) error
 }
 
 // NamespaceRepository represents a storage interface for registered namespaces
@@ -55,6 +65,12 @@ type NamespaceRepository interface {
 	Save(context.Context, models.NamespaceSpec) error
 	GetByName(context.Context, string) (models.NamespaceSpec, error)
 	GetAll(context.Context) ([]models.NamespaceSpec, error)
+
+	// GetDeletionPlan lists everything removed on deleting the namespace
+	GetDeletionPlan(context.Context, string) (models.DeletionPlan, error)
+	// Delete removes the namespace permanently along with its jobs, resources,
+	// secrets, replays, backups and runs
+	Delete(context.Context, string) error
 }
 
 // JobRunSpecRepository represents a storage interface for Job runs generated to
@@ -67,10 +83,23 @@ type JobRunRepository interface {
 	GetByScheduledAt(ctx context.Context, jobID uuid.UUID, scheduledAt time.Time) (models.JobRun, models.NamespaceSpec, error)
 	GetByID(context.Context, uuid.UUID) (models.JobRun, models.NamespaceSpec, error)
 	UpdateStatus(context.Context, uuid.UUID, models.JobRunState) error
+	// UpdateWaitReason records why a pending run is still waiting, empty reason
+	// clears it
+	UpdateWaitReason(ctx context.Context, id uuid.UUID, reason string) error
 	GetByStatus(ctx context.Context, state ...models.JobRunState) ([]models.JobRun, error)
 	GetByTrigger(ctx context.Context, trigger models.JobRunTrigger, state ...models.JobRunState) ([]models.JobRun, error)
 	Delete(context.Context, uuid.UUID) error
 
+	// GetByJobName returns all runs of a job in project ordered by their schedule time
+	GetByJobName(ctx context.Context, projectSpec models.ProjectSpec, jobName string) ([]models.JobRun, error)
+	// GetByJobNameAndScheduledAt returns runs of a job in project scheduled between
+	// start and end date inclusive, ordered by their schedule time. Offset and limit
+	// can be used to fetch them in batches, a limit of 0 returns all the runs
+	GetByJobNameAndScheduledAt(ctx context.Context, projectSpec models.ProjectSpec, jobName string,
+		startDate, endDate time.Time, offset, limit int) ([]models.JobRun, error)
+	// GetJobNames returns name of all the jobs having a run in namespace
+	GetJobNames(ctx context.Context, namespaceSpec models.NamespaceSpec) ([]string, error)
+
 	AddInstance(ctx context.Context, namespace models.NamespaceSpec, run models.JobRun, spec models.InstanceSpec) error
 
 	// Clear will not delete the record but will reset all the run details
@@ -79,11 +108,26 @@ type JobRunRepository interface {
 	ClearInstance(ctx context.Context, runID uuid.UUID, instanceType models.InstanceType, instanceName string) error
 }
 
+// JobDeploymentRepository stores jobs deployed to the built-in scheduler
+type JobDeploymentRepository interface {
+	// Save inserts the deployment if it doesn't exist else updates the job
+	// specification keeping its schedule state intact
+	Save(ctx context.Context, namespace models.NamespaceSpec, spec models.JobSpec) error
+	Delete(ctx context.Context, namespace models.NamespaceSpec, jobName string) error
+	GetByNamespace(ctx context.Context, namespace models.NamespaceSpec) ([]models.JobDeployment, error)
+	// GetAll returns deployments across all the projects
+	GetAll(ctx context.Context) ([]models.JobDeployment, error)
+	UpdateLastScheduledAt(ctx context.Context, id uuid.UUID, lastScheduledAt time.Time) error
+}
+
 // JobRunSpecRepository represents a storage interface for Job run instances created
 // during execution
 type InstanceRepository interface {
 	Save(ctx context.Context, run models.JobRun, spec models.InstanceSpec) error
 	UpdateStatus(ctx context.Context, id uuid.UUID, status models.JobRunState) error
+	// UpdateExecution records the state, exit code, start/finish time and
+	// failure reason of instance
+	UpdateExecution(ctx context.Context, id uuid.UUID, spec models.InstanceSpec) error
 	GetByName(ctx context.Context, runID uuid.UUID, instanceName, instanceType string) (models.InstanceSpec, error)
 
 	DeleteByJobRun(ctx context.Context, id uuid.UUID) error
@@ -115,6 +159,21 @@ type ReplaySpecRepository interface {
 	GetByProjectID(ctx context.Context, projectID uuid.UUID) ([]models.ReplaySpec, error)
 }
 
+// AuditRepository represents a storage interface for audit entries
+type AuditRepository interface {
+	Insert(ctx context.Context, entry models.AuditEntry) error
+	List(ctx context.Context, filter models.AuditFilter) ([]models.AuditEntry, error)
+}
+
+// JobEventRepository represents a storage interface for events registered for jobs
+type JobEventRepository interface {
+	Insert(ctx context.Context, record models.JobEventRecord) error
+	List(ctx context.Context, namespace models.NamespaceSpec, jobName string, filter models.JobEventFilter) ([]models.JobEventRecord, error)
+	// DeleteOlderThan removes events of all jobs registered before the given
+	// time, returns the number of events removed
+	DeleteOlderThan(ctx context.Context, before time.Time) (int64, error)
+}
+
 // BackupRepository represents a storage interface for backup objects
 type BackupRepository interface {
 	Save(ctx context.Context, spec models.BackupSpec) error
@@ -0,0 +1,52 @@
+package vault
+
+import (
+	"context"
+
+	"github.com/odpf/optimus/models"
+	"github.com/odpf/optimus/store"
+)
+
+// namespaceRepository carries secrets of project resolved from vault into
+// namespaces of the project, namespaces are read from optimus db with only
+// the secrets stored there
+type namespaceRepository struct {
+	store.NamespaceRepository
+	project models.ProjectSpec
+}
+
+func (repo *namespaceRepository) GetByName(ctx context.Context, name string) (models.NamespaceSpec, error) {
+	spec, err := repo.NamespaceRepository.GetByName(ctx, name)
+	if err != nil {
+		return spec, err
+	}
+	return repo.withProjectSecrets(spec), nil
+}
+
+func (repo *namespaceRepository) GetAll(ctx context.Context) ([]models.NamespaceSpec, error) {
+	specs, err := repo.NamespaceRepository.GetAll(ctx)
+	if err != nil {
+		return specs, err
+	}
+	for i := range specs {
+		specs[i] = repo.withProjectSecrets(specs[i])
+	}
+	return specs, nil
+}
+
+func (repo *namespaceRepository) withProjectSecrets(spec models.NamespaceSpec) models.NamespaceSpec {
+	if repo.project.Config[models.ProjectSecretBackend] == models.SecretBackendVault {
+		spec.ProjectSpec.Secret = repo.project.Secret
+	}
+	return spec
+}
+
+// NewNamespaceRepository wraps a namespace repository of project to use
+// secrets of project resolved from vault, project should be fetched with
+// projectRepository for its secrets to be resolved
+func NewNamespaceRepository(base store.NamespaceRepository, project models.ProjectSpec) *namespaceRepository {
+	return &namespaceRepository{
+		NamespaceRepository: base,
+		project:             project,
+	}
+}
@@ -0,0 +1,65 @@
+package vault_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/odpf/optimus/mock"
+	"github.com/odpf/optimus/models"
+	"github.com/odpf/optimus/store/vault"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNamespaceRepository(t *testing.T) {
+	ctx := context.Background()
+	storedProjectSpec := models.ProjectSpec{
+		Name: "t-optimus-project",
+		Config: map[string]string{
+			models.ProjectSecretBackend: models.SecretBackendVault,
+		},
+		Secret: models.ProjectSecrets{
+			{
+				Name:  models.ProjectSecretBackendAuth,
+				Value: testToken,
+			},
+		},
+	}
+	namespaceSpec := models.NamespaceSpec{
+		Name:        "sample-namespace",
+		ProjectSpec: storedProjectSpec,
+	}
+
+	t.Run("should use secrets of project resolved from vault", func(t *testing.T) {
+		resolvedProjectSpec := storedProjectSpec
+		resolvedProjectSpec.Secret = append(models.ProjectSecrets{
+			{
+				Name:  "g-optimus",
+				Value: "secret",
+			},
+		}, storedProjectSpec.Secret...)
+
+		baseRepo := new(mock.NamespaceRepository)
+		baseRepo.On("GetByName", ctx, namespaceSpec.Name).Return(namespaceSpec, nil)
+		baseRepo.On("GetAll", ctx).Return([]models.NamespaceSpec{namespaceSpec}, nil)
+		defer baseRepo.AssertExpectations(t)
+
+		repo := vault.NewNamespaceRepository(baseRepo, resolvedProjectSpec)
+		resolvedSpec, err := repo.GetByName(ctx, namespaceSpec.Name)
+		assert.Nil(t, err)
+		assert.Equal(t, resolvedProjectSpec.Secret, resolvedSpec.ProjectSpec.Secret)
+
+		resolvedSpecs, err := repo.GetAll(ctx)
+		assert.Nil(t, err)
+		assert.Equal(t, resolvedProjectSpec.Secret, resolvedSpecs[0].ProjectSpec.Secret)
+	})
+	t.Run("should not change secrets of project stored in optimus", func(t *testing.T) {
+		projectSpec := models.ProjectSpec{Name: "t-optimus-project"}
+		baseRepo := new(mock.NamespaceRepository)
+		baseRepo.On("GetByName", ctx, namespaceSpec.Name).Return(namespaceSpec, nil)
+		defer baseRepo.AssertExpectations(t)
+
+		resolvedSpec, err := vault.NewNamespaceRepository(baseRepo, projectSpec).GetByName(ctx, namespaceSpec.Name)
+		assert.Nil(t, err)
+		assert.Equal(t, namespaceSpec, resolvedSpec)
+	})
+}
@@ -0,0 +1,66 @@
+package vault
+
+import (
+	"context"
+
+	"github.com/odpf/optimus/models"
+	"github.com/odpf/optimus/store"
+	"github.com/pkg/errors"
+)
+
+// projectRepository resolves secrets of projects using vault as secret backend
+// at runtime, secrets stored in optimus db are overridden by the ones in vault
+// with the same name. Secrets are resolved only when a project is fetched by
+// name, listed projects carry secrets stored in optimus db so that a vault
+// which can't be reached fails only the project using it
+type projectRepository struct {
+	store.ProjectRepository
+	httpClient HTTPClient
+	hash       models.ApplicationKey
+}
+
+func (repo *projectRepository) GetByName(ctx context.Context, name string) (models.ProjectSpec, error) {
+	spec, err := repo.ProjectRepository.GetByName(ctx, name)
+	if err != nil {
+		return spec, err
+	}
+	return repo.resolveSecrets(ctx, spec)
+}
+
+func (repo *projectRepository) resolveSecrets(ctx context.Context, spec models.ProjectSpec) (models.ProjectSpec, error) {
+	if spec.Config[models.ProjectSecretBackend] != models.SecretBackendVault {
+		return spec, nil
+	}
+	backendSecrets, err := NewSecretRepository(repo.httpClient, spec, models.NamespaceSpec{}, repo.hash).GetAll(ctx)
+	if err != nil {
+		return spec, errors.Wrapf(err, "failed to resolve secrets of project %s", spec.Name)
+	}
+
+	spec.Secret = overrideSecrets(spec.Secret, backendSecrets)
+	return spec, nil
+}
+
+// overrideSecrets replaces secrets with the overrides having the same name
+func overrideSecrets(secrets, overrides []models.ProjectSecretItem) []models.ProjectSecretItem {
+	overridden := map[string]bool{}
+	for _, secret := range overrides {
+		overridden[secret.Name] = true
+	}
+	merged := []models.ProjectSecretItem{}
+	for _, secret := range secrets {
+		if !overridden[secret.Name] {
+			merged = append(merged, secret)
+		}
+	}
+	return append(merged, overrides...)
+}
+
+// NewProjectRepository wraps a project repository to resolve secrets of
+// projects from vault when configured as their secret backend
+func NewProjectRepository(base store.ProjectRepository, httpClient HTTPClient, hash models.ApplicationKey) *projectRepository {
+	return &projectRepository{
+		ProjectRepository: base,
+		httpClient:        httpClient,
+		hash:              hash,
+	}
+}
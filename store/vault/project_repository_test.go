@@ -0,0 +1,82 @@
+package vault_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/odpf/optimus/mock"
+	"github.com/odpf/optimus/models"
+	"github.com/odpf/optimus/store/vault"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestProjectRepository(t *testing.T) {
+	ctx := context.Background()
+	hash, _ := models.NewApplicationSecret("32charshtesthashtesthashtesthash")
+
+	t.Run("GetByName", func(t *testing.T) {
+		t.Run("should resolve secrets of project from vault", func(t *testing.T) {
+			_, srv := newKVServer(t)
+			projectSpec := models.ProjectSpec{
+				Name: "t-optimus-project",
+				Config: map[string]string{
+					models.ProjectSecretBackend:     models.SecretBackendVault,
+					models.ProjectSecretBackendHost: srv.URL,
+				},
+				Secret: models.ProjectSecrets{
+					{
+						Name:  models.ProjectSecretBackendAuth,
+						Value: testToken,
+					},
+					{
+						Name:  "g-optimus",
+						Value: "stale-secret",
+						Type:  models.SecretTypeUserDefined,
+					},
+				},
+			}
+			secretRepo := vault.NewSecretRepository(srv.Client(), projectSpec, models.NamespaceSpec{}, hash)
+			assert.Nil(t, secretRepo.Save(ctx, models.ProjectSecretItem{Name: "g-optimus", Value: "secret"}))
+
+			baseRepo := new(mock.ProjectRepository)
+			baseRepo.On("GetByName", ctx, projectSpec.Name).Return(projectSpec, nil)
+			defer baseRepo.AssertExpectations(t)
+
+			resolvedSpec, err := vault.NewProjectRepository(baseRepo, srv.Client(), hash).GetByName(ctx, projectSpec.Name)
+			assert.Nil(t, err)
+			assert.Equal(t, models.ProjectSecrets{
+				{
+					Name:  models.ProjectSecretBackendAuth,
+					Value: testToken,
+				},
+				{
+					Name:  "g-optimus",
+					Value: "secret",
+					Type:  models.SecretTypeUserDefined,
+				},
+			}, resolvedSpec.Secret)
+		})
+		t.Run("should list projects without resolving secrets from vault", func(t *testing.T) {
+			projectSpec := models.ProjectSpec{
+				Name: "t-optimus-project",
+				Config: map[string]string{
+					models.ProjectSecretBackend:     models.SecretBackendVault,
+					models.ProjectSecretBackendHost: "http://unreachable-vault",
+				},
+				Secret: models.ProjectSecrets{
+					{
+						Name:  "g-optimus",
+						Value: "secret",
+					},
+				},
+			}
+			baseRepo := new(mock.ProjectRepository)
+			baseRepo.On("GetAll", ctx).Return([]models.ProjectSpec{projectSpec}, nil)
+			defer baseRepo.AssertExpectations(t)
+
+			specs, err := vault.NewProjectRepository(baseRepo, nil, hash).GetAll(ctx)
+			assert.Nil(t, err)
+			assert.Equal(t, []models.ProjectSpec{projectSpec}, specs)
+		})
+	})
+}
@@ -0,0 +1,196 @@
+package vault
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/odpf/optimus/models"
+	"github.com/odpf/optimus/store"
+	"github.com/pkg/errors"
+)
+
+type secretRepository struct {
+	httpClient HTTPClient
+	project    models.ProjectSpec
+	namespace  models.NamespaceSpec
+
+	// hash keys digests of secrets, values are stored in vault as is
+	hash models.ApplicationKey
+}
+
+func (repo *secretRepository) Save(ctx context.Context, item models.ProjectSecretItem) error {
+	if len(item.Name) == 0 {
+		return errors.New("name cannot be empty")
+	}
+	kv, err := newKVClient(repo.httpClient, repo.project)
+	if err != nil {
+		return err
+	}
+	_, err = kv.read(ctx, item.Name)
+	if errors.Is(err, store.ErrResourceNotFound) {
+		return kv.write(ctx, item.Name, repo.toSecretData(item))
+	} else if err != nil {
+		return errors.Wrap(err, "unable to find secret by name")
+	}
+	return errors.New("secret already exist")
+}
+
+func (repo *secretRepository) Update(ctx context.Context, item models.ProjectSecretItem) error {
+	kv, err := newKVClient(repo.httpClient, repo.project)
+	if err != nil {
+		return err
+	}
+	_, err = kv.read(ctx, item.Name)
+	if errors.Is(err, store.ErrResourceNotFound) {
+		return errors.New(fmt.Sprintf("secret %s does not exist", item.Name))
+	} else if err != nil {
+		return errors.Wrap(err, "unable to find secret by name")
+	}
+	return kv.write(ctx, item.Name, repo.toSecretData(item))
+}
+
+func (repo *secretRepository) GetByName(ctx context.Context, name string) (models.ProjectSecretItem, error) {
+	kv, err := newKVClient(repo.httpClient, repo.project)
+	if err != nil {
+		return models.ProjectSecretItem{}, err
+	}
+	secret, err := kv.read(ctx, name)
+	if err != nil {
+		return models.ProjectSecretItem{}, err
+	}
+	return toSpec(name, secret.Data)
+}
+
+func (repo *secretRepository) GetAll(ctx context.Context) ([]models.ProjectSecretItem, error) {
+	var specs []models.ProjectSecretItem
+	err := repo.forEach(ctx, func(name string, secret kvSecret) error {
+		spec, err := toSpec(name, secret.Data)
+		if err != nil {
+			return err
+		}
+		specs = append(specs, spec)
+		return nil
+	})
+	return specs, err
+}
+
+func (repo *secretRepository) GetSecrets(ctx context.Context) ([]models.SecretItemInfo, error) {
+	var secretItems []models.SecretItemInfo
+	err := repo.forEach(ctx, func(name string, secret kvSecret) error {
+		spec, err := toSpec(name, secret.Data)
+		if err != nil {
+			return err
+		}
+		digest, err := repo.digest(spec.Value, secret.Data.KeyID)
+		if err != nil {
+			return errors.Wrapf(err, "failed to digest secret %s", name)
+		}
+		secretItems = append(secretItems, models.SecretItemInfo{
+			Name:      spec.Name,
+			Digest:    digest,
+			Namespace: secret.Data.Namespace,
+			Type:      spec.Type,
+			UpdatedAt: secret.Metadata.CreatedTime,
+		})
+		return nil
+	})
+	return secretItems, err
+}
+
+// Delete removes all the versions of secret
+func (repo *secretRepository) Delete(ctx context.Context, name string) error {
+	kv, err := newKVClient(repo.httpClient, repo.project)
+	if err != nil {
+		return err
+	}
+	if _, err := kv.read(ctx, name); err != nil {
+		return err
+	}
+	return kv.delete(ctx, name)
+}
+
+func (repo *secretRepository) forEach(ctx context.Context, fn func(name string, secret kvSecret) error) error {
+	kv, err := newKVClient(repo.httpClient, repo.project)
+	if err != nil {
+		return err
+	}
+	names, err := kv.list(ctx)
+	if err != nil {
+		return errors.Wrap(err, "failed to list secrets")
+	}
+	for _, name := range names {
+		secret, err := kv.read(ctx, name)
+		if errors.Is(err, store.ErrResourceNotFound) {
+			// deleted after listing
+			continue
+		} else if err != nil {
+			return errors.Wrapf(err, "failed to read secret %s", name)
+		}
+		if err := fn(name, secret); err != nil {
+			return errors.Wrap(err, "failed to adapt secret")
+		}
+	}
+	return nil
+}
+
+func (repo *secretRepository) toSecretData(item models.ProjectSecretItem) secretData {
+	secretType := models.SecretTypeUserDefined
+	if strings.HasPrefix(item.Name, models.SecretTypeSystemDefinedPrefix) {
+		secretType = models.SecretTypeSystemDefined
+	}
+	data := secretData{
+		Value: item.Value,
+		Type:  secretType.String(),
+		KeyID: repo.hash.GetKeyID(),
+	}
+	if repo.namespace.ID != uuid.Nil {
+		data.Namespace = repo.namespace.Name
+		data.NamespaceID = repo.namespace.ID.String()
+	}
+	return data
+}
+
+// digest keys the digest of value with the application key recorded when
+// secret was written so that it doesn't change when application key is
+// rotated, secrets written before keys were recorded or whose key has been
+// dropped are digested with primary key
+func (repo *secretRepository) digest(value, keyID string) (string, error) {
+	if _, err := repo.hash.GetKeyByID(keyID); err != nil {
+		keyID = repo.hash.GetKeyID()
+	}
+	return repo.hash.Digest(keyID, value)
+}
+
+func toSpec(name string, data secretData) (models.ProjectSecretItem, error) {
+	secretType := models.SecretTypeSystemDefined
+	if data.Type == models.SecretTypeUserDefined.String() {
+		secretType = models.SecretTypeUserDefined
+	}
+	var namespaceID uuid.UUID
+	if data.NamespaceID != "" {
+		var err error
+		if namespaceID, err = uuid.Parse(data.NamespaceID); err != nil {
+			return models.ProjectSecretItem{}, errors.Wrapf(err, "invalid namespace id of secret %s", name)
+		}
+	}
+	return models.ProjectSecretItem{
+		Name:        name,
+		Value:       data.Value,
+		Type:        secretType,
+		NamespaceID: namespaceID,
+	}, nil
+}
+
+// NewSecretRepository creates a repository for secrets of project stored in
+// vault, address and path of vault are read from project config
+func NewSecretRepository(httpClient HTTPClient, project models.ProjectSpec, namespace models.NamespaceSpec,
+	hash models.ApplicationKey) *secretRepository {
+	return &secretRepository{
+		httpClient: httpClient,
+		project:    project,
+		namespace:  namespace,
+		hash:       hash,
+	}
+}
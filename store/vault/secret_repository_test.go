@@ -0,0 +1,282 @@
+package vault_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/odpf/optimus/models"
+	"github.com/odpf/optimus/store"
+	"github.com/odpf/optimus/store/vault"
+	"github.com/stretchr/testify/assert"
+)
+
+const testToken = "vault-token"
+
+// kvServer is a stand-in for kv version 2 secrets engine of vault
+type kvServer struct {
+	mu      sync.Mutex
+	secrets map[string]map[string]interface{}
+}
+
+func (s *kvServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if r.Header.Get("X-Vault-Token") != testToken {
+		w.WriteHeader(http.StatusForbidden)
+		return
+	}
+	switch {
+	case strings.HasPrefix(r.URL.Path, "/v1/secret/data/"):
+		path := strings.TrimPrefix(r.URL.Path, "/v1/secret/data/")
+		switch r.Method {
+		case http.MethodGet:
+			data, ok := s.secrets[path]
+			if !ok {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			json.NewEncoder(w).Encode(map[string]interface{}{
+				"data": map[string]interface{}{
+					"data": data,
+					"metadata": map[string]interface{}{
+						"created_time": "2021-10-10T10:00:00Z",
+						"version":      1,
+					},
+				},
+			})
+		case http.MethodPost:
+			var req struct {
+				Data map[string]interface{} `json:"data"`
+			}
+			if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			s.secrets[path] = req.Data
+			json.NewEncoder(w).Encode(map[string]interface{}{"data": map[string]interface{}{"version": 1}})
+		}
+	case strings.HasPrefix(r.URL.Path, "/v1/secret/metadata/"):
+		path := strings.TrimPrefix(r.URL.Path, "/v1/secret/metadata/")
+		switch {
+		case r.Method == http.MethodGet && r.URL.Query().Get("list") == "true":
+			var keys []string
+			for secretPath := range s.secrets {
+				if strings.HasPrefix(secretPath, path+"/") {
+					keys = append(keys, strings.TrimPrefix(secretPath, path+"/"))
+				}
+			}
+			if len(keys) == 0 {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			sort.Strings(keys)
+			json.NewEncoder(w).Encode(map[string]interface{}{"data": map[string]interface{}{"keys": keys}})
+		case r.Method == http.MethodDelete:
+			delete(s.secrets, path)
+			w.WriteHeader(http.StatusNoContent)
+		}
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
+
+func newKVServer(t *testing.T) (*kvServer, *httptest.Server) {
+	kv := &kvServer{secrets: map[string]map[string]interface{}{}}
+	srv := httptest.NewServer(kv)
+	t.Cleanup(srv.Close)
+	return kv, srv
+}
+
+func TestSecretRepository(t *testing.T) {
+	ctx := context.Background()
+	hash, _ := models.NewApplicationSecret("32charshtesthashtesthashtesthash")
+	namespaceSpec := models.NamespaceSpec{
+		ID:   uuid.Must(uuid.NewRandom()),
+		Name: "sample-namespace",
+	}
+	projectSpecFn := func(host string) models.ProjectSpec {
+		return models.ProjectSpec{
+			Name: "t-optimus-project",
+			Config: map[string]string{
+				models.ProjectSecretBackend:     models.SecretBackendVault,
+				models.ProjectSecretBackendHost: host,
+			},
+			Secret: models.ProjectSecrets{
+				{
+					Name:  models.ProjectSecretBackendAuth,
+					Value: testToken,
+				},
+			},
+		}
+	}
+
+	t.Run("Save", func(t *testing.T) {
+		t.Run("should store secret under path of project", func(t *testing.T) {
+			kv, srv := newKVServer(t)
+			repo := vault.NewSecretRepository(srv.Client(), projectSpecFn(srv.URL), namespaceSpec, hash)
+
+			err := repo.Save(ctx, models.ProjectSecretItem{Name: "g-optimus", Value: "secret"})
+			assert.Nil(t, err)
+			assert.Equal(t, map[string]interface{}{
+				"value":        "secret",
+				"type":         "user",
+				"namespace":    namespaceSpec.Name,
+				"namespace_id": namespaceSpec.ID.String(),
+				"key_id":       models.DefaultApplicationKeyID,
+			}, kv.secrets["optimus/t-optimus-project/g-optimus"])
+
+			checkModel, err := repo.GetByName(ctx, "g-optimus")
+			assert.Nil(t, err)
+			assert.Equal(t, models.ProjectSecretItem{
+				Name:        "g-optimus",
+				Value:       "secret",
+				Type:        models.SecretTypeUserDefined,
+				NamespaceID: namespaceSpec.ID,
+			}, checkModel)
+		})
+		t.Run("should use configured mount and path", func(t *testing.T) {
+			kv, srv := newKVServer(t)
+			projectSpec := projectSpecFn(srv.URL)
+			projectSpec.Config[models.ProjectSecretBackendMount] = "secret"
+			projectSpec.Config[models.ProjectSecretBackendPath] = "/teams/data/"
+			repo := vault.NewSecretRepository(srv.Client(), projectSpec, models.NamespaceSpec{}, hash)
+
+			err := repo.Save(ctx, models.ProjectSecretItem{Name: "_OPTIMUS_sample_secret", Value: "secret"})
+			assert.Nil(t, err)
+			assert.Equal(t, map[string]interface{}{
+				"value":  "secret",
+				"type":   "system",
+				"key_id": models.DefaultApplicationKeyID,
+			}, kv.secrets["teams/data/_OPTIMUS_sample_secret"])
+		})
+		t.Run("should fail if secret already exists", func(t *testing.T) {
+			_, srv := newKVServer(t)
+			repo := vault.NewSecretRepository(srv.Client(), projectSpecFn(srv.URL), namespaceSpec, hash)
+
+			err := repo.Save(ctx, models.ProjectSecretItem{Name: "g-optimus", Value: "secret"})
+			assert.Nil(t, err)
+			err = repo.Save(ctx, models.ProjectSecretItem{Name: "g-optimus", Value: "secret"})
+			assert.Equal(t, "secret already exist", err.Error())
+		})
+		t.Run("should fail if auth secret is not configured", func(t *testing.T) {
+			_, srv := newKVServer(t)
+			projectSpec := projectSpecFn(srv.URL)
+			projectSpec.Secret = nil
+			repo := vault.NewSecretRepository(srv.Client(), projectSpec, namespaceSpec, hash)
+
+			err := repo.Save(ctx, models.ProjectSecretItem{Name: "g-optimus", Value: "secret"})
+			assert.Equal(t, "SECRET_BACKEND_AUTH secret not configured for project t-optimus-project", err.Error())
+		})
+		t.Run("should fail if backend rejects the token", func(t *testing.T) {
+			_, srv := newKVServer(t)
+			projectSpec := projectSpecFn(srv.URL)
+			projectSpec.Secret = models.ProjectSecrets{{Name: models.ProjectSecretBackendAuth, Value: "invalid"}}
+			repo := vault.NewSecretRepository(srv.Client(), projectSpec, namespaceSpec, hash)
+
+			err := repo.Save(ctx, models.ProjectSecretItem{Name: "g-optimus", Value: "secret"})
+			assert.NotNil(t, err)
+		})
+	})
+	t.Run("Update", func(t *testing.T) {
+		t.Run("should overwrite existing secret", func(t *testing.T) {
+			_, srv := newKVServer(t)
+			repo := vault.NewSecretRepository(srv.Client(), projectSpecFn(srv.URL), namespaceSpec, hash)
+
+			err := repo.Save(ctx, models.ProjectSecretItem{Name: "g-optimus", Value: "secret"})
+			assert.Nil(t, err)
+			err = repo.Update(ctx, models.ProjectSecretItem{Name: "g-optimus", Value: "super-secret"})
+			assert.Nil(t, err)
+
+			checkModel, err := repo.GetByName(ctx, "g-optimus")
+			assert.Nil(t, err)
+			assert.Equal(t, "super-secret", checkModel.Value)
+		})
+		t.Run("should return error if secret does not exist", func(t *testing.T) {
+			_, srv := newKVServer(t)
+			repo := vault.NewSecretRepository(srv.Client(), projectSpecFn(srv.URL), namespaceSpec, hash)
+
+			err := repo.Update(ctx, models.ProjectSecretItem{Name: "g-optimus", Value: "secret"})
+			assert.Equal(t, "secret g-optimus does not exist", err.Error())
+		})
+	})
+	t.Run("GetSecrets", func(t *testing.T) {
+		_, srv := newKVServer(t)
+		projectRepo := vault.NewSecretRepository(srv.Client(), projectSpecFn(srv.URL), models.NamespaceSpec{}, hash)
+		assert.Nil(t, projectRepo.Save(ctx, models.ProjectSecretItem{Name: "_OPTIMUS_sample_secret", Value: "super-secret"}))
+		namespaceRepo := vault.NewSecretRepository(srv.Client(), projectSpecFn(srv.URL), namespaceSpec, hash)
+		assert.Nil(t, namespaceRepo.Save(ctx, models.ProjectSecretItem{Name: "g-optimus", Value: "secret"}))
+
+		secretItems, err := projectRepo.GetSecrets(ctx)
+		assert.Nil(t, err)
+		assert.Equal(t, []models.SecretItemInfo{
+			{
+				Name: "_OPTIMUS_sample_secret",
+				// hmac-sha256 of "super-secret" keyed with application key
+				Digest:    "rwKwn9SlnpCdz/qevE6+ctvtGq62nj1Yhni7+3Z7cvA=",
+				Type:      models.SecretTypeSystemDefined,
+				UpdatedAt: time.Date(2021, 10, 10, 10, 0, 0, 0, time.UTC),
+			},
+			{
+				Name: "g-optimus",
+				// hmac-sha256 of "secret" keyed with application key
+				Digest:    "xmef/iVO+WuB4E2QwoEp3YjcKxsMKhEUke465fp/xtU=",
+				Namespace: namespaceSpec.Name,
+				Type:      models.SecretTypeUserDefined,
+				UpdatedAt: time.Date(2021, 10, 10, 10, 0, 0, 0, time.UTC),
+			},
+		}, secretItems)
+
+		allSecrets, err := projectRepo.GetAll(ctx)
+		assert.Nil(t, err)
+		assert.Len(t, allSecrets, 2)
+	})
+	t.Run("GetSecrets should keep digest of secret after application key is rotated", func(t *testing.T) {
+		_, srv := newKVServer(t)
+		repo := vault.NewSecretRepository(srv.Client(), projectSpecFn(srv.URL), models.NamespaceSpec{}, hash)
+		assert.Nil(t, repo.Save(ctx, models.ProjectSecretItem{Name: "g-optimus", Value: "secret"}))
+
+		rotated, err := models.NewApplicationKeyring("v2", "zest-hashtest-hashtest-hashzzzzz",
+			map[string]string{models.DefaultApplicationKeyID: "32charshtesthashtesthashtesthash"})
+		assert.Nil(t, err)
+		rotatedRepo := vault.NewSecretRepository(srv.Client(), projectSpecFn(srv.URL), models.NamespaceSpec{}, rotated)
+
+		before, err := repo.GetSecrets(ctx)
+		assert.Nil(t, err)
+		after, err := rotatedRepo.GetSecrets(ctx)
+		assert.Nil(t, err)
+		assert.Len(t, after, 1)
+		assert.Equal(t, before, after)
+	})
+	t.Run("Delete", func(t *testing.T) {
+		t.Run("should delete secret so that it can be registered again", func(t *testing.T) {
+			_, srv := newKVServer(t)
+			repo := vault.NewSecretRepository(srv.Client(), projectSpecFn(srv.URL), namespaceSpec, hash)
+			assert.Nil(t, repo.Save(ctx, models.ProjectSecretItem{Name: "g-optimus", Value: "secret"}))
+
+			err := repo.Delete(ctx, "g-optimus")
+			assert.Nil(t, err)
+
+			_, err = repo.GetByName(ctx, "g-optimus")
+			assert.Equal(t, store.ErrResourceNotFound, err)
+
+			err = repo.Save(ctx, models.ProjectSecretItem{Name: "g-optimus", Value: "new-secret"})
+			assert.Nil(t, err)
+		})
+		t.Run("should return not found for unknown secret", func(t *testing.T) {
+			_, srv := newKVServer(t)
+			repo := vault.NewSecretRepository(srv.Client(), projectSpecFn(srv.URL), namespaceSpec, hash)
+
+			err := repo.Delete(ctx, "unknown")
+			assert.Equal(t, store.ErrResourceNotFound, err)
+		})
+	})
+}
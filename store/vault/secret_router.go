@@ -0,0 +1,89 @@
+package vault
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	"github.com/odpf/optimus/models"
+	"github.com/odpf/optimus/store"
+)
+
+// secretRouter stores secrets configuring the secret backend of project in
+// optimus db as they are needed to reach vault, rest of the secrets are
+// stored in vault. Secrets stored in optimus db before switching the backend
+// are listed along with the ones in vault, unless vault has the same secret
+type secretRouter struct {
+	base  store.ProjectSecretRepository
+	vault store.ProjectSecretRepository
+}
+
+func (r *secretRouter) Save(ctx context.Context, item models.ProjectSecretItem) error {
+	return r.route(item.Name).Save(ctx, item)
+}
+
+func (r *secretRouter) Update(ctx context.Context, item models.ProjectSecretItem) error {
+	return r.route(item.Name).Update(ctx, item)
+}
+
+func (r *secretRouter) GetByName(ctx context.Context, name string) (models.ProjectSecretItem, error) {
+	return r.route(name).GetByName(ctx, name)
+}
+
+func (r *secretRouter) GetAll(ctx context.Context) ([]models.ProjectSecretItem, error) {
+	baseSecrets, err := r.base.GetAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+	vaultSecrets, err := r.vault.GetAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return overrideSecrets(baseSecrets, vaultSecrets), nil
+}
+
+func (r *secretRouter) GetSecrets(ctx context.Context) ([]models.SecretItemInfo, error) {
+	baseSecrets, err := r.base.GetSecrets(ctx)
+	if err != nil {
+		return nil, err
+	}
+	vaultSecrets, err := r.vault.GetSecrets(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	overridden := map[string]bool{}
+	for _, secret := range vaultSecrets {
+		overridden[secret.Name] = true
+	}
+	secrets := vaultSecrets
+	for _, secret := range baseSecrets {
+		if !overridden[secret.Name] {
+			secrets = append(secrets, secret)
+		}
+	}
+	sort.Slice(secrets, func(i, j int) bool {
+		return secrets[i].Name < secrets[j].Name
+	})
+	return secrets, nil
+}
+
+func (r *secretRouter) Delete(ctx context.Context, name string) error {
+	return r.route(name).Delete(ctx, name)
+}
+
+func (r *secretRouter) route(name string) store.ProjectSecretRepository {
+	if strings.HasPrefix(name, models.ProjectSecretBackend) {
+		return r.base
+	}
+	return r.vault
+}
+
+// NewSecretRouter stores secrets of a project using vault as its secret
+// backend, secrets configuring the backend itself are kept in base
+func NewSecretRouter(base, vault store.ProjectSecretRepository) *secretRouter {
+	return &secretRouter{
+		base:  base,
+		vault: vault,
+	}
+}
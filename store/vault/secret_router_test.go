@@ -0,0 +1,81 @@
+package vault_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/odpf/optimus/mock"
+	"github.com/odpf/optimus/models"
+	"github.com/odpf/optimus/store/vault"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSecretRouter(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("should store secrets configuring secret backend in base repository", func(t *testing.T) {
+		authSecret := models.ProjectSecretItem{Name: models.ProjectSecretBackendAuth, Value: testToken}
+		baseRepo := new(mock.ProjectSecretRepository)
+		baseRepo.On("Save", ctx, authSecret).Return(nil)
+		baseRepo.On("Delete", ctx, authSecret.Name).Return(nil)
+		defer baseRepo.AssertExpectations(t)
+		vaultRepo := new(mock.ProjectSecretRepository)
+		defer vaultRepo.AssertExpectations(t)
+
+		router := vault.NewSecretRouter(baseRepo, vaultRepo)
+		assert.Nil(t, router.Save(ctx, authSecret))
+		assert.Nil(t, router.Delete(ctx, authSecret.Name))
+	})
+	t.Run("should store rest of the secrets in vault", func(t *testing.T) {
+		secret := models.ProjectSecretItem{Name: "g-optimus", Value: "secret"}
+		baseRepo := new(mock.ProjectSecretRepository)
+		defer baseRepo.AssertExpectations(t)
+		vaultRepo := new(mock.ProjectSecretRepository)
+		vaultRepo.On("Update", ctx, secret).Return(nil)
+		vaultRepo.On("GetByName", ctx, secret.Name).Return(secret, nil)
+		defer vaultRepo.AssertExpectations(t)
+
+		router := vault.NewSecretRouter(baseRepo, vaultRepo)
+		assert.Nil(t, router.Update(ctx, secret))
+		item, err := router.GetByName(ctx, secret.Name)
+		assert.Nil(t, err)
+		assert.Equal(t, secret, item)
+	})
+	t.Run("should list secrets of both repositories preferring the ones in vault", func(t *testing.T) {
+		updatedAt := time.Date(2021, 10, 10, 10, 0, 0, 0, time.UTC)
+		baseRepo := new(mock.ProjectSecretRepository)
+		baseRepo.On("GetAll", ctx).Return([]models.ProjectSecretItem{
+			{Name: models.ProjectSecretBackendAuth, Value: testToken},
+			{Name: "g-optimus", Value: "stale-secret"},
+		}, nil)
+		baseRepo.On("GetSecrets", ctx).Return([]models.SecretItemInfo{
+			{Name: models.ProjectSecretBackendAuth, Digest: "digest-1"},
+			{Name: "g-optimus", Digest: "digest-2"},
+		}, nil)
+		defer baseRepo.AssertExpectations(t)
+		vaultRepo := new(mock.ProjectSecretRepository)
+		vaultRepo.On("GetAll", ctx).Return([]models.ProjectSecretItem{
+			{Name: "g-optimus", Value: "secret"},
+		}, nil)
+		vaultRepo.On("GetSecrets", ctx).Return([]models.SecretItemInfo{
+			{Name: "g-optimus", Digest: "digest-3", UpdatedAt: updatedAt},
+		}, nil)
+		defer vaultRepo.AssertExpectations(t)
+
+		router := vault.NewSecretRouter(baseRepo, vaultRepo)
+		secrets, err := router.GetAll(ctx)
+		assert.Nil(t, err)
+		assert.Equal(t, []models.ProjectSecretItem{
+			{Name: models.ProjectSecretBackendAuth, Value: testToken},
+			{Name: "g-optimus", Value: "secret"},
+		}, secrets)
+
+		secretItems, err := router.GetSecrets(ctx)
+		assert.Nil(t, err)
+		assert.Equal(t, []models.SecretItemInfo{
+			{Name: "SECRET_BACKEND_AUTH", Digest: "digest-1"},
+			{Name: "g-optimus", Digest: "digest-3", UpdatedAt: updatedAt},
+		}, secretItems)
+	})
+}
@@ -0,0 +1,173 @@
+// vault implementation stores secrets of projects in kv version 2 secrets
+// engine of vault, or any other server compatible with its http api. Each
+// secret of a project is stored as a separate kv secret under the path
+// configured for the project.
+package vault
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/odpf/optimus/models"
+	"github.com/odpf/optimus/store"
+	"github.com/pkg/errors"
+)
+
+const (
+	defaultMount      = "secret"
+	defaultPathPrefix = "optimus"
+
+	tokenHeader = "X-Vault-Token"
+)
+
+type HTTPClient interface {
+	Do(req *http.Request) (*http.Response, error)
+}
+
+// secretData is stored as data of kv secret
+type secretData struct {
+	Value       string `json:"value"`
+	Type        string `json:"type"`
+	Namespace   string `json:"namespace,omitempty"`
+	NamespaceID string `json:"namespace_id,omitempty"`
+
+	// KeyID identifies the application key value is digested with
+	KeyID string `json:"key_id,omitempty"`
+}
+
+type kvSecret struct {
+	Data     secretData `json:"data"`
+	Metadata struct {
+		// CreatedTime is the time when current version of secret was written
+		CreatedTime time.Time `json:"created_time"`
+	} `json:"metadata"`
+}
+
+// kvClient talks to kv version 2 secrets engine
+type kvClient struct {
+	httpClient HTTPClient
+	host       string
+	mount      string
+	path       string
+	token      string
+}
+
+func (c *kvClient) read(ctx context.Context, name string) (kvSecret, error) {
+	var resp struct {
+		Data kvSecret `json:"data"`
+	}
+	if err := c.do(ctx, http.MethodGet, c.secretURL("data", name), nil, &resp); err != nil {
+		return kvSecret{}, err
+	}
+	return resp.Data, nil
+}
+
+func (c *kvClient) write(ctx context.Context, name string, data secretData) error {
+	return c.do(ctx, http.MethodPost, c.secretURL("data", name), map[string]interface{}{
+		"data": data,
+	}, nil)
+}
+
+// delete removes all the versions of secret
+func (c *kvClient) delete(ctx context.Context, name string) error {
+	return c.do(ctx, http.MethodDelete, c.secretURL("metadata", name), nil, nil)
+}
+
+// list returns names of all the secrets stored under path
+func (c *kvClient) list(ctx context.Context) ([]string, error) {
+	var resp struct {
+		Data struct {
+			Keys []string `json:"keys"`
+		} `json:"data"`
+	}
+	listURL := fmt.Sprintf("%s/v1/%s/metadata/%s?list=true", c.host, c.mount, c.path)
+	if err := c.do(ctx, http.MethodGet, listURL, nil, &resp); err != nil {
+		if errors.Is(err, store.ErrResourceNotFound) {
+			// nothing is stored under path yet
+			return nil, nil
+		}
+		return nil, err
+	}
+	var names []string
+	for _, key := range resp.Data.Keys {
+		// skip nested paths
+		if strings.HasSuffix(key, "/") {
+			continue
+		}
+		names = append(names, key)
+	}
+	return names, nil
+}
+
+func (c *kvClient) secretURL(kind, name string) string {
+	return fmt.Sprintf("%s/v1/%s/%s/%s/%s", c.host, c.mount, kind, c.path, url.PathEscape(name))
+}
+
+func (c *kvClient) do(ctx context.Context, method, reqURL string, reqBody, respBody interface{}) error {
+	var body io.Reader
+	if reqBody != nil {
+		payload, err := json.Marshal(reqBody)
+		if err != nil {
+			return err
+		}
+		body = bytes.NewBuffer(payload)
+	}
+	request, err := http.NewRequestWithContext(ctx, method, reqURL, body)
+	if err != nil {
+		return errors.Wrapf(err, "failed to build http request for %s", reqURL)
+	}
+	request.Header.Set(tokenHeader, c.token)
+	if body != nil {
+		request.Header.Set("Content-Type", "application/json")
+	}
+
+	resp, err := c.httpClient.Do(request)
+	if err != nil {
+		return errors.Wrapf(err, "failed to reach secret backend at %s", reqURL)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return store.ErrResourceNotFound
+	}
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		return errors.Errorf("failed to %s secret backend at %s: %d", method, reqURL, resp.StatusCode)
+	}
+	if respBody == nil || resp.StatusCode == http.StatusNoContent {
+		return nil
+	}
+	return errors.Wrap(json.NewDecoder(resp.Body).Decode(respBody), "failed to decode secret backend response")
+}
+
+func newKVClient(httpClient HTTPClient, project models.ProjectSpec) (*kvClient, error) {
+	host, ok := project.Config[models.ProjectSecretBackendHost]
+	if !ok || host == "" {
+		return nil, errors.Errorf("%s config not set for project %s", models.ProjectSecretBackendHost, project.Name)
+	}
+	token, ok := project.Secret.GetByName(models.ProjectSecretBackendAuth)
+	if !ok {
+		return nil, errors.Errorf("%s secret not configured for project %s", models.ProjectSecretBackendAuth, project.Name)
+	}
+	mount := defaultMount
+	if val, ok := project.Config[models.ProjectSecretBackendMount]; ok && val != "" {
+		mount = val
+	}
+	path := fmt.Sprintf("%s/%s", defaultPathPrefix, project.Name)
+	if val, ok := project.Config[models.ProjectSecretBackendPath]; ok && val != "" {
+		path = val
+	}
+	return &kvClient{
+		httpClient: httpClient,
+		host:       strings.TrimRight(host, "/"),
+		mount:      strings.Trim(mount, "/"),
+		path:       strings.Trim(path, "/"),
+		token:      token,
+	}, nil
+}